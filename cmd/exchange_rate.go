@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/urfave/cli/v2"
+	"imansohibul.my.id/account-domain-service/config"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+func LoadExchangeRates(c *cli.Context) error {
+	ctx := context.Background()
+
+	file, err := os.Open(c.String("file"))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	rates, err := entity.ParseExchangeRatesCSV(file)
+	if err != nil {
+		return err
+	}
+
+	loader, err := config.NewExchangeRateLoader()
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize exchange rate loader", err, nil)
+	}
+
+	if err := loader.LoadExchangeRates(ctx, rates); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "Exchange rates loaded", map[string]interface{}{
+		"count": len(rates),
+	})
+
+	return nil
+}
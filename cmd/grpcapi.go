@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"imansohibul.my.id/account-domain-service/config"
+	"imansohibul.my.id/account-domain-service/internal/grpc/server"
+)
+
+// DefaultGRPCShutdownTimeout is the time given to the pending RPCs to finish on shutdown
+const DefaultGRPCShutdownTimeout = 30 * time.Second
+
+func GRPCAPI(c *cli.Context) error {
+	address := c.String("address")
+	ctx := context.Background()
+
+	grpcAPIServer, err := config.NewGRPCAPI()
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize gRPC API server", err, nil)
+	}
+
+	// Graceful shutdown handler
+	stopped := make(chan struct{})
+	go handleGRPCGracefulShutdown(ctx, grpcAPIServer, stopped)
+
+	logger.Info(ctx, "Starting gRPC API server...", map[string]interface{}{"address": address})
+	if err := grpcAPIServer.Start(address); err != nil {
+		logger.Fatal(ctx, "gRPC API server stopped with error", err, nil)
+	}
+
+	<-stopped
+	logger.Info(ctx, "Server shut down gracefully", nil)
+	return nil
+}
+
+func handleGRPCGracefulShutdown(ctx context.Context, grpcAPIServer *server.GRPCAPIServer, done chan struct{}) {
+	// Listen for interrupt signal (e.g., Ctrl+C, SIGTERM)
+	sigint := make(chan os.Signal, 1)
+	signal.Notify(sigint, os.Interrupt, syscall.SIGTERM)
+	<-sigint
+
+	logger.Warn(ctx, "Shutdown signal received", nil)
+
+	shutdownCtx, cancel := context.WithTimeout(ctx, DefaultGRPCShutdownTimeout)
+	defer cancel()
+
+	if err := grpcAPIServer.Shutdown(shutdownCtx); err != nil {
+		logger.Error(ctx, "Error during server shutdown", err, nil)
+	}
+
+	close(done)
+}
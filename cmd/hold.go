@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"imansohibul.my.id/account-domain-service/config"
+)
+
+func ExpireHolds(c *cli.Context) error {
+	var (
+		ctx = context.Background()
+		now = time.Now()
+	)
+
+	expirer, err := config.NewHoldExpirer()
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize hold expirer", err, nil)
+	}
+
+	report, err := expirer.ExpireHolds(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	type expiry struct {
+		HoldID        uint   `json:"hold_id"`
+		AccountNumber string `json:"account_number"`
+		Amount        string `json:"amount"`
+		Currency      string `json:"currency"`
+	}
+
+	jsonReport := struct {
+		Date     string   `json:"date"`
+		Expiries []expiry `json:"expiries"`
+	}{
+		Date:     report.Date.Format(time.RFC3339),
+		Expiries: make([]expiry, 0, len(report.Expiries)),
+	}
+
+	for _, e := range report.Expiries {
+		jsonReport.Expiries = append(jsonReport.Expiries, expiry{
+			HoldID:        e.HoldID,
+			AccountNumber: e.AccountNumber,
+			Amount:        e.Amount.StringFixed(e.Currency.MinorUnits()),
+			Currency:      string(e.Currency),
+		})
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(jsonReport); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "Hold expiry finished", map[string]interface{}{
+		"date":    jsonReport.Date,
+		"expired": len(report.Expiries),
+	})
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"imansohibul.my.id/account-domain-service/config"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// monthLayout is the layout of the month flags e.g. 2025-05
+const monthLayout = "2006-01"
+
+func AccrueInterest(c *cli.Context) error {
+	var (
+		ctx  = context.Background()
+		date = c.String("date")
+		day  = time.Now().UTC().AddDate(0, 0, -1)
+	)
+
+	// A past date catches up the days missed while the job didn't run
+	if date != "" {
+		parsedDate, err := time.Parse(dateLayout, date)
+		if err != nil {
+			return fmt.Errorf("invalid date %q, expected YYYY-MM-DD", date)
+		}
+
+		day = parsedDate
+	}
+
+	accruer, err := config.NewInterestAccruer()
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize interest accruer", err, nil)
+	}
+
+	report, err := accruer.AccrueInterest(ctx, day)
+	if err != nil {
+		return err
+	}
+
+	jsonReport := struct {
+		Date           string            `json:"date"`
+		Accrued        int               `json:"accrued"`
+		AlreadyAccrued int               `json:"already_accrued"`
+		Totals         map[string]string `json:"totals"`
+	}{
+		Date:           report.Date.Format(dateLayout),
+		Accrued:        report.Accrued,
+		AlreadyAccrued: report.AlreadyAccrued,
+		Totals:         make(map[string]string, len(report.Totals)),
+	}
+
+	for currency, total := range report.Totals {
+		jsonReport.Totals[string(currency)] = total.String()
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(jsonReport); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "Interest accrual finished", map[string]interface{}{
+		"date":            jsonReport.Date,
+		"accrued":         report.Accrued,
+		"already_accrued": report.AlreadyAccrued,
+	})
+
+	return nil
+}
+
+func PostInterest(c *cli.Context) error {
+	var (
+		ctx   = context.Background()
+		month = c.String("month")
+		now   = time.Now().UTC()
+		// The posting runs after the end of the month, so the previous month is posted by default
+		postedMonth = time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	if month != "" {
+		parsedMonth, err := time.Parse(monthLayout, month)
+		if err != nil {
+			return fmt.Errorf("invalid month %q, expected YYYY-MM", month)
+		}
+
+		postedMonth = parsedMonth
+	}
+
+	poster, err := config.NewInterestPoster()
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize interest poster", err, nil)
+	}
+
+	report, err := poster.PostInterest(ctx, postedMonth)
+	if err != nil {
+		return err
+	}
+
+	type posting struct {
+		AccountID     uint   `json:"account_id"`
+		AccountNumber string `json:"account_number,omitempty"`
+		Interest      string `json:"interest,omitempty"`
+		Tax           string `json:"tax,omitempty"`
+		Residual      string `json:"residual,omitempty"`
+		Currency      string `json:"currency,omitempty"`
+		Accruals      int    `json:"accruals"`
+		Status        string `json:"status"`
+		Reason        string `json:"reason,omitempty"`
+	}
+
+	jsonReport := struct {
+		Month    string    `json:"month"`
+		Postings []posting `json:"postings"`
+	}{
+		Month:    report.Month.Format(monthLayout),
+		Postings: make([]posting, 0, len(report.Postings)),
+	}
+
+	counts := make(map[entity.InterestPostingStatus]int)
+	for _, p := range report.Postings {
+		counts[p.Status]++
+
+		jsonPosting := posting{
+			AccountID:     p.AccountID,
+			AccountNumber: p.AccountNumber,
+			Currency:      string(p.Currency),
+			Accruals:      p.Accruals,
+			Status:        string(p.Status),
+			Reason:        p.Reason,
+		}
+
+		if p.Currency != "" {
+			jsonPosting.Interest = p.Interest.StringFixed(p.Currency.MinorUnits())
+			jsonPosting.Tax = p.Tax.StringFixed(p.Currency.MinorUnits())
+			jsonPosting.Residual = p.Residual.String()
+		}
+
+		jsonReport.Postings = append(jsonReport.Postings, jsonPosting)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(jsonReport); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "Interest posting finished", map[string]interface{}{
+		"month":        jsonReport.Month,
+		"posted":       counts[entity.InterestPosted],
+		"carried_over": counts[entity.InterestCarriedOver],
+		"failed":       counts[entity.InterestFailed],
+	})
+
+	return nil
+}
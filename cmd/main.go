@@ -3,8 +3,11 @@ package main
 import (
 	"log"
 	"os"
+	"time"
 
 	"github.com/urfave/cli/v2"
+	"imansohibul.my.id/account-domain-service/internal/publisher"
+	"imansohibul.my.id/account-domain-service/internal/usecase"
 )
 
 func main() {
@@ -31,6 +34,161 @@ func main() {
 					},
 				},
 			},
+			{
+				Name:    "grpc",
+				Aliases: []string{"g"},
+				Usage:   "Start account service gRPC server",
+				Action:  GRPCAPI,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "address",
+						Value: ":9090", // default value
+						Usage: "The address parameter defines the server address and port number (e.g localhost:9090) that the server will listen on.",
+					},
+				},
+			},
+			{
+				Name:   "reconcile",
+				Usage:  "Replay the transactions of every account and report drift against the stored balances",
+				Action: Reconcile,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "format",
+						Value: ReportFormatJSON, // default value
+						Usage: "The format of the report, either json or csv.",
+					},
+					&cli.StringFlag{
+						Name:  "output",
+						Usage: "The file the report is written to, the report is written to stdout when empty.",
+					},
+					&cli.IntFlag{
+						Name:  "mismatch-exit-code",
+						Value: 0, // default value
+						Usage: "The exit code used when mismatches are found (e.g 2 for cron alerting), 0 always exits successfully.",
+					},
+				},
+			},
+			{
+				Name:   "relay",
+				Usage:  "Publish the pending outbox events to the downstream services",
+				Action: Relay,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "publisher",
+						Value: PublisherStdout, // default value
+						Usage: "The publisher of the events, either stdout, file or webhook.",
+					},
+					&cli.StringFlag{
+						Name:  "file",
+						Usage: "The file the events are appended to as JSON lines, required by the file publisher.",
+					},
+					&cli.StringFlag{
+						Name:  "webhook-url",
+						Usage: "The URL every event is posted to, required by the webhook publisher.",
+					},
+					&cli.DurationFlag{
+						Name:  "webhook-timeout",
+						Value: publisher.DefaultWebhookTimeout, // default value
+						Usage: "The timeout of a single webhook request.",
+					},
+					&cli.IntFlag{
+						Name:  "batch-size",
+						Value: usecase.DefaultRelayBatchSize, // default value
+						Usage: "The number of events published per database transaction.",
+					},
+					&cli.DurationFlag{
+						Name:  "interval",
+						Value: 5 * time.Second, // default value
+						Usage: "The interval between polls when there are no pending events.",
+					},
+					&cli.BoolFlag{
+						Name:  "once",
+						Usage: "Publish the events that are due and exit instead of polling.",
+					},
+				},
+			},
+			{
+				Name:   "load-exchange-rates",
+				Usage:  "Load the exchange rates of a CSV file used to convert cross-currency transfers",
+				Action: LoadExchangeRates,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "file",
+						Required: true,
+						Usage:    "The CSV file of the rates with the header base_currency,quote_currency,buy_rate,sell_rate,effective_at.",
+					},
+				},
+			},
+			{
+				Name:   "normalize-phone-numbers",
+				Usage:  "Rewrite the phone numbers of the customers to E.164 and report the numbers shared by several customers",
+				Action: NormalizePhoneNumbers,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "dry-run",
+						Usage: "Only report the phone numbers that would be rewritten.",
+					},
+				},
+			},
+			{
+				Name:   "mature-time-deposits",
+				Usage:  "Pay out or roll over the time deposits that reached their maturity date",
+				Action: MatureTimeDeposits,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "date",
+						Usage: "The date the maturities are processed at (e.g 2025-05-31), today when empty.",
+					},
+				},
+			},
+			{
+				Name:   "accrue-interest",
+				Usage:  "Accrue the daily interest of the accounts on their end-of-day balance",
+				Action: AccrueInterest,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "date",
+						Usage: "The day the interest is accrued for (e.g 2025-05-31), yesterday when empty.",
+					},
+				},
+			},
+			{
+				Name:   "post-interest",
+				Usage:  "Post the interest accrued in a month minus the withholding tax",
+				Action: PostInterest,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "month",
+						Usage: "The month the interest is posted for (e.g 2025-05), the previous month when empty.",
+					},
+				},
+			},
+			{
+				Name:   "expire-holds",
+				Usage:  "Release the holds that reached their expiry so their amounts become available again",
+				Action: ExpireHolds,
+			},
+			{
+				Name:   "generate-statements",
+				Usage:  "Generate the month-end statements of the customer accounts to a local directory",
+				Action: GenerateStatements,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "month",
+						Usage: "The month the statements are generated for (e.g 2025-05), the previous month when empty.",
+					},
+					&cli.StringFlag{
+						Name:  "dir",
+						Usage: "The directory the statements are saved to.",
+						Value: "statements",
+					},
+					&cli.StringSliceFlag{
+						Name:  "format",
+						Usage: "The formats the statements are generated as (csv, pdf), repeat the flag for several formats.",
+						Value: cli.NewStringSlice("pdf"),
+					},
+				},
+			},
 		},
 	}
 
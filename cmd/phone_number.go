@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/urfave/cli/v2"
+	"imansohibul.my.id/account-domain-service/config"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+func NormalizePhoneNumbers(c *cli.Context) error {
+	var (
+		ctx    = context.Background()
+		dryRun = c.Bool("dry-run")
+	)
+
+	normalizer, err := config.NewPhoneNumberNormalizer()
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize phone number normalizer", err, nil)
+	}
+
+	report, err := normalizer.NormalizePhoneNumbers(ctx, dryRun)
+	if err != nil {
+		return err
+	}
+
+	type normalization struct {
+		CustomerID            uint   `json:"customer_id"`
+		PhoneNumber           string `json:"phone_number"`
+		NormalizedPhoneNumber string `json:"normalized_phone_number,omitempty"`
+		Status                string `json:"status"`
+		CollidingCustomerIDs  []uint `json:"colliding_customer_ids,omitempty"`
+	}
+
+	jsonReport := struct {
+		DryRun           bool            `json:"dry_run"`
+		CheckedCustomers int             `json:"checked_customers"`
+		Normalizations   []normalization `json:"normalizations"`
+	}{
+		DryRun:           report.DryRun,
+		CheckedCustomers: report.CheckedCustomers,
+		Normalizations:   make([]normalization, 0, len(report.Normalizations)),
+	}
+
+	counts := make(map[entity.PhoneNumberNormalizationStatus]int)
+	for _, n := range report.Normalizations {
+		counts[n.Status]++
+		jsonReport.Normalizations = append(jsonReport.Normalizations, normalization{
+			CustomerID:            n.CustomerID,
+			PhoneNumber:           n.PhoneNumber,
+			NormalizedPhoneNumber: n.NormalizedPhoneNumber,
+			Status:                string(n.Status),
+			CollidingCustomerIDs:  n.CollidingCustomerIDs,
+		})
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(jsonReport); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "Phone number normalization finished", map[string]interface{}{
+		"dry_run":           report.DryRun,
+		"checked_customers": report.CheckedCustomers,
+		"normalized":        counts[entity.PhoneNumberNormalized],
+		"collisions":        counts[entity.PhoneNumberCollision],
+		"invalid":           counts[entity.PhoneNumberInvalid],
+	})
+
+	return nil
+}
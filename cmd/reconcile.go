@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/urfave/cli/v2"
+	"imansohibul.my.id/account-domain-service/config"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// Supported formats of the reconciliation report
+const (
+	ReportFormatJSON = "json"
+	ReportFormatCSV  = "csv"
+)
+
+func Reconcile(c *cli.Context) error {
+	var (
+		ctx              = context.Background()
+		format           = c.String("format")
+		output           = c.String("output")
+		mismatchExitCode = c.Int("mismatch-exit-code")
+	)
+
+	if format != ReportFormatJSON && format != ReportFormatCSV {
+		return fmt.Errorf("unsupported report format %q", format)
+	}
+
+	reconciler, err := config.NewReconciler()
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize reconciler", err, nil)
+	}
+
+	report, err := reconciler.Reconcile(ctx)
+	if err != nil {
+		return err
+	}
+
+	writer := io.Writer(os.Stdout)
+	if output != "" {
+		file, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		writer = file
+	}
+
+	if err := writeReconciliationReport(writer, format, report); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "Reconciliation finished", map[string]interface{}{
+		"checked_accounts":     report.CheckedAccounts,
+		"checked_transactions": report.CheckedTransactions,
+		"mismatches":           len(report.Mismatches),
+	})
+
+	// A non-zero exit code lets cron alert when the balances drifted
+	if len(report.Mismatches) > 0 && mismatchExitCode != 0 {
+		return cli.Exit(fmt.Sprintf("found %d reconciliation mismatches", len(report.Mismatches)), mismatchExitCode)
+	}
+
+	return nil
+}
+
+func writeReconciliationReport(w io.Writer, format string, report *entity.ReconciliationReport) error {
+	if format == ReportFormatCSV {
+		return writeReconciliationReportCSV(w, report)
+	}
+
+	type mismatch struct {
+		AccountNumber string `json:"account_number"`
+		TransactionID uint   `json:"transaction_id,omitempty"`
+		Type          string `json:"type"`
+		Expected      string `json:"expected"`
+		Actual        string `json:"actual"`
+	}
+
+	jsonReport := struct {
+		CheckedAccounts     int        `json:"checked_accounts"`
+		CheckedTransactions int        `json:"checked_transactions"`
+		Mismatches          []mismatch `json:"mismatches"`
+	}{
+		CheckedAccounts:     report.CheckedAccounts,
+		CheckedTransactions: report.CheckedTransactions,
+		Mismatches:          make([]mismatch, 0, len(report.Mismatches)),
+	}
+
+	for _, m := range report.Mismatches {
+		jsonReport.Mismatches = append(jsonReport.Mismatches, mismatch{
+			AccountNumber: m.AccountNumber,
+			TransactionID: m.TransactionID,
+			Type:          string(m.Type),
+			Expected:      m.Expected.StringFixed(2),
+			Actual:        m.Actual.StringFixed(2),
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(jsonReport)
+}
+
+func writeReconciliationReportCSV(w io.Writer, report *entity.ReconciliationReport) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{"account_number", "transaction_id", "type", "expected", "actual"}); err != nil {
+		return err
+	}
+
+	for _, m := range report.Mismatches {
+		err := writer.Write([]string{
+			m.AccountNumber,
+			strconv.FormatUint(uint64(m.TransactionID), 10),
+			string(m.Type),
+			m.Expected.StringFixed(2),
+			m.Actual.StringFixed(2),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
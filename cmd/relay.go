@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"imansohibul.my.id/account-domain-service/config"
+	"imansohibul.my.id/account-domain-service/internal/publisher"
+	"imansohibul.my.id/account-domain-service/internal/usecase"
+)
+
+// Supported publishers of the outbox events
+const (
+	PublisherStdout  = "stdout"
+	PublisherFile    = "file"
+	PublisherWebhook = "webhook"
+)
+
+func Relay(c *cli.Context) error {
+	var (
+		batchSize = c.Int("batch-size")
+		interval  = c.Duration("interval")
+		once      = c.Bool("once")
+	)
+
+	// Stop relaying on interrupt signal (e.g., Ctrl+C, SIGTERM)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	eventPublisher, closePublisher, err := newEventPublisher(c)
+	if err != nil {
+		return err
+	}
+	defer closePublisher()
+
+	relayer, err := config.NewRelayer(eventPublisher)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize relay", err, nil)
+	}
+
+	logger.Info(ctx, "Starting outbox relay...", map[string]interface{}{
+		"publisher": c.String("publisher"),
+		"interval":  interval.String(),
+	})
+
+	for {
+		// Keep relaying while full batches are found, then wait for new events
+		report, err := relayer.Relay(ctx, batchSize)
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+
+		if report != nil && report.Processed() > 0 {
+			logger.Info(ctx, "Relayed outbox events", map[string]interface{}{
+				"delivered": report.Delivered,
+				"retried":   report.Retried,
+				"failed":    report.Failed,
+			})
+		}
+
+		if report != nil && report.Processed() >= batchSize && ctx.Err() == nil {
+			continue
+		}
+
+		if once {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info(ctx, "Outbox relay stopped", nil)
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// newEventPublisher creates the publisher selected by the publisher flag
+// the returned function releases the resources of the publisher
+func newEventPublisher(c *cli.Context) (usecase.EventPublisher, func(), error) {
+	switch c.String("publisher") {
+	case PublisherStdout:
+		return publisher.NewWriterPublisher(os.Stdout), func() {}, nil
+	case PublisherFile:
+		if c.String("file") == "" {
+			return nil, nil, fmt.Errorf("the file flag is required by the %s publisher", PublisherFile)
+		}
+
+		file, err := os.OpenFile(c.String("file"), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return publisher.NewWriterPublisher(file), func() { file.Close() }, nil
+	case PublisherWebhook:
+		if c.String("webhook-url") == "" {
+			return nil, nil, fmt.Errorf("the webhook-url flag is required by the %s publisher", PublisherWebhook)
+		}
+
+		return publisher.NewWebhookPublisher(c.String("webhook-url"), c.Duration("webhook-timeout")), func() {}, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported publisher %q", c.String("publisher"))
+	}
+}
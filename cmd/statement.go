@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"imansohibul.my.id/account-domain-service/config"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+func GenerateStatements(c *cli.Context) error {
+	var (
+		ctx     = context.Background()
+		month   = c.String("month")
+		dir     = c.String("dir")
+		formats = make([]entity.StatementFormat, 0, len(c.StringSlice("format")))
+		now     = time.Now().UTC()
+		// The statements are generated after the end of the month, so the previous month is generated by default
+		statementMonth = time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	if month != "" {
+		parsedMonth, err := time.Parse(monthLayout, month)
+		if err != nil {
+			return fmt.Errorf("invalid month %q, expected YYYY-MM", month)
+		}
+
+		statementMonth = parsedMonth
+	}
+
+	for _, format := range c.StringSlice("format") {
+		statementFormat := entity.StatementFormat(format)
+		if statementFormat != entity.StatementFormatCSV && statementFormat != entity.StatementFormatPDF {
+			return fmt.Errorf("invalid format %q, expected csv or pdf", format)
+		}
+
+		formats = append(formats, statementFormat)
+	}
+
+	generator, err := config.NewStatementGenerator(dir)
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize statement generator", err, nil)
+	}
+
+	report, err := generator.GenerateStatements(ctx, statementMonth, formats)
+	if err != nil {
+		return err
+	}
+
+	type statement struct {
+		AccountNumber  string `json:"account_number"`
+		File           string `json:"file"`
+		Transactions   int    `json:"transactions"`
+		ClosingBalance string `json:"closing_balance"`
+		Currency       string `json:"currency"`
+	}
+
+	type failure struct {
+		AccountNumber string `json:"account_number"`
+		Format        string `json:"format"`
+		Reason        string `json:"reason"`
+	}
+
+	jsonReport := struct {
+		Month      string      `json:"month"`
+		Dir        string      `json:"dir"`
+		Statements []statement `json:"statements"`
+		Failed     []failure   `json:"failed"`
+		Skipped    int         `json:"skipped"`
+	}{
+		Month:      report.Month.Format(monthLayout),
+		Dir:        dir,
+		Statements: make([]statement, 0, len(report.Generated)),
+		Failed:     make([]failure, 0, len(report.Failed)),
+		Skipped:    report.Skipped,
+	}
+
+	for _, s := range report.Generated {
+		jsonReport.Statements = append(jsonReport.Statements, statement{
+			AccountNumber:  s.AccountNumber,
+			File:           s.Filename,
+			Transactions:   s.Transactions,
+			ClosingBalance: s.ClosingBalance.StringFixed(s.Currency.MinorUnits()),
+			Currency:       string(s.Currency),
+		})
+	}
+
+	for _, f := range report.Failed {
+		jsonReport.Failed = append(jsonReport.Failed, failure{
+			AccountNumber: f.AccountNumber,
+			Format:        string(f.Format),
+			Reason:        f.Reason,
+		})
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(jsonReport); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "Statement generation finished", map[string]interface{}{
+		"month":     jsonReport.Month,
+		"generated": len(report.Generated),
+		"failed":    len(report.Failed),
+		"skipped":   report.Skipped,
+	})
+
+	// The report is printed first, so the failed statements can be generated again
+	// with the statement API while the job exits with an error for the scheduler
+	if len(report.Failed) > 0 {
+		return fmt.Errorf("%d statements failed", len(report.Failed))
+	}
+
+	return nil
+}
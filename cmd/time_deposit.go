@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"imansohibul.my.id/account-domain-service/config"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// dateLayout is the layout of the date flags e.g. 2025-05-31
+const dateLayout = "2006-01-02"
+
+func MatureTimeDeposits(c *cli.Context) error {
+	var (
+		ctx  = context.Background()
+		date = c.String("date")
+		now  = time.Now()
+	)
+
+	// A past date catches up the maturities missed while the job didn't run
+	if date != "" {
+		parsedDate, err := time.Parse(dateLayout, date)
+		if err != nil {
+			return fmt.Errorf("invalid date %q, expected YYYY-MM-DD", date)
+		}
+
+		now = parsedDate
+	}
+
+	maturer, err := config.NewTimeDepositMaturer()
+	if err != nil {
+		logger.Fatal(ctx, "failed to initialize time deposit maturer", err, nil)
+	}
+
+	report, err := maturer.MatureTimeDeposits(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	type maturity struct {
+		TimeDepositID    uint   `json:"time_deposit_id"`
+		AccountNumber    string `json:"account_number,omitempty"`
+		Principal        string `json:"principal,omitempty"`
+		Interest         string `json:"interest,omitempty"`
+		Tax              string `json:"tax,omitempty"`
+		Currency         string `json:"currency,omitempty"`
+		Status           string `json:"status"`
+		NextMaturityDate string `json:"next_maturity_date,omitempty"`
+		Reason           string `json:"reason,omitempty"`
+	}
+
+	jsonReport := struct {
+		Date       string     `json:"date"`
+		Maturities []maturity `json:"maturities"`
+	}{
+		Date:       report.Date.Format(dateLayout),
+		Maturities: make([]maturity, 0, len(report.Maturities)),
+	}
+
+	counts := make(map[entity.TimeDepositMaturityStatus]int)
+	for _, m := range report.Maturities {
+		counts[m.Status]++
+
+		jsonMaturity := maturity{
+			TimeDepositID: m.TimeDepositID,
+			AccountNumber: m.AccountNumber,
+			Currency:      string(m.Currency),
+			Status:        string(m.Status),
+			Reason:        m.Reason,
+		}
+
+		if m.AccountNumber != "" {
+			jsonMaturity.Principal = m.Principal.StringFixed(m.Currency.MinorUnits())
+			jsonMaturity.Interest = m.Interest.StringFixed(m.Currency.MinorUnits())
+			jsonMaturity.Tax = m.Tax.StringFixed(m.Currency.MinorUnits())
+		}
+
+		if !m.NextMaturityDate.IsZero() {
+			jsonMaturity.NextMaturityDate = m.NextMaturityDate.Format(dateLayout)
+		}
+
+		jsonReport.Maturities = append(jsonReport.Maturities, jsonMaturity)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(jsonReport); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "Time deposit maturity finished", map[string]interface{}{
+		"date":        jsonReport.Date,
+		"paid_out":    counts[entity.TimeDepositPaidOut],
+		"rolled_over": counts[entity.TimeDepositRolledOver],
+		"failed":      counts[entity.TimeDepositFailed],
+	})
+
+	return nil
+}
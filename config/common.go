@@ -6,17 +6,24 @@ import (
 	"os"
 	"strings"
 	"time"
+	_ "time/tzdata" // the timezone of the bank is loaded without the zoneinfo of the system
 
 	"github.com/go-rel/postgres"
 	"github.com/go-rel/rel"
 	"github.com/kelseyhightower/envconfig"
 	_ "github.com/lib/pq"
+	"github.com/shopspring/decimal"
 	"github.com/subosito/gotenv"
+	"imansohibul.my.id/account-domain-service/entity"
 	"imansohibul.my.id/account-domain-service/util"
 )
 
 type ServiceConfig struct {
-	DatabaseConfig DatabaseConfig `envconfig:"DB"`
+	TimeZone            string              `envconfig:"TIMEZONE" default:"Asia/Jakarta"`
+	DatabaseConfig      DatabaseConfig      `envconfig:"DB"`
+	AccountNumberConfig AccountNumberConfig `envconfig:"ACCOUNT_NUMBER"`
+	InterestConfig      InterestConfig      `envconfig:"INTEREST"`
+	ReversalConfig      ReversalConfig      `envconfig:"REVERSAL"`
 }
 
 // LoadConfig loads the configuration from environment variables
@@ -31,10 +38,28 @@ func LoadConfig() (ServiceConfig, error) {
 	}
 
 	// parse environment variable to config struct
-	err := envconfig.Process("service", &cfg)
+	if err := envconfig.Process("service", &cfg); err != nil {
+		return cfg, err
+	}
+
+	if _, err := cfg.Location(); err != nil {
+		return cfg, err
+	}
+
+	err := cfg.AccountNumberConfig.Scheme().Validate()
 	return cfg, err
 }
 
+// Location loads the timezone of the bank e.g. Asia/Jakarta
+func (c ServiceConfig) Location() (*time.Location, error) {
+	location, err := time.LoadLocation(c.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", c.TimeZone, err)
+	}
+
+	return location, nil
+}
+
 type DatabaseConfig struct {
 	Host     string `envconfig:"HOST"`
 	Port     int    `envconfig:"PORT"`
@@ -43,6 +68,54 @@ type DatabaseConfig struct {
 	Database string `envconfig:"NAME"`
 }
 
+// AccountNumberConfig is the format of the generated account numbers, see util.AccountNumberScheme
+type AccountNumberConfig struct {
+	Prefix       string `envconfig:"PREFIX"`
+	Length       int    `envconfig:"LENGTH" default:"10"`
+	Body         string `envconfig:"BODY" default:"random"`
+	CheckDigit   string `envconfig:"CHECK_DIGIT" default:"luhn"`
+	LegacyLength int    `envconfig:"LEGACY_LENGTH"`
+	LegacyPrefix string `envconfig:"LEGACY_PREFIX"`
+}
+
+// Scheme converts the configuration to the account number scheme
+func (a AccountNumberConfig) Scheme() util.AccountNumberScheme {
+	return util.AccountNumberScheme{
+		Prefix:       a.Prefix,
+		Length:       a.Length,
+		Body:         a.Body,
+		CheckDigit:   a.CheckDigit,
+		LegacyLength: a.LegacyLength,
+		LegacyPrefix: a.LegacyPrefix,
+	}
+}
+
+// InterestConfig is the withholding tax deducted from the posted interest, see entity.InterestTaxPolicy
+type InterestConfig struct {
+	WithholdingTaxRate decimal.Decimal `envconfig:"WITHHOLDING_TAX_RATE" default:"20"`
+	TaxFreeBalance     decimal.Decimal `envconfig:"TAX_FREE_BALANCE" default:"7500000"`
+}
+
+// TaxPolicy converts the configuration to the withholding tax policy
+func (i InterestConfig) TaxPolicy() entity.InterestTaxPolicy {
+	return entity.InterestTaxPolicy{
+		Rate:           i.WithholdingTaxRate,
+		TaxFreeBalance: i.TaxFreeBalance,
+	}
+}
+
+// ReversalConfig is what happens when a reversal would make the balance negative, see entity.ReversalPolicy
+type ReversalConfig struct {
+	AllowForce bool `envconfig:"ALLOW_FORCE" default:"false"`
+}
+
+// Policy converts the configuration to the reversal policy
+func (r ReversalConfig) Policy() entity.ReversalPolicy {
+	return entity.ReversalPolicy{
+		AllowForce: r.AllowForce,
+	}
+}
+
 // BuildDSN constructs the PostgreSQL DSN in URL format
 func (db DatabaseConfig) PostgresDSN() string {
 	return fmt.Sprintf(
@@ -0,0 +1,37 @@
+package config
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/internal/repository"
+	"imansohibul.my.id/account-domain-service/internal/usecase"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// ExchangeRateLoader stores the exchange rates used to convert cross-currency transfers
+type ExchangeRateLoader interface {
+	LoadExchangeRates(ctx context.Context, exchangeRates []*entity.ExchangeRate) error
+}
+
+func NewExchangeRateLoader() (ExchangeRateLoader, error) {
+	// Load configuration
+	serviceConfig, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize database connection
+	db, err := initPostgresDatabase(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize logger
+	logger := util.GetZapLogger()
+
+	return usecase.NewLoadExchangeRatesUsecase(
+		repository.NewExchangeRateRepository(db),
+		logger,
+	), nil
+}
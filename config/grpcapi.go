@@ -0,0 +1,102 @@
+package config
+
+import (
+	"imansohibul.my.id/account-domain-service/internal/grpc/server"
+	"imansohibul.my.id/account-domain-service/internal/repository"
+	"imansohibul.my.id/account-domain-service/internal/usecase"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func NewGRPCAPI() (*server.GRPCAPIServer, error) {
+	// Load configuration
+	serviceConfig, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize database connection
+	db, err := initPostgresDatabase(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize logger
+	logger := util.GetZapLogger()
+
+	// The days and months of the withdrawal limits start at midnight in the timezone of the bank
+	location, err := serviceConfig.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	// Account numbers are generated and validated with the configured scheme
+	accountNumberScheme := serviceConfig.AccountNumberConfig.Scheme()
+	util.SetAccountNumberScheme(accountNumberScheme)
+
+	// Initialize repositories
+	var (
+		accountRepository          = repository.NewAccountRepository(db)
+		transactionRepository      = repository.NewTransactionRepository(db)
+		customerRepository         = repository.NewCustomerRepository(db)
+		customerIdentityRepository = repository.NewCustomerIdentityRepository(db)
+		transactionManager         = repository.NewTransactionManager(db)
+		idempotencyKeyRepository   = repository.NewIdempotencyKeyRepository(db)
+		journalRepository          = repository.NewJournalRepository(db)
+		outboxRepository           = repository.NewOutboxRepository(db)
+		feeRuleRepository          = repository.NewFeeRuleRepository(db)
+		withdrawalLimitRepository  = repository.NewWithdrawalLimitRepository(db)
+	)
+
+	// Create usecases
+	var (
+		createAccountUsecase = usecase.NewCreateAccountUsecase(
+			accountRepository,
+			transactionManager,
+			customerRepository,
+			customerIdentityRepository,
+			transactionRepository,
+			idempotencyKeyRepository,
+			outboxRepository,
+			accountNumberScheme,
+			logger,
+		)
+
+		depositUsecase = usecase.NewDepositUsecase(
+			accountRepository,
+			transactionRepository,
+			transactionManager,
+			idempotencyKeyRepository,
+			journalRepository,
+			outboxRepository,
+			logger,
+		)
+
+		withdrawUsecase = usecase.NewWithdrawUsecase(
+			accountRepository,
+			transactionRepository,
+			transactionManager,
+			idempotencyKeyRepository,
+			journalRepository,
+			outboxRepository,
+			feeRuleRepository,
+			withdrawalLimitRepository,
+			customerRepository,
+			location,
+			logger,
+		)
+
+		getBalanceUsecase = usecase.NewGetBalanceUsecase(
+			accountRepository,
+			logger,
+		)
+	)
+
+	// Initialize gRPC API server
+	return server.NewGRPCAPIServer(
+		createAccountUsecase,
+		depositUsecase,
+		withdrawUsecase,
+		getBalanceUsecase,
+		logger,
+	), nil
+}
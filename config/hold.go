@@ -0,0 +1,47 @@
+package config
+
+import (
+	"context"
+	"time"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/internal/repository"
+	"imansohibul.my.id/account-domain-service/internal/usecase"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// HoldExpirer releases the holds that reached their expiry
+type HoldExpirer interface {
+	ExpireHolds(ctx context.Context, now time.Time) (*entity.HoldExpiryReport, error)
+}
+
+func NewHoldExpirer() (HoldExpirer, error) {
+	// Load configuration
+	serviceConfig, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize database connection
+	db, err := initPostgresDatabase(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize logger
+	logger := util.GetZapLogger()
+
+	// Initialize repositories
+	var (
+		accountRepository  = repository.NewAccountRepository(db)
+		holdRepository     = repository.NewHoldRepository(db)
+		transactionManager = repository.NewTransactionManager(db)
+	)
+
+	return usecase.NewExpireHoldsUsecase(
+		accountRepository,
+		holdRepository,
+		transactionManager,
+		logger,
+	), nil
+}
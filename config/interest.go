@@ -0,0 +1,90 @@
+package config
+
+import (
+	"context"
+	"time"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/internal/repository"
+	"imansohibul.my.id/account-domain-service/internal/usecase"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// InterestAccruer accrues the daily interest of the accounts
+type InterestAccruer interface {
+	AccrueInterest(ctx context.Context, date time.Time) (*entity.InterestAccrualReport, error)
+}
+
+// InterestPoster posts the monthly interest of the accounts
+type InterestPoster interface {
+	PostInterest(ctx context.Context, month time.Time) (*entity.InterestPostingReport, error)
+}
+
+func NewInterestAccruer() (InterestAccruer, error) {
+	// Load configuration
+	serviceConfig, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize database connection
+	db, err := initPostgresDatabase(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize logger
+	logger := util.GetZapLogger()
+
+	// Initialize repositories
+	var (
+		accountRepository     = repository.NewAccountRepository(db)
+		transactionRepository = repository.NewTransactionRepository(db)
+		interestRepository    = repository.NewInterestRepository(db)
+	)
+
+	return usecase.NewAccrueInterestUsecase(
+		accountRepository,
+		transactionRepository,
+		interestRepository,
+		logger,
+	), nil
+}
+
+func NewInterestPoster() (InterestPoster, error) {
+	// Load configuration
+	serviceConfig, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize database connection
+	db, err := initPostgresDatabase(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize logger
+	logger := util.GetZapLogger()
+
+	// Initialize repositories
+	var (
+		accountRepository     = repository.NewAccountRepository(db)
+		transactionRepository = repository.NewTransactionRepository(db)
+		interestRepository    = repository.NewInterestRepository(db)
+		transactionManager    = repository.NewTransactionManager(db)
+		journalRepository     = repository.NewJournalRepository(db)
+		outboxRepository      = repository.NewOutboxRepository(db)
+	)
+
+	return usecase.NewPostInterestUsecase(
+		accountRepository,
+		transactionRepository,
+		interestRepository,
+		transactionManager,
+		journalRepository,
+		outboxRepository,
+		serviceConfig.InterestConfig.TaxPolicy(),
+		logger,
+	), nil
+}
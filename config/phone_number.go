@@ -0,0 +1,46 @@
+package config
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/internal/repository"
+	"imansohibul.my.id/account-domain-service/internal/usecase"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// PhoneNumberNormalizer rewrites the stored phone numbers of the customers to E.164
+type PhoneNumberNormalizer interface {
+	NormalizePhoneNumbers(ctx context.Context, dryRun bool) (*entity.PhoneNumberNormalizationReport, error)
+}
+
+func NewPhoneNumberNormalizer() (PhoneNumberNormalizer, error) {
+	// Load configuration
+	serviceConfig, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize database connection
+	db, err := initPostgresDatabase(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize logger
+	logger := util.GetZapLogger()
+
+	// Initialize repositories
+	var (
+		customerRepository        = repository.NewCustomerRepository(db)
+		customerHistoryRepository = repository.NewCustomerHistoryRepository(db)
+		transactionManager        = repository.NewTransactionManager(db)
+	)
+
+	return usecase.NewNormalizePhoneNumbersUsecase(
+		customerRepository,
+		customerHistoryRepository,
+		transactionManager,
+		logger,
+	), nil
+}
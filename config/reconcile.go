@@ -0,0 +1,46 @@
+package config
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/internal/repository"
+	"imansohibul.my.id/account-domain-service/internal/usecase"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// Reconciler replays the transactions of every account against the stored balances
+type Reconciler interface {
+	Reconcile(ctx context.Context) (*entity.ReconciliationReport, error)
+}
+
+func NewReconciler() (Reconciler, error) {
+	// Load configuration
+	serviceConfig, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize database connection
+	db, err := initPostgresDatabase(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize logger
+	logger := util.GetZapLogger()
+
+	// Initialize repositories
+	var (
+		accountRepository     = repository.NewAccountRepository(db)
+		transactionRepository = repository.NewTransactionRepository(db)
+		transactionManager    = repository.NewTransactionManager(db)
+	)
+
+	return usecase.NewReconcileUsecase(
+		accountRepository,
+		transactionRepository,
+		transactionManager,
+		logger,
+	), nil
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/internal/repository"
+	"imansohibul.my.id/account-domain-service/internal/usecase"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// Relayer publishes the pending outbox events
+type Relayer interface {
+	Relay(ctx context.Context, batchSize int) (*entity.RelayReport, error)
+}
+
+func NewRelayer(publisher usecase.EventPublisher) (Relayer, error) {
+	// Load configuration
+	serviceConfig, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize database connection
+	db, err := initPostgresDatabase(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize logger
+	logger := util.GetZapLogger()
+
+	// Initialize repositories
+	var (
+		outboxRepository   = repository.NewOutboxRepository(db)
+		transactionManager = repository.NewTransactionManager(db)
+	)
+
+	return usecase.NewRelayUsecase(
+		outboxRepository,
+		transactionManager,
+		publisher,
+		logger,
+	), nil
+}
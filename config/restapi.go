@@ -23,13 +23,34 @@ func NewRestAPI() (*server.RestAPIServer, error) {
 	// Initialize logger
 	logger := util.GetZapLogger()
 
+	// The days and months of the withdrawal limits start at midnight in the timezone of the bank
+	location, err := serviceConfig.Location()
+	if err != nil {
+		return nil, err
+	}
+
+	// Account numbers are generated and validated with the configured scheme
+	accountNumberScheme := serviceConfig.AccountNumberConfig.Scheme()
+	util.SetAccountNumberScheme(accountNumberScheme)
+
 	// Initialize repositories
 	var (
-		accountRepository          = repository.NewAccountRepository(db)
-		transactionRepository      = repository.NewTransactionRepository(db)
-		customerRepository         = repository.NewCustomerRepository(db)
-		customerIdentityRepository = repository.NewCustomerIdentityRepository(db)
-		transactionManager         = repository.NewTransactionManager(db)
+		accountRepository              = repository.NewAccountRepository(db)
+		transactionRepository          = repository.NewTransactionRepository(db)
+		customerRepository             = repository.NewCustomerRepository(db)
+		customerIdentityRepository     = repository.NewCustomerIdentityRepository(db)
+		transactionManager             = repository.NewTransactionManager(db)
+		idempotencyKeyRepository       = repository.NewIdempotencyKeyRepository(db)
+		accountStatusHistoryRepository = repository.NewAccountStatusHistoryRepository(db)
+		journalRepository              = repository.NewJournalRepository(db)
+		outboxRepository               = repository.NewOutboxRepository(db)
+		feeRuleRepository              = repository.NewFeeRuleRepository(db)
+		withdrawalLimitRepository      = repository.NewWithdrawalLimitRepository(db)
+		customerHistoryRepository      = repository.NewCustomerHistoryRepository(db)
+		exchangeRateRepository         = repository.NewExchangeRateRepository(db)
+		timeDepositRepository          = repository.NewTimeDepositRepository(db)
+		holdRepository                 = repository.NewHoldRepository(db)
+		transactionReversalRepository  = repository.NewTransactionReversalRepository(db)
 	)
 
 	// Create usecases
@@ -40,6 +61,9 @@ func NewRestAPI() (*server.RestAPIServer, error) {
 			customerRepository,
 			customerIdentityRepository,
 			transactionRepository,
+			idempotencyKeyRepository,
+			outboxRepository,
+			accountNumberScheme,
 			logger,
 		)
 
@@ -47,6 +71,9 @@ func NewRestAPI() (*server.RestAPIServer, error) {
 			accountRepository,
 			transactionRepository,
 			transactionManager,
+			idempotencyKeyRepository,
+			journalRepository,
+			outboxRepository,
 			logger,
 		)
 
@@ -54,6 +81,13 @@ func NewRestAPI() (*server.RestAPIServer, error) {
 			accountRepository,
 			transactionRepository,
 			transactionManager,
+			idempotencyKeyRepository,
+			journalRepository,
+			outboxRepository,
+			feeRuleRepository,
+			withdrawalLimitRepository,
+			customerRepository,
+			location,
 			logger,
 		)
 
@@ -61,6 +95,163 @@ func NewRestAPI() (*server.RestAPIServer, error) {
 			accountRepository,
 			logger,
 		)
+
+		transferUsecase = usecase.NewTransferUsecase(
+			accountRepository,
+			transactionRepository,
+			transactionManager,
+			idempotencyKeyRepository,
+			journalRepository,
+			outboxRepository,
+			exchangeRateRepository,
+			feeRuleRepository,
+			logger,
+		)
+
+		listTransactionsUsecase = usecase.NewListTransactionsUsecase(
+			accountRepository,
+			transactionRepository,
+			logger,
+		)
+
+		updateAccountStatusUsecase = usecase.NewUpdateAccountStatusUsecase(
+			accountRepository,
+			accountStatusHistoryRepository,
+			transactionManager,
+			logger,
+		)
+
+		getTrialBalanceUsecase = usecase.NewGetTrialBalanceUsecase(
+			journalRepository,
+			logger,
+		)
+
+		loadExchangeRatesUsecase = usecase.NewLoadExchangeRatesUsecase(
+			exchangeRateRepository,
+			logger,
+		)
+
+		openAccountUsecase = usecase.NewOpenAccountUsecase(
+			accountRepository,
+			transactionManager,
+			customerRepository,
+			customerIdentityRepository,
+			idempotencyKeyRepository,
+			outboxRepository,
+			accountNumberScheme,
+			logger,
+		)
+
+		listCustomerAccountsUsecase = usecase.NewListCustomerAccountsUsecase(
+			accountRepository,
+			customerRepository,
+			logger,
+		)
+
+		getCustomerUsecase = usecase.NewGetCustomerUsecase(
+			customerRepository,
+			customerIdentityRepository,
+			accountRepository,
+			logger,
+		)
+
+		updateCustomerUsecase = usecase.NewUpdateCustomerUsecase(
+			customerRepository,
+			customerHistoryRepository,
+			transactionManager,
+			logger,
+		)
+
+		searchCustomersUsecase = usecase.NewSearchCustomersUsecase(
+			customerRepository,
+			customerIdentityRepository,
+			logger,
+		)
+
+		addCustomerIdentityUsecase = usecase.NewAddCustomerIdentityUsecase(
+			customerRepository,
+			customerIdentityRepository,
+			logger,
+		)
+
+		openTimeDepositUsecase = usecase.NewOpenTimeDepositUsecase(
+			accountRepository,
+			transactionRepository,
+			timeDepositRepository,
+			transactionManager,
+			idempotencyKeyRepository,
+			journalRepository,
+			outboxRepository,
+			accountNumberScheme,
+			logger,
+		)
+
+		quoteFeeUsecase = usecase.NewQuoteFeeUsecase(
+			accountRepository,
+			feeRuleRepository,
+			logger,
+		)
+
+		setWithdrawalLimitUsecase = usecase.NewSetWithdrawalLimitUsecase(
+			accountRepository,
+			withdrawalLimitRepository,
+			transactionManager,
+			logger,
+		)
+
+		removeWithdrawalLimitUsecase = usecase.NewRemoveWithdrawalLimitUsecase(
+			accountRepository,
+			withdrawalLimitRepository,
+			transactionManager,
+			logger,
+		)
+
+		placeHoldUsecase = usecase.NewPlaceHoldUsecase(
+			accountRepository,
+			holdRepository,
+			transactionManager,
+			idempotencyKeyRepository,
+			logger,
+		)
+
+		captureHoldUsecase = usecase.NewCaptureHoldUsecase(
+			accountRepository,
+			transactionRepository,
+			holdRepository,
+			transactionManager,
+			idempotencyKeyRepository,
+			journalRepository,
+			outboxRepository,
+			logger,
+		)
+
+		releaseHoldUsecase = usecase.NewReleaseHoldUsecase(
+			accountRepository,
+			holdRepository,
+			transactionManager,
+			idempotencyKeyRepository,
+			logger,
+		)
+
+		reverseTransactionUsecase = usecase.NewReverseTransactionUsecase(
+			accountRepository,
+			transactionRepository,
+			transactionReversalRepository,
+			holdRepository,
+			transactionManager,
+			journalRepository,
+			outboxRepository,
+			serviceConfig.ReversalConfig.Policy(),
+			logger,
+		)
+
+		generateStatementUsecase = usecase.NewGenerateStatementUsecase(
+			accountRepository,
+			customerRepository,
+			transactionRepository,
+			statementRenderers(),
+			logger,
+		)
 	)
 
 	// Initialize Rest API server
@@ -69,5 +260,25 @@ func NewRestAPI() (*server.RestAPIServer, error) {
 		depositUsecase,
 		withdrawUsecase,
 		getBalanceUsecase,
+		transferUsecase,
+		listTransactionsUsecase,
+		updateAccountStatusUsecase,
+		getTrialBalanceUsecase,
+		loadExchangeRatesUsecase,
+		openAccountUsecase,
+		listCustomerAccountsUsecase,
+		getCustomerUsecase,
+		updateCustomerUsecase,
+		searchCustomersUsecase,
+		addCustomerIdentityUsecase,
+		openTimeDepositUsecase,
+		quoteFeeUsecase,
+		setWithdrawalLimitUsecase,
+		removeWithdrawalLimitUsecase,
+		placeHoldUsecase,
+		captureHoldUsecase,
+		releaseHoldUsecase,
+		reverseTransactionUsecase,
+		generateStatementUsecase,
 	), nil
 }
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"context"
+	"time"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/internal/repository"
+	"imansohibul.my.id/account-domain-service/internal/statement"
+	"imansohibul.my.id/account-domain-service/internal/usecase"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// StatementGenerator generates the month-end statements of every customer account
+type StatementGenerator interface {
+	GenerateStatements(ctx context.Context, month time.Time, formats []entity.StatementFormat) (*entity.StatementBatchReport, error)
+}
+
+// NewStatementGenerator creates the generator saving the statements as files in the directory
+func NewStatementGenerator(dir string) (StatementGenerator, error) {
+	// Load configuration
+	serviceConfig, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize database connection
+	db, err := initPostgresDatabase(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize logger
+	logger := util.GetZapLogger()
+
+	// Initialize repositories
+	var (
+		accountRepository              = repository.NewAccountRepository(db)
+		accountStatusHistoryRepository = repository.NewAccountStatusHistoryRepository(db)
+		customerRepository             = repository.NewCustomerRepository(db)
+		transactionRepository          = repository.NewTransactionRepository(db)
+	)
+
+	return usecase.NewGenerateStatementsUsecase(
+		accountRepository,
+		accountStatusHistoryRepository,
+		customerRepository,
+		transactionRepository,
+		statementRenderers(),
+		statement.NewDirectoryStore(dir),
+		logger,
+	), nil
+}
+
+// statementRenderers returns the renderers of every supported statement format
+func statementRenderers() []usecase.StatementRenderer {
+	return []usecase.StatementRenderer{
+		statement.NewCSVRenderer(),
+		statement.NewPDFRenderer(),
+	}
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"context"
+	"time"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/internal/repository"
+	"imansohibul.my.id/account-domain-service/internal/usecase"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// TimeDepositMaturer pays out or rolls over the time deposits that reached their maturity date
+type TimeDepositMaturer interface {
+	MatureTimeDeposits(ctx context.Context, now time.Time) (*entity.TimeDepositMaturityReport, error)
+}
+
+func NewTimeDepositMaturer() (TimeDepositMaturer, error) {
+	// Load configuration
+	serviceConfig, err := LoadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize database connection
+	db, err := initPostgresDatabase(serviceConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Initialize logger
+	logger := util.GetZapLogger()
+
+	// Initialize repositories
+	var (
+		accountRepository              = repository.NewAccountRepository(db)
+		transactionRepository          = repository.NewTransactionRepository(db)
+		timeDepositRepository          = repository.NewTimeDepositRepository(db)
+		accountStatusHistoryRepository = repository.NewAccountStatusHistoryRepository(db)
+		transactionManager             = repository.NewTransactionManager(db)
+		journalRepository              = repository.NewJournalRepository(db)
+		outboxRepository               = repository.NewOutboxRepository(db)
+	)
+
+	return usecase.NewMatureTimeDepositsUsecase(
+		accountRepository,
+		transactionRepository,
+		timeDepositRepository,
+		accountStatusHistoryRepository,
+		transactionManager,
+		journalRepository,
+		outboxRepository,
+		serviceConfig.InterestConfig.TaxPolicy(),
+		logger,
+	), nil
+}
@@ -0,0 +1,2 @@
+-- Drop column linked_transaction_id if exists (rollback migration)
+ALTER TABLE transactions DROP COLUMN IF EXISTS linked_transaction_id;
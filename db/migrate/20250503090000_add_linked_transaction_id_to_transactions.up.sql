@@ -0,0 +1,4 @@
+-- This SQL script adds the linked_transaction_id column to the transactions table.
+-- A transfer creates a debit and a credit transaction, each one points to its counterpart.
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS linked_transaction_id INT NULL; -- Counterpart transaction of a transfer (nullable)
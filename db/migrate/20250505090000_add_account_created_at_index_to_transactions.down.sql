@@ -0,0 +1,2 @@
+-- Drop index idx_transactions_account_id_created_at_id if exists (rollback migration)
+DROP INDEX IF EXISTS idx_transactions_account_id_created_at_id;
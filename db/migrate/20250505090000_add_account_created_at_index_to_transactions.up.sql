@@ -0,0 +1,4 @@
+-- This SQL script adds an index to read the transaction history (mutasi) of an account.
+-- The history is ordered from the newest transaction and paginated with a (created_at, id) cursor,
+-- so the index matches that order to avoid sorting and offset scans.
+CREATE INDEX IF NOT EXISTS idx_transactions_account_id_created_at_id ON transactions(account_id, created_at DESC, id DESC);
@@ -0,0 +1,16 @@
+-- This SQL script creates a table named 'idempotency_keys' in the database.
+-- The table stores the Idempotency-Key sent by clients on money-moving requests
+-- together with a hash of the request payload and the response of the first request.
+-- The key is written in the same database transaction as the balance update,
+-- so a key exists if and only if the request has been applied.
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    id BIGSERIAL PRIMARY KEY,                       -- Auto-incrementing ID
+    scope VARCHAR(32) NOT NULL,                     -- Guarded operation e.g. deposit, withdraw
+    idempotency_key VARCHAR(64) NOT NULL,           -- Key sent by the client in the Idempotency-Key header
+    request_hash CHAR(64) NOT NULL,                 -- SHA-256 (hex) of the request payload
+    response TEXT NOT NULL DEFAULT '',              -- JSON encoded result of the first request
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set creation timestamp
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set updated timestamp
+
+    CONSTRAINT uq_idempotency_scope_key UNIQUE(scope, idempotency_key) -- One request per key and scope
+);
@@ -0,0 +1,2 @@
+-- Drop table account_status_histories if exists (rollback migration)
+DROP TABLE IF EXISTS account_status_histories;
@@ -0,0 +1,15 @@
+-- This SQL script creates a table named 'account_status_histories' in the database.
+-- Every status change of an account (e.g. blocked, dormant, closed) is recorded
+-- with the previous status, the new status and the reason of the change.
+CREATE TABLE IF NOT EXISTS account_status_histories (
+    id BIGSERIAL PRIMARY KEY,                       -- Auto-incrementing ID
+    account_id BIGINT NOT NULL,                     -- Account ID (Foreign Key to reference the account)
+    from_status SMALLINT NOT NULL,                  -- Status before the change
+    to_status SMALLINT NOT NULL,                    -- Status after the change
+    reason VARCHAR(255) NOT NULL,                   -- Reason of the change
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set creation timestamp
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP  -- Automatically set updated timestamp
+);
+
+-- Create an index for quick lookup by account_id (without foreign key constraint)
+CREATE INDEX idx_account_status_histories_account_id ON account_status_histories(account_id);
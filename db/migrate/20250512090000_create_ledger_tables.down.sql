@@ -0,0 +1,4 @@
+-- Drop the ledger tables and the internal system accounts (rollback migration)
+DROP TABLE IF EXISTS journal_entries;
+DROP TABLE IF EXISTS journals;
+DELETE FROM accounts WHERE account_type = 2;
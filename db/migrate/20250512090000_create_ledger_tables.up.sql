@@ -0,0 +1,52 @@
+-- This SQL script creates the double-entry ledger tables.
+-- Every balance movement posts a journal with at least one debit and one credit entry,
+-- the sum of the debit entries equals the sum of the credit entries for every currency.
+CREATE TABLE IF NOT EXISTS journals (
+    id BIGSERIAL PRIMARY KEY,                       -- Auto-incrementing ID
+    description VARCHAR(255) NOT NULL,              -- Business event of the journal e.g. deposit
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set creation timestamp
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP  -- Automatically set updated timestamp
+);
+
+CREATE TABLE IF NOT EXISTS journal_entries (
+    id BIGSERIAL PRIMARY KEY,                       -- Auto-incrementing ID
+    journal_id BIGINT NOT NULL,                     -- Journal ID (Foreign Key to reference the journal)
+    account_id BIGINT NOT NULL,                     -- Account ID, customer or internal system account
+    transaction_id BIGINT NULL,                     -- Customer transaction of the entry (nullable for system accounts)
+    type SMALLINT NOT NULL,                         -- Entry type (1 = Credit, 2 = Debit)
+    amount DECIMAL(15, 2) NOT NULL,                 -- Amount of the entry, always positive
+    currency SMALLINT NOT NULL DEFAULT 1,           -- Currency code (ISO 4217) e.g., 1 = IDR
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set creation timestamp
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set updated timestamp
+
+    CONSTRAINT chk_journal_entry_amount_positive CHECK (amount > 0)
+);
+
+CREATE INDEX idx_journal_entries_journal_id ON journal_entries(journal_id);
+CREATE INDEX idx_journal_entries_account_id ON journal_entries(account_id);
+
+-- Internal system accounts (account_type 2) used as the counterpart of cash movements.
+-- They only hold journal entries, their balance column is not maintained.
+INSERT INTO accounts (customer_id, account_number, account_type, status, balance, currency) VALUES
+    (0, '9000000001', 2, 1, 0, 1), -- Cash-in (setoran tunai)
+    (0, '9000000002', 2, 1, 0, 1), -- Cash-out (penarikan tunai)
+    (0, '9000000003', 2, 1, 0, 1)  -- Opening balance (saldo awal sebelum ledger)
+ON CONFLICT (account_number) DO NOTHING;
+
+-- Post the balances that existed before the ledger as a single opening journal,
+-- crediting every customer account and debiting the opening balance system account.
+WITH opening_journal AS (
+    INSERT INTO journals (description)
+    SELECT 'Saldo awal ledger'
+    WHERE EXISTS (SELECT 1 FROM accounts WHERE account_type <> 2 AND balance > 0)
+    RETURNING id
+)
+INSERT INTO journal_entries (journal_id, account_id, type, amount, currency)
+SELECT opening_journal.id, accounts.id, 1, accounts.balance, accounts.currency
+FROM opening_journal, accounts
+WHERE accounts.account_type <> 2 AND accounts.balance > 0
+UNION ALL
+SELECT opening_journal.id, system_account.id, 2, SUM(accounts.balance), accounts.currency
+FROM opening_journal, accounts, accounts AS system_account
+WHERE accounts.account_type <> 2 AND accounts.balance > 0 AND system_account.account_number = '9000000003'
+GROUP BY opening_journal.id, system_account.id, accounts.currency;
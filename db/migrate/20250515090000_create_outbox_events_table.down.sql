@@ -0,0 +1,2 @@
+-- Drop table outbox_events if exists (rollback migration)
+DROP TABLE IF EXISTS outbox_events;
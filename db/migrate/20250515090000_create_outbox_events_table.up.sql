@@ -0,0 +1,20 @@
+-- This SQL script creates a table named 'outbox_events' in the database.
+-- Domain events (e.g. AccountCreated, BalanceCredited) are written in the same database transaction
+-- as the change they describe and published later by the relay command (transactional outbox).
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id BIGSERIAL PRIMARY KEY,                           -- Auto-incrementing ID
+    aggregate_type VARCHAR(32) NOT NULL,                -- Aggregate of the event e.g. account
+    aggregate_id BIGINT NOT NULL,                       -- ID of the aggregate e.g. account ID
+    event_type VARCHAR(64) NOT NULL,                    -- Event type e.g. AccountCreated
+    payload TEXT NOT NULL,                              -- JSON encoded event payload
+    status SMALLINT NOT NULL DEFAULT 1,                 -- 1 = Pending, 2 = Delivered, 3 = Failed
+    attempts INT NOT NULL DEFAULT 0,                    -- Number of publish attempts
+    next_attempt_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Earliest time of the next publish attempt
+    delivered_at TIMESTAMP NULL,                        -- Time the event was published
+    last_error TEXT NOT NULL DEFAULT '',                -- Error of the last failed attempt
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,     -- Automatically set creation timestamp
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP      -- Automatically set updated timestamp
+);
+
+-- The relay only reads pending events that are due
+CREATE INDEX idx_outbox_events_pending ON outbox_events(next_attempt_at, id) WHERE status = 1;
@@ -0,0 +1,13 @@
+-- Revert the ISO 4217 currency codes to the smallint codes (rollback migration)
+-- Only IDR can be reverted, rows in other currencies fail the NOT NULL constraint and must be removed first
+ALTER TABLE accounts ALTER COLUMN currency DROP DEFAULT;
+ALTER TABLE accounts ALTER COLUMN currency TYPE SMALLINT USING (CASE currency WHEN 'IDR' THEN 1 END);
+ALTER TABLE accounts ALTER COLUMN currency SET DEFAULT 1;
+
+ALTER TABLE transactions ALTER COLUMN currency DROP DEFAULT;
+ALTER TABLE transactions ALTER COLUMN currency TYPE SMALLINT USING (CASE currency WHEN 'IDR' THEN 1 END);
+ALTER TABLE transactions ALTER COLUMN currency SET DEFAULT 1;
+
+ALTER TABLE journal_entries ALTER COLUMN currency DROP DEFAULT;
+ALTER TABLE journal_entries ALTER COLUMN currency TYPE SMALLINT USING (CASE currency WHEN 'IDR' THEN 1 END);
+ALTER TABLE journal_entries ALTER COLUMN currency SET DEFAULT 1;
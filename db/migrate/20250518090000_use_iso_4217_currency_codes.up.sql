@@ -0,0 +1,14 @@
+-- This SQL script replaces the smallint currency codes with ISO 4217 alphabetic codes (e.g. 1 -> 'IDR'),
+-- so accounts can be opened in other currencies (USD, SGD, EUR, JPY).
+-- Only IDR (1) existed before, any other code fails the NOT NULL constraint instead of being guessed.
+ALTER TABLE accounts ALTER COLUMN currency DROP DEFAULT;
+ALTER TABLE accounts ALTER COLUMN currency TYPE CHAR(3) USING (CASE currency WHEN 1 THEN 'IDR' END);
+ALTER TABLE accounts ALTER COLUMN currency SET DEFAULT 'IDR';
+
+ALTER TABLE transactions ALTER COLUMN currency DROP DEFAULT;
+ALTER TABLE transactions ALTER COLUMN currency TYPE CHAR(3) USING (CASE currency WHEN 1 THEN 'IDR' END);
+ALTER TABLE transactions ALTER COLUMN currency SET DEFAULT 'IDR';
+
+ALTER TABLE journal_entries ALTER COLUMN currency DROP DEFAULT;
+ALTER TABLE journal_entries ALTER COLUMN currency TYPE CHAR(3) USING (CASE currency WHEN 1 THEN 'IDR' END);
+ALTER TABLE journal_entries ALTER COLUMN currency SET DEFAULT 'IDR';
@@ -0,0 +1,12 @@
+-- Drop the conversion columns and table exchange_rates if exists (rollback migration)
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS exchange_rate_id,
+    DROP COLUMN IF EXISTS exchange_rate,
+    DROP COLUMN IF EXISTS source_amount,
+    DROP COLUMN IF EXISTS source_currency,
+    DROP COLUMN IF EXISTS target_amount,
+    DROP COLUMN IF EXISTS target_currency;
+
+DROP TABLE IF EXISTS exchange_rates;
+
+DELETE FROM accounts WHERE account_number = '9000000004' AND account_type = 2;
@@ -0,0 +1,27 @@
+-- This SQL script creates a table named 'exchange_rates' in the database.
+-- A rate applies from its effective_at until a newer rate of the same currency pair is effective.
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    id BIGSERIAL PRIMARY KEY,                           -- Auto-incrementing ID
+    base_currency CHAR(3) NOT NULL,                     -- ISO 4217 code of the base currency e.g. USD
+    quote_currency CHAR(3) NOT NULL,                    -- ISO 4217 code of the quote currency e.g. IDR
+    buy_rate DECIMAL(20, 10) NOT NULL CHECK (buy_rate > 0), -- Price the bank buys one unit of the base currency at
+    sell_rate DECIMAL(20, 10) NOT NULL CHECK (sell_rate >= buy_rate), -- Price the bank sells one unit of the base currency at
+    effective_at TIMESTAMP NOT NULL,                    -- Time the rate applies from
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,     -- Automatically set creation timestamp
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,     -- Automatically set updated timestamp
+    CONSTRAINT uq_exchange_rates_pair_effective_at UNIQUE (base_currency, quote_currency, effective_at)
+);
+
+-- The conversion applied to a cross-currency transfer, stored on both transactions of the transfer
+ALTER TABLE transactions
+    ADD COLUMN exchange_rate_id BIGINT NULL REFERENCES exchange_rates(id), -- Exchange rate the conversion used
+    ADD COLUMN exchange_rate DECIMAL(20, 10) NULL,                         -- Units of the target currency per unit of the source currency
+    ADD COLUMN source_amount DECIMAL(15, 2) NULL,                          -- Amount debited in the source currency
+    ADD COLUMN source_currency CHAR(3) NULL,                               -- ISO 4217 code of the source currency
+    ADD COLUMN target_amount DECIMAL(15, 2) NULL,                          -- Amount credited in the target currency
+    ADD COLUMN target_currency CHAR(3) NULL;                               -- ISO 4217 code of the target currency
+
+-- Internal system account holding the foreign exchange position of cross-currency transfers
+INSERT INTO accounts (customer_id, account_number, account_type, status, balance, currency) VALUES
+    (0, '9000000004', 2, 1, 0, 'IDR') -- FX position (posisi valas)
+ON CONFLICT (account_number) DO NOTHING;
@@ -0,0 +1,3 @@
+-- Drop table customer_histories and the customer search index if exists (rollback migration)
+DROP INDEX IF EXISTS idx_customers_lower_fullname;
+DROP TABLE IF EXISTS customer_histories;
@@ -0,0 +1,18 @@
+-- This SQL script creates a table named 'customer_histories' in the database.
+-- Every change of the customer profile (e.g. full name, phone number) is recorded
+-- with the field, the previous value and the new value.
+CREATE TABLE IF NOT EXISTS customer_histories (
+    id BIGSERIAL PRIMARY KEY,                       -- Auto-incrementing ID
+    customer_id BIGINT NOT NULL,                    -- Customer ID (Foreign Key to reference the customer)
+    field VARCHAR(32) NOT NULL,                     -- Changed field e.g. fullname, phone_number
+    old_value VARCHAR(255) NOT NULL,                -- Value before the change
+    new_value VARCHAR(255) NOT NULL,                -- Value after the change
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set creation timestamp
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP  -- Automatically set updated timestamp
+);
+
+-- Create an index for quick lookup by customer_id (without foreign key constraint)
+CREATE INDEX idx_customer_histories_customer_id ON customer_histories(customer_id);
+
+-- Create an index for the case-insensitive search of customers by the prefix of their name
+CREATE INDEX idx_customers_lower_fullname ON customers(LOWER(fullname) text_pattern_ops);
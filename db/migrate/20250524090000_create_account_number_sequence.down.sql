@@ -0,0 +1,2 @@
+-- Drop sequence account_number_seq if exists (rollback migration)
+DROP SEQUENCE IF EXISTS account_number_seq;
@@ -0,0 +1,3 @@
+-- This SQL script creates a sequence named 'account_number_seq' in the database.
+-- It's the body of the account numbers when SERVICE_ACCOUNT_NUMBER_BODY is sequence.
+CREATE SEQUENCE IF NOT EXISTS account_number_seq START WITH 1 INCREMENT BY 1;
@@ -0,0 +1,3 @@
+-- Drop table time_deposits and the interest expense system account if exists (rollback migration)
+DROP TABLE IF EXISTS time_deposits;
+DELETE FROM accounts WHERE account_number = '9000000005';
@@ -0,0 +1,28 @@
+-- This SQL script creates a table named 'time_deposits' in the database.
+-- A time deposit (deposito, account_type 3) is placed with the funds of a saving account (the funding account)
+-- and is locked until its maturity date, when it's paid out or rolled over according to its maturity instruction.
+CREATE TABLE IF NOT EXISTS time_deposits (
+    id BIGSERIAL PRIMARY KEY,                       -- Auto-incrementing ID
+    account_id BIGINT NOT NULL,                     -- Account ID of the time deposit (Foreign Key to reference the account)
+    funding_account_id BIGINT NOT NULL,             -- Account ID of the saving account the funds come from and are paid to
+    principal DECIMAL(15, 2) NOT NULL,              -- Placed amount of the current term
+    interest_rate DECIMAL(5, 2) NOT NULL,           -- Annual interest rate in percent e.g. 4.25
+    term_months SMALLINT NOT NULL,                  -- Term in months e.g. 1, 3, 6, 12, 24
+    maturity_instruction SMALLINT NOT NULL,         -- 1 = Pay out, 2 = Rollover principal, 3 = Rollover principal and interest
+    status SMALLINT NOT NULL DEFAULT 1,             -- 1 = Active, 2 = Paid out
+    start_date DATE NOT NULL,                       -- Start of the current term
+    maturity_date DATE NOT NULL,                    -- End of the current term
+    rollovers INT NOT NULL DEFAULT 0,               -- Number of terms renewed at maturity
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set creation timestamp
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set updated timestamp
+
+    CONSTRAINT uq_time_deposits_account_id UNIQUE(account_id)
+);
+
+-- Create an index for the maturity job, which picks up the active deposits that reached their maturity date
+CREATE INDEX idx_time_deposits_status_maturity_date ON time_deposits(status, maturity_date);
+
+-- Internal system account paying the interest of the time deposits
+INSERT INTO accounts (customer_id, account_number, account_type, status, balance, currency) VALUES
+    (0, '9000000005', 2, 1, 0, 'IDR') -- Interest expense (beban bunga)
+ON CONFLICT (account_number) DO NOTHING;
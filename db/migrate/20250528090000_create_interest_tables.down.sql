@@ -0,0 +1,4 @@
+-- Drop tables interest_accruals, interest_rate_tiers and the withholding tax system account if exists (rollback migration)
+DROP TABLE IF EXISTS interest_accruals;
+DROP TABLE IF EXISTS interest_rate_tiers;
+DELETE FROM accounts WHERE account_number = '9000000006';
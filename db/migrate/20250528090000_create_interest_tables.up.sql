@@ -0,0 +1,46 @@
+-- This SQL script creates the tables named 'interest_rate_tiers' and 'interest_accruals' in the database.
+-- The interest of an account is accrued daily on its end-of-day balance at the rate of its product tier
+-- and posted monthly as an interest transaction (type 3), minus the withholding tax (type 4).
+CREATE TABLE IF NOT EXISTS interest_rate_tiers (
+    id BIGSERIAL PRIMARY KEY,                       -- Auto-incrementing ID
+    account_type SMALLINT NOT NULL,                 -- Product of the tier e.g. 1 = Savings (tabungan)
+    currency CHAR(3) NOT NULL,                      -- ISO 4217 currency code of the product e.g. IDR
+    min_balance DECIMAL(15, 2) NOT NULL,            -- Minimum end-of-day balance of the tier (inclusive)
+    annual_rate DECIMAL(5, 2) NOT NULL,             -- Annual interest rate in percent e.g. 0.75
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set creation timestamp
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set updated timestamp
+
+    CONSTRAINT uq_interest_rate_tiers_product_min_balance UNIQUE(account_type, currency, min_balance)
+);
+
+CREATE TABLE IF NOT EXISTS interest_accruals (
+    id BIGSERIAL PRIMARY KEY,                       -- Auto-incrementing ID
+    account_id BIGINT NOT NULL,                     -- Account ID (Foreign Key to reference the account)
+    accrual_date DATE NOT NULL,                     -- The day whose end-of-day balance earned the interest
+    balance DECIMAL(15, 2) NOT NULL,                -- End-of-day balance
+    annual_rate DECIMAL(5, 2) NOT NULL,             -- Rate of the tier of the balance
+    amount DECIMAL(20, 10) NOT NULL,                -- Interest of the day, only rounded to the minor unit when posted
+    transaction_id BIGINT,                          -- Interest transaction the accrual is posted with, NULL until posted
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set creation timestamp
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set updated timestamp
+
+    CONSTRAINT uq_interest_accruals_account_id_accrual_date UNIQUE(account_id, accrual_date)
+);
+
+-- Create an index for the monthly posting, which picks up the accruals that aren't posted yet
+CREATE INDEX idx_interest_accruals_unposted ON interest_accruals(account_id, accrual_date) WHERE transaction_id IS NULL;
+
+-- Tiers of the saving accounts (account_type 1)
+INSERT INTO interest_rate_tiers (account_type, currency, min_balance, annual_rate) VALUES
+    (1, 'IDR', 0, 0),
+    (1, 'IDR', 1000000, 0.50),
+    (1, 'IDR', 10000000, 0.75),
+    (1, 'IDR', 100000000, 1.00),
+    (1, 'USD', 0, 0),
+    (1, 'USD', 1000, 0.10)
+ON CONFLICT (account_type, currency, min_balance) DO NOTHING;
+
+-- Internal system account holding the tax withheld from the interest until it's paid to the tax office
+INSERT INTO accounts (customer_id, account_number, account_type, status, balance, currency) VALUES
+    (0, '9000000006', 2, 1, 0, 'IDR') -- Withholding tax payable (utang pajak bunga)
+ON CONFLICT (account_number) DO NOTHING;
@@ -0,0 +1,3 @@
+-- Drop table fee_rules and the fee income system account if exists (rollback migration)
+DROP TABLE IF EXISTS fee_rules;
+DELETE FROM accounts WHERE account_number = '9000000007';
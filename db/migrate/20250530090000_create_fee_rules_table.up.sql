@@ -0,0 +1,33 @@
+-- This SQL script creates a table named 'fee_rules' in the database.
+-- The fee schedule of an operation (1 = Withdraw, 2 = Transfer) made through a channel by the accounts of a product.
+-- An amount is charged the rule with the highest min_amount it reaches, the fees are posted as fee transactions (type 5).
+CREATE TABLE IF NOT EXISTS fee_rules (
+    id BIGSERIAL PRIMARY KEY,                       -- Auto-incrementing ID
+    account_type SMALLINT NOT NULL,                 -- Product of the rule e.g. 1 = Savings (tabungan)
+    operation SMALLINT NOT NULL,                    -- 1 = Withdraw, 2 = Transfer
+    channel SMALLINT NOT NULL,                      -- 1 = Teller, 2 = ATM, 3 = Mobile banking, 4 = Internet banking
+    currency CHAR(3) NOT NULL,                      -- ISO 4217 currency code of the product e.g. IDR
+    min_amount DECIMAL(15, 2) NOT NULL DEFAULT 0,   -- Minimum amount of the tier (inclusive)
+    flat_fee DECIMAL(15, 2) NOT NULL DEFAULT 0,     -- Flat fee
+    percentage DECIMAL(7, 4) NOT NULL DEFAULT 0,    -- Fee in percent of the amount e.g. 0.1
+    min_fee DECIMAL(15, 2) NOT NULL DEFAULT 0,      -- Minimum fee
+    max_fee DECIMAL(15, 2) NOT NULL DEFAULT 0,      -- Maximum fee, 0 means no maximum
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set creation timestamp
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set updated timestamp
+
+    CONSTRAINT uq_fee_rules_tier UNIQUE(account_type, operation, channel, currency, min_amount)
+);
+
+-- Fees of the saving accounts (account_type 1), the operations and channels without a rule are free
+INSERT INTO fee_rules (account_type, operation, channel, currency, min_amount, flat_fee, percentage, min_fee, max_fee) VALUES
+    (1, 1, 1, 'IDR', 0, 0, 0, 0, 0),                  -- Teller withdrawal, free up to 25.000.000
+    (1, 1, 1, 'IDR', 25000000, 0, 0.05, 10000, 50000), -- Teller withdrawal, 0,05% from 25.000.000
+    (1, 1, 2, 'IDR', 0, 2500, 0, 0, 0),               -- ATM withdrawal
+    (1, 2, 2, 'IDR', 0, 6500, 0, 0, 0),               -- ATM transfer
+    (1, 1, 1, 'USD', 0, 0, 0.25, 5, 50)               -- Teller withdrawal of USD cash
+ON CONFLICT (account_type, operation, channel, currency, min_amount) DO NOTHING;
+
+-- Internal system account receiving the fees charged to the customers
+INSERT INTO accounts (customer_id, account_number, account_type, status, balance, currency) VALUES
+    (0, '9000000007', 2, 1, 0, 'IDR') -- Fee income (pendapatan biaya administrasi)
+ON CONFLICT (account_number) DO NOTHING;
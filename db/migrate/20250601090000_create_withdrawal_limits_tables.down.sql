@@ -0,0 +1,4 @@
+-- Drop tables account_withdrawal_limits, withdrawal_limits and the tier of the customers if exists (rollback migration)
+DROP TABLE IF EXISTS account_withdrawal_limits;
+DROP TABLE IF EXISTS withdrawal_limits;
+ALTER TABLE customers DROP COLUMN IF EXISTS tier;
@@ -0,0 +1,43 @@
+-- This SQL script adds the tier of the customers and creates the tables named 'withdrawal_limits' and
+-- 'account_withdrawal_limits' in the database.
+-- The withdrawals of an account are limited by the limits of its product and the tier of its customer,
+-- unless the back office overrides the limits of the account. A limit of 0 means unlimited, a product without a row
+-- can't be withdrawn from.
+ALTER TABLE customers
+    ADD COLUMN IF NOT EXISTS tier SMALLINT NOT NULL DEFAULT 1; -- 1 = Regular, 2 = Priority (nasabah prioritas)
+
+CREATE TABLE IF NOT EXISTS withdrawal_limits (
+    id BIGSERIAL PRIMARY KEY,                               -- Auto-incrementing ID
+    account_type SMALLINT NOT NULL,                         -- Product of the limits e.g. 1 = Savings (tabungan)
+    customer_tier SMALLINT NOT NULL,                        -- 1 = Regular, 2 = Priority
+    currency CHAR(3) NOT NULL,                              -- ISO 4217 currency code of the product e.g. IDR
+    max_per_transaction DECIMAL(15, 2) NOT NULL DEFAULT 0,  -- Maximum amount of a single withdrawal
+    max_daily DECIMAL(15, 2) NOT NULL DEFAULT 0,            -- Maximum amount withdrawn per day
+    max_monthly DECIMAL(15, 2) NOT NULL DEFAULT 0,          -- Maximum amount withdrawn per month
+    max_daily_count INT NOT NULL DEFAULT 0,                 -- Maximum number of withdrawals per day
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,         -- Automatically set creation timestamp
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,         -- Automatically set updated timestamp
+
+    CONSTRAINT uq_withdrawal_limits_product_tier UNIQUE(account_type, customer_tier, currency)
+);
+
+CREATE TABLE IF NOT EXISTS account_withdrawal_limits (
+    id BIGSERIAL PRIMARY KEY,                               -- Auto-incrementing ID
+    account_id BIGINT NOT NULL,                             -- Account ID (Foreign Key to reference the account)
+    max_per_transaction DECIMAL(15, 2) NOT NULL DEFAULT 0,  -- Maximum amount of a single withdrawal
+    max_daily DECIMAL(15, 2) NOT NULL DEFAULT 0,            -- Maximum amount withdrawn per day
+    max_monthly DECIMAL(15, 2) NOT NULL DEFAULT 0,          -- Maximum amount withdrawn per month
+    max_daily_count INT NOT NULL DEFAULT 0,                 -- Maximum number of withdrawals per day
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,         -- Automatically set creation timestamp
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,         -- Automatically set updated timestamp
+
+    CONSTRAINT uq_account_withdrawal_limits_account_id UNIQUE(account_id)
+);
+
+-- Limits of the saving accounts (account_type 1)
+INSERT INTO withdrawal_limits (account_type, customer_tier, currency, max_per_transaction, max_daily, max_monthly, max_daily_count) VALUES
+    (1, 1, 'IDR', 100000000, 100000000, 1000000000, 20), -- Regular
+    (1, 2, 'IDR', 500000000, 500000000, 5000000000, 50), -- Priority
+    (1, 1, 'USD', 10000, 10000, 100000, 20),
+    (1, 2, 'USD', 50000, 50000, 500000, 50)
+ON CONFLICT (account_type, customer_tier, currency) DO NOTHING;
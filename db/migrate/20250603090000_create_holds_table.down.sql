@@ -0,0 +1,4 @@
+-- Drop table holds, the hold settlement system account and the held amount of the accounts if exists (rollback migration)
+DROP TABLE IF EXISTS holds;
+DELETE FROM accounts WHERE account_number = '9000000008';
+ALTER TABLE accounts DROP COLUMN IF EXISTS held_amount;
@@ -0,0 +1,30 @@
+-- This SQL script adds the held amount of the accounts and creates a table named 'holds' in the database.
+-- A hold reserves funds of an account for a payment partner: it reduces the available balance (balance - held_amount)
+-- without changing the ledger balance, until it's captured into a debit transaction, released or expired.
+ALTER TABLE accounts
+    ADD COLUMN IF NOT EXISTS held_amount DECIMAL(15, 2) NOT NULL DEFAULT 0; -- Sum of the active holds of the account
+
+CREATE TABLE IF NOT EXISTS holds (
+    id BIGSERIAL PRIMARY KEY,                            -- Auto-incrementing ID
+    account_id BIGINT NOT NULL,                          -- Account ID (Foreign Key to reference the account)
+    amount DECIMAL(15, 2) NOT NULL,                      -- Reserved amount
+    captured_amount DECIMAL(15, 2) NOT NULL DEFAULT 0,   -- Debited amount, the rest of the amount is released
+    currency CHAR(3) NOT NULL,                           -- ISO 4217 currency code of the account e.g. IDR
+    status SMALLINT NOT NULL DEFAULT 1,                  -- 1 = Active, 2 = Captured, 3 = Released, 4 = Expired
+    reference VARCHAR(64) NOT NULL DEFAULT '',           -- Reference of the payment partner e.g. the order number
+    expires_at TIMESTAMP NOT NULL,                       -- The hold is released by the expiry job from this time
+    transaction_id BIGINT,                               -- Debit transaction of the capture
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,      -- Automatically set creation timestamp
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP       -- Automatically set updated timestamp
+);
+
+-- Create an index to tell the debits of the captured holds apart from the withdrawals
+CREATE INDEX idx_holds_transaction_id ON holds(transaction_id);
+
+-- Create an index for the expiry job, which picks up the active holds that reached their expiry
+CREATE INDEX idx_holds_status_expires_at ON holds(status, expires_at);
+
+-- Internal system account receiving the captured holds until they're settled with the payment partners
+INSERT INTO accounts (customer_id, account_number, account_type, status, balance, currency) VALUES
+    (0, '9000000008', 2, 1, 0, 'IDR') -- Hold settlement (utang settlement mitra pembayaran)
+ON CONFLICT (account_number) DO NOTHING;
@@ -0,0 +1,3 @@
+-- Drop table transaction_reversals and the correction suspense system account if exists (rollback migration)
+DROP TABLE IF EXISTS transaction_reversals;
+DELETE FROM accounts WHERE account_number = '9000000009';
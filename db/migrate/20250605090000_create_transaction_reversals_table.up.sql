@@ -0,0 +1,21 @@
+-- This SQL script creates a table named 'transaction_reversals' in the database.
+-- A transaction posted by mistake is reversed by an opposite-direction transaction linked to it
+-- (6 = Reversal debit, 7 = Reversal credit), the table records who reversed it and why.
+CREATE TABLE IF NOT EXISTS transaction_reversals (
+    id BIGSERIAL PRIMARY KEY,                       -- Auto-incrementing ID
+    transaction_id BIGINT NOT NULL,                 -- The reversed transaction
+    reversal_transaction_id BIGINT NOT NULL,        -- The transaction posted by the reversal
+    operator VARCHAR(64) NOT NULL,                  -- The back-office operator who reversed the transaction
+    reason VARCHAR(255) NOT NULL,                   -- Why the transaction was reversed
+    forced BOOLEAN NOT NULL DEFAULT FALSE,          -- Whether the reversal made the balance of the account negative
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set creation timestamp
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set updated timestamp
+
+    -- A transaction can be reversed once
+    CONSTRAINT uq_transaction_reversals_transaction_id UNIQUE(transaction_id)
+);
+
+-- Internal system account the reversals are posted against until the back office clears them
+INSERT INTO accounts (customer_id, account_number, account_type, status, balance, currency) VALUES
+    (0, '9000000009', 2, 1, 0, 'IDR') -- Correction suspense (rekening antara koreksi)
+ON CONFLICT (account_number) DO NOTHING;
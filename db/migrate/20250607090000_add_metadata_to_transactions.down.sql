@@ -0,0 +1,9 @@
+-- Drop the metadata of the transactions if exists (rollback migration)
+DROP INDEX IF EXISTS uq_transactions_channel_reference;
+
+ALTER TABLE transactions
+    DROP COLUMN IF EXISTS channel,
+    DROP COLUMN IF EXISTS reference,
+    DROP COLUMN IF EXISTS description,
+    DROP COLUMN IF EXISTS counterparty_name,
+    DROP COLUMN IF EXISTS counterparty_account_number;
@@ -0,0 +1,11 @@
+-- This SQL script adds the metadata of a transaction to the transactions table, so statements can tell
+-- a teller deposit from a partner top-up. The reference is unique per channel to reject duplicated requests.
+ALTER TABLE transactions
+    ADD COLUMN IF NOT EXISTS channel SMALLINT NOT NULL DEFAULT 0,         -- 0 = Unspecified (posted by the service), 1 = Teller, 2 = ATM, 3 = Mobile banking, 4 = Internet banking, 5 = Partner API
+    ADD COLUMN IF NOT EXISTS reference VARCHAR(64) NULL,                  -- External reference ID e.g. the slip number or the partner order ID
+    ADD COLUMN IF NOT EXISTS description VARCHAR(255) NULL,               -- Free text shown on the statement
+    ADD COLUMN IF NOT EXISTS counterparty_name VARCHAR(100) NULL,         -- The other party e.g. the depositor or the merchant
+    ADD COLUMN IF NOT EXISTS counterparty_account_number VARCHAR(34) NULL; -- Account of the other party outside of this service
+
+-- A reference can be used once per channel
+CREATE UNIQUE INDEX IF NOT EXISTS uq_transactions_channel_reference ON transactions (channel, reference) WHERE reference IS NOT NULL;
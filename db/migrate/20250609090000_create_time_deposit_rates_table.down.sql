@@ -0,0 +1,2 @@
+-- Drop table time_deposit_rates if exists (rollback migration)
+DROP TABLE IF EXISTS time_deposit_rates;
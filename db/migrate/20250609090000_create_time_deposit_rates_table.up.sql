@@ -0,0 +1,26 @@
+-- This SQL script creates a table named 'time_deposit_rates' in the database.
+-- The annual interest rate of a time deposit opened for a term in a currency, the rate is resolved by the service
+-- when the deposit is opened and kept in time_deposits for the whole placement.
+CREATE TABLE IF NOT EXISTS time_deposit_rates (
+    id BIGSERIAL PRIMARY KEY,                       -- Auto-incrementing ID
+    currency CHAR(3) NOT NULL,                      -- ISO 4217 currency code of the deposit e.g. IDR
+    term_months SMALLINT NOT NULL,                  -- Term in months (1, 3, 6, 12 or 24)
+    interest_rate DECIMAL(5, 2) NOT NULL,           -- Annual interest rate in percent e.g. 4.25
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set creation timestamp
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Automatically set updated timestamp
+
+    CONSTRAINT uq_time_deposit_rates_term UNIQUE(currency, term_months)
+);
+
+-- Rates of the time deposits, the terms and currencies without a rate can't be opened
+INSERT INTO time_deposit_rates (currency, term_months, interest_rate) VALUES
+    ('IDR', 1, 3.00),
+    ('IDR', 3, 3.25),
+    ('IDR', 6, 3.50),
+    ('IDR', 12, 3.75),
+    ('IDR', 24, 4.00),
+    ('USD', 1, 1.00),
+    ('USD', 3, 1.25),
+    ('USD', 6, 1.50),
+    ('USD', 12, 1.75)
+ON CONFLICT (currency, term_months) DO NOTHING;
@@ -0,0 +1,7 @@
+-- Drop the carried over accruals if exists (rollback migration), their remainder is lost
+ALTER TABLE interest_accruals DROP CONSTRAINT IF EXISTS uq_interest_accruals_account_id_accrual_date;
+DELETE FROM interest_accruals WHERE carried_over;
+
+ALTER TABLE interest_accruals
+    DROP COLUMN IF EXISTS carried_over,
+    ADD CONSTRAINT uq_interest_accruals_account_id_accrual_date UNIQUE(account_id, accrual_date);
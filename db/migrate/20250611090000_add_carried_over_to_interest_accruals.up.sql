@@ -0,0 +1,10 @@
+-- This SQL script lets an account have a carried over accrual next to its daily accrual of a day.
+-- The remainder below the minor unit left by the monthly posting is stored as a carried over accrual
+-- on the first day of the next month, so it adds up with the interest of that month instead of being lost.
+ALTER TABLE interest_accruals
+    ADD COLUMN IF NOT EXISTS carried_over BOOLEAN NOT NULL DEFAULT FALSE; -- TRUE for the remainder of the previous posting
+
+-- An account is accrued once per day and carries over one remainder per month
+ALTER TABLE interest_accruals DROP CONSTRAINT IF EXISTS uq_interest_accruals_account_id_accrual_date;
+ALTER TABLE interest_accruals
+    ADD CONSTRAINT uq_interest_accruals_account_id_accrual_date UNIQUE(account_id, accrual_date, carried_over);
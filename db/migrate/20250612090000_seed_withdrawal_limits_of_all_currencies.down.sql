@@ -0,0 +1,2 @@
+-- Delete the seeded withdrawal limits (rollback migration)
+DELETE FROM withdrawal_limits WHERE account_type = 1 AND currency IN ('SGD', 'EUR', 'JPY');
@@ -0,0 +1,11 @@
+-- This SQL script seeds the withdrawal limits of the saving accounts (account_type 1) in every supported currency.
+-- A withdrawal from an account whose product has no limits for the tier of its customer is rejected,
+-- so every product and tier that can be withdrawn from needs a row.
+INSERT INTO withdrawal_limits (account_type, customer_tier, currency, max_per_transaction, max_daily, max_monthly, max_daily_count) VALUES
+    (1, 1, 'SGD', 13000, 13000, 130000, 20),           -- Regular
+    (1, 2, 'SGD', 65000, 65000, 650000, 50),           -- Priority
+    (1, 1, 'EUR', 9000, 9000, 90000, 20),
+    (1, 2, 'EUR', 45000, 45000, 450000, 50),
+    (1, 1, 'JPY', 1500000, 1500000, 15000000, 20),
+    (1, 2, 'JPY', 7500000, 7500000, 75000000, 50)
+ON CONFLICT (account_type, customer_tier, currency) DO NOTHING;
@@ -0,0 +1,2 @@
+-- Drop the open date of the time deposits if exists (rollback migration)
+ALTER TABLE time_deposits DROP COLUMN IF EXISTS open_date;
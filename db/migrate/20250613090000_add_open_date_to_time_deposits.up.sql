@@ -0,0 +1,13 @@
+-- This SQL script adds the open date of the time deposits.
+-- Every term ends on the day of the month of the open date, so the maturity of a deposit opened at the end of a month
+-- doesn't drift to an earlier day after a shorter month.
+ALTER TABLE time_deposits
+    ADD COLUMN IF NOT EXISTS open_date DATE; -- Start of the first term
+
+-- The start date of a deposit never rolled over is its open date,
+-- the deposits already rolled over keep the day of their current term
+UPDATE time_deposits SET open_date = (start_date - make_interval(months => term_months * rollovers))::DATE
+WHERE open_date IS NULL;
+
+ALTER TABLE time_deposits
+    ALTER COLUMN open_date SET NOT NULL;
@@ -13,9 +13,35 @@ type AccountType int16
 // The enumeration values are:
 // 0 - Unspecified
 // 1 - Saving
+// 2 - Internal (system account of the bank, e.g. cash-in, cash-out)
+// 3 - TimeDeposit (deposito, locked until maturity)
 const (
 	AccountTypeUnspecified AccountType = iota
 	AccountTypeSaving
+	AccountTypeInternal
+	AccountTypeTimeDeposit
+)
+
+// IsOpenable checks whether customers can open an account of the type
+// Internal accounts are only created by the database migrations,
+// time deposits are only opened with the funds of a saving account
+func (t AccountType) IsOpenable() bool {
+	return t == AccountTypeSaving
+}
+
+// Account numbers of the internal system accounts
+// The system accounts are created by the database migration and only hold journal entries,
+// their balance column is not maintained to avoid locking a single row on every transaction
+const (
+	SystemAccountCashIn          = "9000000001" // cash received from customers (setoran)
+	SystemAccountCashOut         = "9000000002" // cash paid out to customers (penarikan)
+	SystemAccountOpeningBalance  = "9000000003" // counterpart of balances that existed before the ledger
+	SystemAccountFXPosition      = "9000000004" // foreign exchange position of cross-currency transfers
+	SystemAccountInterestExpense = "9000000005" // interest paid to the customers (beban bunga)
+	SystemAccountWithholdingTax  = "9000000006" // tax withheld from the interest, owed to the tax office (utang pajak)
+	SystemAccountFeeIncome       = "9000000007" // fees charged to the customers (pendapatan biaya administrasi)
+	SystemAccountHoldSettlement  = "9000000008" // captured holds owed to the payment partners (utang settlement mitra)
+	SystemAccountCorrection      = "9000000009" // counterpart of the reversals until the back office clears them (rekening antara koreksi)
 )
 
 // AccountStatus represents the status of an account
@@ -25,27 +51,151 @@ type AccountStatus int16
 // The enumeration values are:
 // 0 - Unspecified
 // 1 - Active
+// 2 - Blocked (no credit nor debit)
+// 3 - DebitBlocked (credit only)
+// 4 - Dormant (inactive for a long time, debit is rejected until reactivated)
+// 5 - Closed (final, no further transaction)
 const (
 	AccountStatusUnspecified AccountStatus = iota
 	AccountStatusActive
+	AccountStatusBlocked
+	AccountStatusDebitBlocked
+	AccountStatusDormant
+	AccountStatusClosed
 )
 
+// accountStatusTransitions lists the statuses an account can move to from its current status
+var accountStatusTransitions = map[AccountStatus][]AccountStatus{
+	AccountStatusActive:       {AccountStatusBlocked, AccountStatusDebitBlocked, AccountStatusDormant, AccountStatusClosed},
+	AccountStatusBlocked:      {AccountStatusActive, AccountStatusDebitBlocked, AccountStatusClosed},
+	AccountStatusDebitBlocked: {AccountStatusActive, AccountStatusBlocked, AccountStatusClosed},
+	AccountStatusDormant:      {AccountStatusActive, AccountStatusBlocked, AccountStatusClosed},
+	AccountStatusClosed:       {},
+}
+
+// CanTransitionTo checks whether the status can move to the target status
+func (s AccountStatus) CanTransitionTo(target AccountStatus) bool {
+	for _, status := range accountStatusTransitions[s] {
+		if status == target {
+			return true
+		}
+	}
+
+	return false
+}
+
 type Account struct {
 	ID            uint
 	CustomerID    uint
 	AccountType   AccountType
 	AccountNumber string
-	Balance       decimal.Decimal
+	Balance       decimal.Decimal // ledger balance
+	HeldAmount    decimal.Decimal // sum of the active holds, reserved but not yet debited
 	Currency      Currency
 	Status        AccountStatus
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
 }
 
+// AvailableBalance returns the balance that can be withdrawn, transferred or held,
+// i.e. the ledger balance less the amounts reserved by the active holds
+func (a Account) AvailableBalance() decimal.Decimal {
+	return a.Balance.Sub(a.HeldAmount)
+}
+
+// Balance is the balance of an account
+type Balance struct {
+	Ledger    decimal.Decimal // balance of the posted transactions
+	Available decimal.Decimal // ledger balance less the active holds
+	Currency  Currency
+}
+
+// ValidateCredit checks whether money can be deposited into the account
+// Time deposits only move with their placement and their maturity
+func (a Account) ValidateCredit() error {
+	if a.AccountType == AccountTypeTimeDeposit {
+		return ErrTimeDepositLocked
+	}
+
+	switch a.Status {
+	case AccountStatusBlocked:
+		return ErrAccountBlocked
+	case AccountStatusClosed:
+		return ErrAccountClosed
+	}
+
+	return nil
+}
+
+// ValidateDebit checks whether money can be withdrawn from the account
+// Time deposits only move with their placement and their maturity
+func (a Account) ValidateDebit() error {
+	if a.AccountType == AccountTypeTimeDeposit {
+		return ErrTimeDepositLocked
+	}
+
+	switch a.Status {
+	case AccountStatusBlocked:
+		return ErrAccountBlocked
+	case AccountStatusDebitBlocked:
+		return ErrAccountDebitBlocked
+	case AccountStatusDormant:
+		return ErrAccountDormant
+	case AccountStatusClosed:
+		return ErrAccountClosed
+	}
+
+	return nil
+}
+
+// ValidateAmount checks that the amount is in the currency of the account
+// and has no more decimal places than the currency allows
+func (a Account) ValidateAmount(amount decimal.Decimal, currency Currency) error {
+	if currency != a.Currency {
+		return ErrCurrencyMismatch
+	}
+
+	return a.Currency.ValidateAmount(amount)
+}
+
+// AccountStatusHistory represents a status change of an account
+type AccountStatusHistory struct {
+	ID         uint
+	AccountID  uint
+	FromStatus AccountStatus
+	ToStatus   AccountStatus
+	Reason     string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// UpdateAccountStatusParams represents the request to change the status of an account
+// Will be used as parameters for the use case of updating the account status
+type UpdateAccountStatusParams struct {
+	AccountNumber string
+	Status        AccountStatus
+	Reason        string
+}
+
 // CreateAccountParams represents the request to create an account
 // Will be used as parameters for the use case of creating an account
 type CreateAccountParams struct {
 	Fullname       string
 	PhoneNumber    string
+	IdentityType   CustomerIdentityType
 	IdentityNumber string
+	BirthDate      time.Time // optional, cross-checked with the birth date encoded in the NIK
+	Gender         Gender    // optional, cross-checked with the gender encoded in the NIK
+	Currency       Currency  // currency of the new account
+	IdempotencyKey string    // optional, empty means the request is not idempotent
+}
+
+// OpenAccountParams represents the request to open an additional account for an existing customer
+// Will be used as parameters for the use case of opening an account
+type OpenAccountParams struct {
+	CustomerID     uint   // zero means the customer is identified by IdentityNumber
+	IdentityNumber string // NIK of the customer, only used when CustomerID is zero
+	AccountType    AccountType
+	Currency       Currency // currency of the new account
+	IdempotencyKey string   // optional, empty means the request is not idempotent
 }
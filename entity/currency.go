@@ -1,13 +1,139 @@
 package entity
 
-// Currency represents the currency type
-type Currency int16
+import (
+	"encoding/json"
+	"strings"
 
-// Currency is an enumeration of currency types
-// The enumeration values are:
-// 0 - Unspecified
-// 1 - IDR (Indonesian Rupiah)
+	"github.com/shopspring/decimal"
+)
+
+// Currency represents the ISO 4217 alphabetic code of a currency e.g. IDR
+type Currency string
+
+// Supported currencies
 const (
-	CurrencyUnspecified Currency = iota
-	CurrencyIDR
+	CurrencyUnspecified Currency = ""
+	CurrencyIDR         Currency = "IDR" // Indonesian Rupiah
+	CurrencyUSD         Currency = "USD" // US Dollar
+	CurrencySGD         Currency = "SGD" // Singapore Dollar
+	CurrencyEUR         Currency = "EUR" // Euro
+	CurrencyJPY         Currency = "JPY" // Japanese Yen
 )
+
+// DefaultCurrency is the currency used when a request doesn't specify one
+const DefaultCurrency = CurrencyIDR
+
+// currencyMinorUnits is the number of decimal places (ISO 4217 minor unit) of every supported currency
+// Amounts are stored as DECIMAL(15, 2), so a currency with more than 2 minor units can't be supported
+var currencyMinorUnits = map[Currency]int32{
+	CurrencyIDR: 2,
+	CurrencyUSD: 2,
+	CurrencySGD: 2,
+	CurrencyEUR: 2,
+	CurrencyJPY: 0,
+}
+
+// amountMaximums are the exclusive upper bounds of a single amount in a currency
+type amountMaximums struct {
+	Deposit    decimal.Decimal // Deposits and time deposit principals
+	Withdrawal decimal.Decimal
+	Transfer   decimal.Decimal
+}
+
+// currencyMaxAmounts are the amount maximums of every supported currency,
+// worth about the same as the IDR maximums
+var currencyMaxAmounts = map[Currency]amountMaximums{
+	CurrencyIDR: {Deposit: decimal.NewFromInt(1_000_000_000), Withdrawal: decimal.NewFromInt(1_000_000_000), Transfer: decimal.NewFromInt(100_000_000)},
+	CurrencyUSD: {Deposit: decimal.NewFromInt(65_000), Withdrawal: decimal.NewFromInt(65_000), Transfer: decimal.NewFromInt(6_500)},
+	CurrencySGD: {Deposit: decimal.NewFromInt(85_000), Withdrawal: decimal.NewFromInt(85_000), Transfer: decimal.NewFromInt(8_500)},
+	CurrencyEUR: {Deposit: decimal.NewFromInt(60_000), Withdrawal: decimal.NewFromInt(60_000), Transfer: decimal.NewFromInt(6_000)},
+	CurrencyJPY: {Deposit: decimal.NewFromInt(10_000_000), Withdrawal: decimal.NewFromInt(10_000_000), Transfer: decimal.NewFromInt(1_000_000)},
+}
+
+// legacyCurrencyCodes maps the smallint codes used before ISO 4217 codes to their currency
+var legacyCurrencyCodes = map[int16]Currency{
+	1: CurrencyIDR,
+}
+
+// ParseCurrency parses a case-insensitive ISO 4217 code of a supported currency
+func ParseCurrency(code string) (Currency, error) {
+	currency := Currency(strings.ToUpper(strings.TrimSpace(code)))
+	if !currency.IsSupported() {
+		return CurrencyUnspecified, ErrUnsupportedCurrency
+	}
+
+	return currency, nil
+}
+
+// IsSupported checks if accounts can be opened in the currency
+func (c Currency) IsSupported() bool {
+	_, ok := currencyMinorUnits[c]
+	return ok
+}
+
+// MinorUnits returns the number of decimal places of the currency
+func (c Currency) MinorUnits() int32 {
+	return currencyMinorUnits[c]
+}
+
+// ValidateAmount checks that the amount has no more decimal places than the currency allows
+// e.g. 10.5 is a valid USD amount while it isn't a valid JPY amount
+func (c Currency) ValidateAmount(amount decimal.Decimal) error {
+	if !c.IsSupported() {
+		return ErrUnsupportedCurrency
+	}
+
+	if !amount.Equal(amount.Truncate(c.MinorUnits())) {
+		return ErrInvalidAmountPrecision
+	}
+
+	return nil
+}
+
+// ValidateDepositAmount checks that a deposit or time deposit principal is below the maximum of the currency
+func (c Currency) ValidateDepositAmount(amount decimal.Decimal) error {
+	return validateMaxAmount(amount, currencyMaxAmounts[c].Deposit)
+}
+
+// ValidateWithdrawalAmount checks that a withdrawal amount is below the maximum of the currency,
+// whatever the withdrawal limits of the account
+func (c Currency) ValidateWithdrawalAmount(amount decimal.Decimal) error {
+	return validateMaxAmount(amount, currencyMaxAmounts[c].Withdrawal)
+}
+
+// ValidateTransferAmount checks that a transfer amount is below the maximum of the currency
+func (c Currency) ValidateTransferAmount(amount decimal.Decimal) error {
+	return validateMaxAmount(amount, currencyMaxAmounts[c].Transfer)
+}
+
+func validateMaxAmount(amount, maximum decimal.Decimal) error {
+	if maximum.IsPositive() && amount.GreaterThanOrEqual(maximum) {
+		return ErrAmountExceedsMaximum
+	}
+
+	return nil
+}
+
+// UnmarshalJSON decodes the ISO 4217 code as well as the legacy smallint code,
+// so the responses stored with an idempotency key before ISO 4217 codes can still be replayed
+func (c *Currency) UnmarshalJSON(data []byte) error {
+	var legacyCode int16
+	if err := json.Unmarshal(data, &legacyCode); err == nil {
+		*c = legacyCurrencyCodes[legacyCode]
+		return nil
+	}
+
+	var code string
+	if err := json.Unmarshal(data, &code); err != nil {
+		return err
+	}
+
+	*c = Currency(code)
+	return nil
+}
+
+// Money is an amount in a currency
+type Money struct {
+	Amount   decimal.Decimal
+	Currency Currency
+}
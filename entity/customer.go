@@ -2,11 +2,113 @@ package entity
 
 import "time"
 
+// CustomerTier represents the service tier of a customer, which sets e.g. the withdrawal limits of their accounts
+type CustomerTier int16
+
+// CustomerTier is an enumeration of customer tiers
+// The enumeration values are:
+// 0 - Unspecified
+// 1 - Regular (the tier of new customers)
+// 2 - Priority (nasabah prioritas)
+const (
+	CustomerTierUnspecified CustomerTier = iota
+	CustomerTierRegular
+	CustomerTierPriority
+)
+
 // Customer represents a customer entity
 type Customer struct {
 	ID          uint
 	Fullname    string
 	PhoneNumber string
+	Tier        CustomerTier
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
+
+// CustomerField is a field of the customer profile that can be changed
+type CustomerField string
+
+// Fields of the customer profile recorded in the change history
+const (
+	CustomerFieldFullname    CustomerField = "fullname"
+	CustomerFieldPhoneNumber CustomerField = "phone_number"
+)
+
+// CustomerHistory represents a change of a single field of the customer profile
+type CustomerHistory struct {
+	ID         uint
+	CustomerID uint
+	Field      CustomerField
+	OldValue   string
+	NewValue   string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// CustomerProfile represents a customer with their identities and accounts
+type CustomerProfile struct {
+	Customer   *Customer
+	Identities []*CustomerIdentity
+	Accounts   []*Account
+}
+
+// UpdateCustomerParams represents the request to change the profile of a customer
+// Will be used as parameters for the use case of updating a customer
+type UpdateCustomerParams struct {
+	CustomerID  uint
+	Fullname    string // empty means unchanged
+	PhoneNumber string // empty means unchanged
+}
+
+// DefaultCustomerSearchLimit is the number of customers returned by a search when no limit is given
+const DefaultCustomerSearchLimit = 20
+
+// MaxCustomerSearchLimit is the maximum number of customers returned by a search
+const MaxCustomerSearchLimit = 100
+
+// SearchCustomersParams represents the request to search customers, the criteria are combined
+// Will be used as parameters for the use case of searching customers
+type SearchCustomersParams struct {
+	NamePrefix     string // case-insensitive prefix of the full name, empty means any name
+	PhoneNumber    string // empty means any phone number
+	IdentityNumber string // NIK of the customer, empty means any NIK
+	Limit          int
+}
+
+// CustomerFilter represents the filters used to query customers, ordered by full name
+type CustomerFilter struct {
+	ID          uint // zero means any customer
+	NamePrefix  string
+	PhoneNumber string
+	Limit       int
+}
+
+// PhoneNumberNormalizationStatus is the outcome of normalizing the stored phone number of a customer to E.164
+type PhoneNumberNormalizationStatus string
+
+// Enumeration of phone number normalization statuses
+const (
+	// PhoneNumberNormalized means the phone number was rewritten to E.164
+	PhoneNumberNormalized PhoneNumberNormalizationStatus = "NORMALIZED"
+	// PhoneNumberCollision means other customers have the same normalized phone number, the number is left unchanged
+	PhoneNumberCollision PhoneNumberNormalizationStatus = "COLLISION"
+	// PhoneNumberInvalid means the phone number can't be normalized, the number is left unchanged
+	PhoneNumberInvalid PhoneNumberNormalizationStatus = "INVALID"
+)
+
+// PhoneNumberNormalization represents a stored phone number that isn't in E.164
+type PhoneNumberNormalization struct {
+	CustomerID            uint
+	PhoneNumber           string
+	NormalizedPhoneNumber string // empty when the phone number is invalid
+	Status                PhoneNumberNormalizationStatus
+	CollidingCustomerIDs  []uint // the other customers with the same normalized phone number
+}
+
+// PhoneNumberNormalizationReport represents the result of normalizing the phone numbers of every customer
+type PhoneNumberNormalizationReport struct {
+	DryRun           bool // the phone numbers are only reported, not rewritten
+	CheckedCustomers int
+	Normalizations   []*PhoneNumberNormalization
+}
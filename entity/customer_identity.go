@@ -7,12 +7,37 @@ import "time"
 // The enumeration values are:
 // 0 - Unspecified
 // 1 - NIK (Nomor Induk Kependudukan)
+// 2 - Passport
+// 3 - KITAS (Kartu Izin Tinggal Terbatas, limited stay permit of foreigners)
+// 4 - NPWP (Nomor Pokok Wajib Pajak, taxpayer identification number)
 type CustomerIdentityType int16
 
 // Enumeration of customer identity types
 const (
 	IdentityTypeUnspecifed CustomerIdentityType = iota
 	IdentityTypeNIK
+	IdentityTypePassport
+	IdentityTypeKITAS
+	IdentityTypeNPWP
+)
+
+// IsSupported checks whether customers can be identified with the identity type
+func (t CustomerIdentityType) IsSupported() bool {
+	return t >= IdentityTypeNIK && t <= IdentityTypeNPWP
+}
+
+// MinimumCustomerAge is the minimum age of the customers registering with a NIK,
+// the age Indonesian citizens are issued their KTP
+const MinimumCustomerAge = 17
+
+// Gender represents the gender of a customer, as encoded in their NIK
+type Gender int8
+
+// Enumeration of genders
+const (
+	GenderUnspecified Gender = iota
+	GenderMale
+	GenderFemale
 )
 
 // CustomerIdentity represents the identity of a customer
@@ -24,3 +49,11 @@ type CustomerIdentity struct {
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
+
+// AddCustomerIdentityParams represents the request to attach an identity document to a registered customer
+// Will be used as parameters for the use case of adding a customer identity
+type AddCustomerIdentityParams struct {
+	CustomerID     uint
+	IdentityType   CustomerIdentityType
+	IdentityNumber string
+}
@@ -4,12 +4,25 @@ package entity
 type DomainError struct {
 	Code    string
 	Message string
+	Details map[string]string // optional context of the error, e.g. the remaining allowance of an exceeded limit
 }
 
 func (e *DomainError) Error() string {
 	return e.Message
 }
 
+// Is reports whether the target is a domain error with the same code,
+// so an error with details still matches the error it was created from
+func (e *DomainError) Is(target error) bool {
+	targetError, ok := target.(*DomainError)
+	return ok && targetError.Code == e.Code
+}
+
+// WithDetails returns a copy of the error with the details
+func (e *DomainError) WithDetails(details map[string]string) *DomainError {
+	return &DomainError{Code: e.Code, Message: e.Message, Details: details}
+}
+
 // DomainError creates a new AppError instance
 func NewDomainError(code, message string) *DomainError {
 	return &DomainError{Code: code, Message: message}
@@ -17,18 +30,95 @@ func NewDomainError(code, message string) *DomainError {
 
 var (
 	// Account-related errors
-	ErrAccountNotFound      = NewDomainError("ACCOUNT_NOT_FOUND", "Nomor rekening tidak ditemukan")
-	ErrAccountAlreadyExists = NewDomainError("ACCOUNT_ALREADY_EXISTS", "Nomor rekening sudah terdaftar")
-	ErrInsufficientBalance  = NewDomainError("ACCOUNT_INSUFFICIENT_BALANCE", "Saldo tidak mencukupi")
+	ErrAccountNotFound        = NewDomainError("ACCOUNT_NOT_FOUND", "Nomor rekening tidak ditemukan")
+	ErrAccountAlreadyExists   = NewDomainError("ACCOUNT_ALREADY_EXISTS", "Nomor rekening sudah terdaftar")
+	ErrInsufficientBalance    = NewDomainError("ACCOUNT_INSUFFICIENT_BALANCE", "Saldo tidak mencukupi")
+	ErrUnsupportedAccountType = NewDomainError("ACCOUNT_TYPE_UNSUPPORTED", "Jenis rekening tidak didukung")
+
+	// Account status-related errors
+	ErrAccountBlocked                 = NewDomainError("ACCOUNT_BLOCKED", "Rekening diblokir")
+	ErrAccountDebitBlocked            = NewDomainError("ACCOUNT_DEBIT_BLOCKED", "Rekening diblokir untuk transaksi debit")
+	ErrAccountDormant                 = NewDomainError("ACCOUNT_DORMANT", "Rekening tidak aktif (dormant)")
+	ErrAccountClosed                  = NewDomainError("ACCOUNT_CLOSED", "Rekening sudah ditutup")
+	ErrAccountBalanceNotZero          = NewDomainError("ACCOUNT_BALANCE_NOT_ZERO", "Saldo rekening harus nol untuk menutup rekening")
+	ErrInvalidAccountStatusTransition = NewDomainError("ACCOUNT_INVALID_STATUS_TRANSITION", "Perubahan status rekening tidak diizinkan")
+	ErrAccountStatusHistoryNotFound   = NewDomainError("ACCOUNT_STATUS_HISTORY_NOT_FOUND", "Riwayat status rekening tidak ditemukan")
+
+	// Time deposit-related errors
+	ErrTimeDepositLocked                = NewDomainError("TIME_DEPOSIT_LOCKED", "Dana deposito tidak dapat ditarik atau ditambah sebelum jatuh tempo")
+	ErrUnsupportedTimeDepositTerm       = NewDomainError("TIME_DEPOSIT_TERM_UNSUPPORTED", "Jangka waktu deposito tidak didukung")
+	ErrInvalidTimeDepositFundingAccount = NewDomainError("TIME_DEPOSIT_INVALID_FUNDING_ACCOUNT", "Deposito hanya dapat dibuka dari rekening tabungan")
+	ErrTimeDepositNotFound              = NewDomainError("TIME_DEPOSIT_NOT_FOUND", "Deposito tidak ditemukan")
+	ErrTimeDepositRateNotFound          = NewDomainError("TIME_DEPOSIT_RATE_NOT_FOUND", "Suku bunga deposito tidak tersedia untuk jangka waktu dan mata uang tersebut")
+
+	// Transaction reversal-related errors
+	ErrTransactionNotFound         = NewDomainError("TRANSACTION_NOT_FOUND", "Transaksi tidak ditemukan")
+	ErrTransactionAlreadyReversed  = NewDomainError("TRANSACTION_ALREADY_REVERSED", "Transaksi sudah dikoreksi")
+	ErrTransactionNotReversible    = NewDomainError("TRANSACTION_NOT_REVERSIBLE", "Hanya setoran dan penarikan yang dapat dikoreksi")
+	ErrReversalForceNotAllowed     = NewDomainError("REVERSAL_FORCE_NOT_ALLOWED", "Koreksi yang membuat saldo negatif tidak diizinkan")
+	ErrTransactionReversalNotFound = NewDomainError("TRANSACTION_REVERSAL_NOT_FOUND", "Koreksi transaksi tidak ditemukan")
+
+	// Hold-related errors
+	ErrHoldNotFound             = NewDomainError("HOLD_NOT_FOUND", "Hold dana tidak ditemukan")
+	ErrHoldNotActive            = NewDomainError("HOLD_NOT_ACTIVE", "Hold dana sudah di-capture, dilepas atau kedaluwarsa")
+	ErrHoldExpired              = NewDomainError("HOLD_EXPIRED", "Hold dana sudah kedaluwarsa")
+	ErrHoldCaptureExceedsAmount = NewDomainError("HOLD_CAPTURE_EXCEEDS_AMOUNT", "Nominal capture melebihi nominal hold dana")
+	ErrInvalidHoldDuration      = NewDomainError("HOLD_INVALID_DURATION", "Masa berlaku hold dana tidak valid")
+
+	// Interest-related errors
+	ErrInterestAlreadyAccrued = NewDomainError("INTEREST_ALREADY_ACCRUED", "Bunga rekening sudah dihitung untuk tanggal tersebut")
+
+	// Currency-related errors
+	ErrUnsupportedCurrency    = NewDomainError("CURRENCY_UNSUPPORTED", "Mata uang tidak didukung")
+	ErrCurrencyMismatch       = NewDomainError("CURRENCY_MISMATCH", "Mata uang tidak sesuai dengan rekening")
+	ErrInvalidAmountPrecision = NewDomainError("AMOUNT_INVALID_PRECISION", "Jumlah desimal nominal melebihi ketentuan mata uang")
+	ErrAmountExceedsMaximum   = NewDomainError("AMOUNT_EXCEEDS_MAXIMUM", "Nominal melebihi batas maksimum mata uang")
+
+	// Exchange rate-related errors
+	ErrExchangeRateNotFound    = NewDomainError("EXCHANGE_RATE_NOT_FOUND", "Kurs mata uang tidak ditemukan")
+	ErrInvalidExchangeRate     = NewDomainError("EXCHANGE_RATE_INVALID", "Kurs mata uang tidak valid")
+	ErrConvertedAmountTooSmall = NewDomainError("EXCHANGE_AMOUNT_TOO_SMALL", "Nominal hasil konversi terlalu kecil")
+
+	// Transfer-related errors
+	ErrTransferToSameAccount = NewDomainError("TRANSFER_SAME_ACCOUNT", "Rekening asal dan tujuan tidak boleh sama")
+
+	// Limit-related errors
+	ErrLimitExceeded                = NewDomainError("LIMIT_EXCEEDED", "Transaksi melebihi limit penarikan")
+	ErrWithdrawalLimitNotFound      = NewDomainError("WITHDRAWAL_LIMIT_NOT_FOUND", "Limit penarikan tidak ditemukan")
+	ErrWithdrawalLimitNotConfigured = NewDomainError("WITHDRAWAL_LIMIT_NOT_CONFIGURED", "Limit penarikan produk rekening belum diatur")
 
 	// Customer-related errors
 	ErrCustomerNotFound         = NewDomainError("CUSTOMER_NOT_FOUND", "Nasabah tidak ditemukan")
 	ErrPhoneNumberAlreadyExists = NewDomainError("CUSTOMER_PHONE_NUMBER_EXISTS", "Nomor telepon sudah terdaftar")
+	ErrInvalidPhoneNumber       = NewDomainError("CUSTOMER_PHONE_NUMBER_INVALID", "Nomor telepon tidak valid")
 
 	// Identity-related errors
 	ErrCustomerIdentityNotFound      = NewDomainError("CUSTOMTER_IDENTITY_NOT_FOUND", "Identitas nasabah tidak ditemukan")
-	ErrCustomerIdentityAlreadyExists = NewDomainError("CUSTOMER_IDENTITY_ALREADY_EXISTS", "NIK sudah terdaftar")
+	ErrCustomerIdentityAlreadyExists = NewDomainError("CUSTOMER_IDENTITY_ALREADY_EXISTS", "Nomor identitas sudah terdaftar")
+	ErrUnsupportedIdentityType       = NewDomainError("CUSTOMER_IDENTITY_TYPE_UNSUPPORTED", "Jenis identitas tidak didukung")
+	ErrInvalidNIK                    = NewDomainError("CUSTOMER_IDENTITY_INVALID_NIK", "NIK tidak valid")
+	ErrCustomerIdentityMismatch      = NewDomainError("CUSTOMER_IDENTITY_MISMATCH", "Data nasabah tidak sesuai dengan NIK")
+	ErrCustomerUnderAge              = NewDomainError("CUSTOMER_UNDER_AGE", "Usia nasabah belum memenuhi syarat pembukaan rekening")
+
+	// Transaction history errors
+	ErrInvalidCursor = NewDomainError("TRANSACTION_INVALID_CURSOR", "Cursor mutasi tidak valid")
+
+	// Statement-related errors
+	ErrInvalidStatementPeriod = NewDomainError("STATEMENT_INVALID_PERIOD", "Periode rekening koran tidak valid, maksimal 366 hari")
+
+	// Transaction metadata errors
+	ErrTransactionReferenceAlreadyExists = NewDomainError("TRANSACTION_REFERENCE_ALREADY_EXISTS", "Referensi transaksi sudah digunakan pada kanal yang sama")
+
+	// Ledger-related errors
+	ErrUnbalancedJournal = NewDomainError("LEDGER_UNBALANCED_JOURNAL", "Jurnal tidak seimbang")
+
+	// Idempotency-related errors
+	ErrIdempotencyKeyNotFound      = NewDomainError("IDEMPOTENCY_KEY_NOT_FOUND", "Idempotency key tidak ditemukan")
+	ErrIdempotencyKeyAlreadyExists = NewDomainError("IDEMPOTENCY_KEY_ALREADY_EXISTS", "Idempotency key sudah terdaftar")
+	ErrIdempotencyKeyReused        = NewDomainError("IDEMPOTENCY_KEY_REUSED", "Idempotency key sudah digunakan untuk permintaan yang berbeda")
 
 	// General errors
-	ErrInvalidRequest = NewDomainError("INVALID_REQUEST", "Permintaan tidak valid")
+	ErrInvalidRequest  = NewDomainError("INVALID_REQUEST", "Permintaan tidak valid")
+	ErrUnauthenticated = NewDomainError("UNAUTHENTICATED", "Petugas tidak terautentikasi")
+	ErrInternal        = NewDomainError("INTERNAL_ERROR", "Terjadi kesalahan pada sistem")
 )
@@ -0,0 +1,169 @@
+package entity
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeRatePrecision is the number of decimal places of an exchange rate
+const ExchangeRatePrecision = 10
+
+// ExchangeRatesCSVHeader is the header of the CSV file the exchange rates are loaded from
+// effective_at is formatted as RFC 3339 e.g. 2025-05-20T09:00:00+07:00
+var ExchangeRatesCSVHeader = []string{"base_currency", "quote_currency", "buy_rate", "sell_rate", "effective_at"}
+
+// ExchangeRate represents the price of one unit of the base currency in the quote currency
+// e.g. base USD and quote IDR with buy rate 16000 and sell rate 16500.
+// The bank buys the base currency at the buy rate and sells it at the (higher) sell rate,
+// the difference is the spread earned by the bank.
+type ExchangeRate struct {
+	ID            uint
+	BaseCurrency  Currency
+	QuoteCurrency Currency
+	BuyRate       decimal.Decimal
+	SellRate      decimal.Decimal
+	EffectiveAt   time.Time // the rate applies from this time until a newer rate of the pair is effective
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// Validate checks the currencies and the spread of the exchange rate
+func (r ExchangeRate) Validate() error {
+	if !r.BaseCurrency.IsSupported() || !r.QuoteCurrency.IsSupported() {
+		return ErrUnsupportedCurrency
+	}
+
+	if r.BaseCurrency == r.QuoteCurrency ||
+		!r.BuyRate.IsPositive() ||
+		r.SellRate.LessThan(r.BuyRate) ||
+		r.EffectiveAt.IsZero() {
+		return ErrInvalidExchangeRate
+	}
+
+	return nil
+}
+
+// Convert converts an amount of one currency of the pair to the other one.
+// Converting the base currency applies the buy rate, converting the quote currency applies the sell rate,
+// and the target amount is rounded down to the minor unit of the target currency.
+func (r ExchangeRate) Convert(amount decimal.Decimal, source Currency) (*CurrencyConversion, error) {
+	conversion := &CurrencyConversion{
+		ExchangeRateID: r.ID,
+		SourceAmount:   amount,
+		SourceCurrency: source,
+	}
+
+	switch source {
+	case r.BaseCurrency:
+		conversion.TargetCurrency = r.QuoteCurrency
+		conversion.Rate = r.BuyRate
+		conversion.TargetAmount = amount.Mul(r.BuyRate)
+	case r.QuoteCurrency:
+		conversion.TargetCurrency = r.BaseCurrency
+		conversion.Rate = decimal.NewFromInt(1).DivRound(r.SellRate, ExchangeRatePrecision)
+		conversion.TargetAmount = amount.DivRound(r.SellRate, ExchangeRatePrecision+conversion.TargetCurrency.MinorUnits())
+	default:
+		return nil, ErrCurrencyMismatch
+	}
+
+	conversion.TargetAmount = conversion.TargetAmount.Truncate(conversion.TargetCurrency.MinorUnits())
+	if !conversion.TargetAmount.IsPositive() {
+		return nil, ErrConvertedAmountTooSmall
+	}
+
+	return conversion, nil
+}
+
+// CurrencyConversion records the exchange applied to a cross-currency transfer
+// Both transactions of the transfer carry the same conversion
+type CurrencyConversion struct {
+	ExchangeRateID uint
+	Rate           decimal.Decimal // units of the target currency per unit of the source currency
+	SourceAmount   decimal.Decimal
+	SourceCurrency Currency
+	TargetAmount   decimal.Decimal
+	TargetCurrency Currency
+}
+
+// ParseExchangeRatesCSV parses and validates the exchange rates of a CSV file with the ExchangeRatesCSVHeader
+func ParseExchangeRatesCSV(r io.Reader) ([]*ExchangeRate, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(ExchangeRatesCSVHeader)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil || strings.Join(header, ",") != strings.Join(ExchangeRatesCSVHeader, ",") {
+		return nil, invalidExchangeRateLine(1)
+	}
+
+	var rates []*ExchangeRate
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, invalidExchangeRateLine(line)
+		}
+
+		rate, err := parseExchangeRateRecord(record)
+		if err != nil {
+			return nil, invalidExchangeRateLine(line)
+		}
+
+		rates = append(rates, rate)
+	}
+
+	if len(rates) == 0 {
+		return nil, ErrInvalidExchangeRate
+	}
+
+	return rates, nil
+}
+
+func parseExchangeRateRecord(record []string) (*ExchangeRate, error) {
+	baseCurrency, err := ParseCurrency(record[0])
+	if err != nil {
+		return nil, err
+	}
+
+	quoteCurrency, err := ParseCurrency(record[1])
+	if err != nil {
+		return nil, err
+	}
+
+	buyRate, err := decimal.NewFromString(record[2])
+	if err != nil {
+		return nil, err
+	}
+
+	sellRate, err := decimal.NewFromString(record[3])
+	if err != nil {
+		return nil, err
+	}
+
+	effectiveAt, err := time.Parse(time.RFC3339, record[4])
+	if err != nil {
+		return nil, err
+	}
+
+	rate := &ExchangeRate{
+		BaseCurrency:  baseCurrency,
+		QuoteCurrency: quoteCurrency,
+		BuyRate:       buyRate,
+		SellRate:      sellRate,
+		EffectiveAt:   effectiveAt,
+	}
+
+	return rate, rate.Validate()
+}
+
+// invalidExchangeRateLine returns ErrInvalidExchangeRate with the line of the CSV file
+func invalidExchangeRateLine(line int) *DomainError {
+	return NewDomainError(ErrInvalidExchangeRate.Code, fmt.Sprintf("%s (baris %d)", ErrInvalidExchangeRate.Message, line))
+}
@@ -0,0 +1,118 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// FeeOperation represents the operation a fee is charged for
+type FeeOperation int16
+
+// FeeOperation is an enumeration of the operations with a fee
+// The enumeration values are:
+// 0 - Unspecified
+// 1 - Withdraw (tarik tunai)
+// 2 - Transfer
+const (
+	FeeOperationUnspecified FeeOperation = iota
+	FeeOperationWithdraw
+	FeeOperationTransfer
+)
+
+// Channel represents the channel a transaction is made through
+type Channel int16
+
+// Channel is an enumeration of transaction channels
+// The enumeration values are:
+// 0 - Unspecified
+// 1 - Teller (branch counter, the default)
+// 2 - ATM
+// 3 - Mobile (mobile banking)
+// 4 - Internet (internet banking)
+// 5 - PartnerAPI (payment partners and other integrated services)
+const (
+	ChannelUnspecified Channel = iota
+	ChannelTeller
+	ChannelATM
+	ChannelMobile
+	ChannelInternet
+	ChannelPartnerAPI
+)
+
+// DefaultChannel is the channel of the requests that don't specify one
+const DefaultChannel = ChannelTeller
+
+// FeeRule represents a tier of the fee schedule of an operation made through a channel
+// by the accounts of a product (account type and currency).
+// The amount is charged the rule with the highest minimum amount it reaches, so a single rule
+// is a flat and/or percentage fee and several rules are a tiered fee
+type FeeRule struct {
+	ID          uint
+	AccountType AccountType
+	Operation   FeeOperation
+	Channel     Channel
+	Currency    Currency
+	MinAmount   decimal.Decimal // inclusive
+	FlatFee     decimal.Decimal
+	Percentage  decimal.Decimal // of the amount, in percent e.g. 0.1
+	MinFee      decimal.Decimal
+	MaxFee      decimal.Decimal // zero means no maximum
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Fee computes the fee of the amount: the flat fee plus the percentage of the amount,
+// bounded by the minimum and the maximum fee and rounded down to the minor unit of the currency
+func (r FeeRule) Fee(amount decimal.Decimal, currency Currency) decimal.Decimal {
+	fee := r.FlatFee.Add(amount.Mul(r.Percentage).Div(decimal.NewFromInt(100)))
+
+	if fee.LessThan(r.MinFee) {
+		fee = r.MinFee
+	}
+
+	if r.MaxFee.IsPositive() && fee.GreaterThan(r.MaxFee) {
+		fee = r.MaxFee
+	}
+
+	return fee.Truncate(currency.MinorUnits())
+}
+
+// CalculateFee computes the fee of the amount with the rule of the tier the amount falls into,
+// zero when the amount is below every tier or there is no rule
+func CalculateFee(rules []*FeeRule, amount decimal.Decimal, currency Currency) decimal.Decimal {
+	var matched *FeeRule
+	for _, rule := range rules {
+		if amount.LessThan(rule.MinAmount) {
+			continue
+		}
+
+		if matched == nil || rule.MinAmount.GreaterThan(matched.MinAmount) {
+			matched = rule
+		}
+	}
+
+	if matched == nil {
+		return decimal.Zero
+	}
+
+	return matched.Fee(amount, currency)
+}
+
+// FeeQuoteParams represents the request to show the fee of an operation before it's confirmed
+// Will be used as parameters for the use case of quoting a fee
+type FeeQuoteParams struct {
+	AccountNumber string
+	Operation     FeeOperation
+	Channel       Channel
+	Amount        decimal.Decimal
+	Currency      Currency // must be the currency of the account
+}
+
+// FeeQuote represents the fee an operation would be charged
+type FeeQuote struct {
+	Amount   decimal.Decimal
+	Fee      decimal.Decimal
+	Total    decimal.Decimal // debited from the account, the amount plus the fee
+	Currency Currency
+}
@@ -0,0 +1,124 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultHoldDuration is how long a hold reserves the funds when no expiry is given
+const DefaultHoldDuration = 7 * 24 * time.Hour
+
+// MaxHoldDuration is the longest a hold can reserve the funds
+const MaxHoldDuration = 30 * 24 * time.Hour
+
+// HoldStatus represents the status of a hold
+type HoldStatus int16
+
+// HoldStatus is an enumeration of hold statuses
+// The enumeration values are:
+// 0 - Unspecified
+// 1 - Active (the amount is reserved, it reduces the available balance but not the ledger balance)
+// 2 - Captured (fully or partially debited, the rest of the amount is released)
+// 3 - Released (cancelled before its expiry, nothing is debited)
+// 4 - Expired (not captured before its expiry, nothing is debited)
+const (
+	HoldStatusUnspecified HoldStatus = iota
+	HoldStatusActive
+	HoldStatusCaptured
+	HoldStatusReleased
+	HoldStatusExpired
+)
+
+// Hold represents funds of an account reserved by a payment partner to be captured later
+// The amount stays in the ledger balance of the account until it's captured into a debit transaction
+type Hold struct {
+	ID             uint
+	AccountID      uint
+	Amount         decimal.Decimal // reserved amount
+	CapturedAmount decimal.Decimal // debited amount, zero unless captured
+	Currency       Currency
+	Status         HoldStatus
+	Reference      string // optional reference of the payment partner e.g. the order number
+	ExpiresAt      time.Time
+	TransactionID  uint // debit transaction of the capture, zero unless captured
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// CaptureReference returns the reference of the debit of the capture, unique per hold
+// since the reference of the payment partner isn't
+func (h Hold) CaptureReference() string {
+	return fmt.Sprintf("hold-%d", h.ID)
+}
+
+// IsExpired checks whether the hold is still active but past its expiry at now
+func (h Hold) IsExpired(now time.Time) bool {
+	return h.Status == HoldStatusActive && !now.Before(h.ExpiresAt)
+}
+
+// ValidateCapture checks whether the amount can be captured from the hold at now
+// A hold is captured once, capturing less than its amount releases the rest
+func (h Hold) ValidateCapture(amount decimal.Decimal, now time.Time) error {
+	if h.Status != HoldStatusActive {
+		return ErrHoldNotActive
+	}
+
+	if h.IsExpired(now) {
+		return ErrHoldExpired
+	}
+
+	if amount.GreaterThan(h.Amount) {
+		return ErrHoldCaptureExceedsAmount
+	}
+
+	return nil
+}
+
+// PlaceHoldParams represents the request to reserve funds of an account
+// Will be used as parameters for the use case of placing a hold
+type PlaceHoldParams struct {
+	AccountNumber  string
+	Amount         decimal.Decimal
+	Currency       Currency      // must be the currency of the account
+	Duration       time.Duration // zero means DefaultHoldDuration
+	Reference      string
+	IdempotencyKey string // optional, empty means the request is not idempotent
+}
+
+// CaptureHoldParams represents the request to debit the funds reserved by a hold
+// Will be used as parameters for the use case of capturing a hold
+type CaptureHoldParams struct {
+	HoldID         uint
+	Amount         decimal.Decimal // zero means the full amount of the hold
+	IdempotencyKey string          // optional, empty means the request is not idempotent
+}
+
+// ReleaseHoldParams represents the request to cancel a hold
+// Will be used as parameters for the use case of releasing a hold
+type ReleaseHoldParams struct {
+	HoldID         uint
+	IdempotencyKey string // optional, empty means the request is not idempotent
+}
+
+// HoldCapture represents a captured hold with the debit transaction of the capture
+type HoldCapture struct {
+	Hold    *Hold
+	Debit   *Transaction
+	Account *Account
+}
+
+// HoldExpiry represents a hold expired by the hold expiry job
+type HoldExpiry struct {
+	HoldID        uint
+	AccountNumber string
+	Amount        decimal.Decimal
+	Currency      Currency
+}
+
+// HoldExpiryReport summarizes a run of the hold expiry job
+type HoldExpiryReport struct {
+	Date     time.Time // the holds expired at this time are released
+	Expiries []*HoldExpiry
+}
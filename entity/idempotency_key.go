@@ -0,0 +1,33 @@
+package entity
+
+import "time"
+
+// IdempotencyScope is the operation guarded by an idempotency key
+// The same key can be used once per scope
+type IdempotencyScope string
+
+// Enumeration of idempotency scopes
+const (
+	IdempotencyScopeCreateAccount   IdempotencyScope = "create_account"
+	IdempotencyScopeDeposit         IdempotencyScope = "deposit"
+	IdempotencyScopeWithdraw        IdempotencyScope = "withdraw"
+	IdempotencyScopeTransfer        IdempotencyScope = "transfer"
+	IdempotencyScopeOpenAccount     IdempotencyScope = "open_account"
+	IdempotencyScopeOpenTimeDeposit IdempotencyScope = "open_time_deposit"
+	IdempotencyScopePlaceHold       IdempotencyScope = "place_hold"
+	IdempotencyScopeCaptureHold     IdempotencyScope = "capture_hold"
+	IdempotencyScopeReleaseHold     IdempotencyScope = "release_hold"
+)
+
+// IdempotencyKey represents a key sent by a client to safely retry a request
+// The response of the first successful request is stored with the key,
+// so a retried request returns the same response instead of being executed again
+type IdempotencyKey struct {
+	ID          uint
+	Scope       IdempotencyScope
+	Key         string
+	RequestHash string // SHA-256 of the request payload
+	Response    string // JSON encoded result of the first request
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
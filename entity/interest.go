@@ -0,0 +1,128 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// InterestAccrualScale is the number of decimal places kept for the daily accrued interest,
+// the accruals are only rounded to the minor unit of the currency when they are posted
+const InterestAccrualScale = 10
+
+// InterestRateTier represents an annual interest rate of a product (account type and currency)
+// The whole end-of-day balance earns the rate of the highest tier whose minimum balance it reaches
+type InterestRateTier struct {
+	ID          uint
+	AccountType AccountType
+	Currency    Currency
+	MinBalance  decimal.Decimal // inclusive
+	AnnualRate  decimal.Decimal // in percent e.g. 0.75
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// InterestRateForBalance returns the annual rate of the tier the balance falls into,
+// zero when the balance is below every tier
+func InterestRateForBalance(tiers []*InterestRateTier, balance decimal.Decimal) decimal.Decimal {
+	var matched *InterestRateTier
+	for _, tier := range tiers {
+		if balance.LessThan(tier.MinBalance) {
+			continue
+		}
+
+		if matched == nil || tier.MinBalance.GreaterThan(matched.MinBalance) {
+			matched = tier
+		}
+	}
+
+	if matched == nil {
+		return decimal.Zero
+	}
+
+	return matched.AnnualRate
+}
+
+// DailyInterest computes the interest earned by an end-of-day balance for a single day.
+// The day count convention is actual/365: every calendar day accrues 1/365 of the annual rate,
+// also in leap years, so a full leap year earns 366/365 of the annual rate
+func DailyInterest(balance, annualRate decimal.Decimal) decimal.Decimal {
+	if !balance.IsPositive() || !annualRate.IsPositive() {
+		return decimal.Zero
+	}
+
+	return balance.
+		Mul(annualRate).
+		Div(decimal.NewFromInt(100 * DaysPerYear)).
+		Truncate(InterestAccrualScale)
+}
+
+// InterestAccrual represents the interest accrued by an account for a single day
+type InterestAccrual struct {
+	ID            uint
+	AccountID     uint
+	AccrualDate   time.Time       // the day whose end-of-day balance earned the interest
+	Balance       decimal.Decimal // end-of-day balance
+	AnnualRate    decimal.Decimal // rate of the tier of the balance, in percent
+	Amount        decimal.Decimal // not rounded to the minor unit, see InterestAccrualScale
+	TransactionID uint            // interest transaction the accrual is posted with, zero until posted
+	CarriedOver   bool            // the remainder below the minor unit of the previous posting, not earned on the day
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// InterestAccrualReport summarizes a run of the daily interest accrual
+type InterestAccrualReport struct {
+	Date           time.Time
+	Accrued        int                          // accounts accrued by this run
+	AlreadyAccrued int                          // accounts accrued by a previous run for the same date
+	Totals         map[Currency]decimal.Decimal // interest accrued by this run per currency
+}
+
+// InterestTaxPolicy represents the withholding tax (PPh final) deducted from the posted interest
+type InterestTaxPolicy struct {
+	Rate           decimal.Decimal // in percent e.g. 20
+	TaxFreeBalance decimal.Decimal // in IDR, the interest of an IDR balance up to this amount isn't taxed
+}
+
+// WithholdingTax computes the tax deducted from the interest posted to an account with the balance,
+// rounded down to the minor unit of the currency. Balances in other currencies than IDR are always taxed
+func (p InterestTaxPolicy) WithholdingTax(interest, balance decimal.Decimal, currency Currency) decimal.Decimal {
+	if currency == CurrencyIDR && balance.LessThanOrEqual(p.TaxFreeBalance) {
+		return decimal.Zero
+	}
+
+	return interest.
+		Mul(p.Rate).
+		Div(decimal.NewFromInt(100)).
+		Truncate(currency.MinorUnits())
+}
+
+// InterestPostingStatus represents the outcome of the interest posting of an account
+type InterestPostingStatus string
+
+// Enumeration of the interest posting outcomes
+const (
+	InterestPosted      InterestPostingStatus = "POSTED"
+	InterestCarriedOver InterestPostingStatus = "CARRIED_OVER" // the accrued interest is below the minor unit, posted with the next month
+	InterestFailed      InterestPostingStatus = "FAILED"       // left unposted, e.g. the account is closed, retried by the next run
+)
+
+// InterestPosting represents the interest posted to a single account
+type InterestPosting struct {
+	AccountID     uint
+	AccountNumber string
+	Interest      decimal.Decimal // gross interest credited to the account
+	Tax           decimal.Decimal // withholding tax debited from the account
+	Residual      decimal.Decimal // remainder below the minor unit carried over to the next month
+	Currency      Currency
+	Accruals      int // number of daily accruals posted
+	Status        InterestPostingStatus
+	Reason        string // domain error code, only when failed
+}
+
+// InterestPostingReport summarizes a run of the monthly interest posting
+type InterestPostingReport struct {
+	Month    time.Time // first day of the month, the accruals up to the end of the month are posted
+	Postings []*InterestPosting
+}
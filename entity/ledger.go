@@ -0,0 +1,149 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Journal represents a balanced set of ledger entries posted for a single business event
+// (e.g. a deposit, a withdrawal or a transfer).
+// Every movement of money is recorded with at least one debit and one credit leg,
+// the sum of the debits must equal the sum of the credits for every currency.
+type Journal struct {
+	ID          uint
+	Description string
+	Entries     []*JournalEntry
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// JournalEntry represents a single debit or credit leg of a journal
+// Customer accounts are liabilities of the bank: a credit increases and a debit decreases their balance,
+// so the entry type follows the type of the customer transaction it belongs to.
+type JournalEntry struct {
+	ID            uint
+	JournalID     uint
+	AccountID     uint
+	TransactionID uint // customer transaction of the leg, zero for system account legs
+	Type          TransactionType
+	Amount        decimal.Decimal
+	Currency      Currency
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewJournal creates an empty journal
+func NewJournal(description string) *Journal {
+	return &Journal{Description: description}
+}
+
+// Debit adds a debit leg to the journal
+func (j *Journal) Debit(accountID, transactionID uint, amount decimal.Decimal, currency Currency) *Journal {
+	return j.addEntry(TransactionTypeDebit, accountID, transactionID, amount, currency)
+}
+
+// Credit adds a credit leg to the journal
+func (j *Journal) Credit(accountID, transactionID uint, amount decimal.Decimal, currency Currency) *Journal {
+	return j.addEntry(TransactionTypeCredit, accountID, transactionID, amount, currency)
+}
+
+func (j *Journal) addEntry(entryType TransactionType, accountID, transactionID uint, amount decimal.Decimal, currency Currency) *Journal {
+	j.Entries = append(j.Entries, &JournalEntry{
+		AccountID:     accountID,
+		TransactionID: transactionID,
+		Type:          entryType,
+		Amount:        amount,
+		Currency:      currency,
+	})
+
+	return j
+}
+
+// Validate checks that the journal has positive legs and that
+// the debits and the credits sum to zero for every currency
+func (j Journal) Validate() error {
+	if len(j.Entries) < 2 {
+		return ErrUnbalancedJournal
+	}
+
+	sums := make(map[Currency]decimal.Decimal)
+	for _, entry := range j.Entries {
+		if !entry.Amount.IsPositive() {
+			return ErrUnbalancedJournal
+		}
+
+		switch entry.Type {
+		case TransactionTypeDebit:
+			sums[entry.Currency] = sums[entry.Currency].Add(entry.Amount)
+		case TransactionTypeCredit:
+			sums[entry.Currency] = sums[entry.Currency].Sub(entry.Amount)
+		default:
+			return ErrUnbalancedJournal
+		}
+	}
+
+	for _, sum := range sums {
+		if !sum.IsZero() {
+			return ErrUnbalancedJournal
+		}
+	}
+
+	return nil
+}
+
+// TrialBalanceLine represents the total debits and credits posted to a single account
+type TrialBalanceLine struct {
+	AccountID     uint
+	AccountNumber string
+	AccountType   AccountType
+	Currency      Currency
+	TotalDebit    decimal.Decimal
+	TotalCredit   decimal.Decimal
+}
+
+// Balance returns the credit balance of the account (credits minus debits)
+func (t TrialBalanceLine) Balance() decimal.Decimal {
+	return t.TotalCredit.Sub(t.TotalDebit)
+}
+
+// TrialBalance represents the totals of every account in the ledger
+// The ledger is balanced when the total debits equal the total credits for every currency
+type TrialBalance struct {
+	Lines       []*TrialBalanceLine
+	TotalDebit  map[Currency]decimal.Decimal
+	TotalCredit map[Currency]decimal.Decimal
+}
+
+// NewTrialBalance creates a trial balance and sums the lines per currency
+func NewTrialBalance(lines []*TrialBalanceLine) *TrialBalance {
+	trialBalance := &TrialBalance{
+		Lines:       lines,
+		TotalDebit:  make(map[Currency]decimal.Decimal),
+		TotalCredit: make(map[Currency]decimal.Decimal),
+	}
+
+	for _, line := range lines {
+		trialBalance.TotalDebit[line.Currency] = trialBalance.TotalDebit[line.Currency].Add(line.TotalDebit)
+		trialBalance.TotalCredit[line.Currency] = trialBalance.TotalCredit[line.Currency].Add(line.TotalCredit)
+	}
+
+	return trialBalance
+}
+
+// IsBalanced checks whether the total debits equal the total credits for every currency
+func (t TrialBalance) IsBalanced() bool {
+	for currency, totalDebit := range t.TotalDebit {
+		if !totalDebit.Equal(t.TotalCredit[currency]) {
+			return false
+		}
+	}
+
+	for currency, totalCredit := range t.TotalCredit {
+		if !totalCredit.Equal(t.TotalDebit[currency]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,188 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// EventType represents the type of a domain event published to downstream services
+type EventType string
+
+// Enumeration of domain event types
+const (
+	EventTypeAccountCreated  EventType = "AccountCreated"
+	EventTypeBalanceCredited EventType = "BalanceCredited"
+	EventTypeBalanceDebited  EventType = "BalanceDebited"
+)
+
+// AggregateTypeAccount is the aggregate of the account events
+const AggregateTypeAccount = "account"
+
+// OutboxStatus represents the delivery status of an outbox event
+type OutboxStatus int16
+
+// OutboxStatus is an enumeration of outbox event statuses
+// The enumeration values are:
+// 0 - Unspecified
+// 1 - Pending (waiting to be published)
+// 2 - Delivered
+// 3 - Failed (gave up after MaxOutboxAttempts)
+const (
+	OutboxStatusUnspecified OutboxStatus = iota
+	OutboxStatusPending
+	OutboxStatusDelivered
+	OutboxStatusFailed
+)
+
+// MaxOutboxAttempts is the number of publish attempts before an event is marked as failed
+const MaxOutboxAttempts = 10
+
+// Backoff between publish attempts, doubled after every failed attempt up to the maximum
+const (
+	OutboxInitialBackoff = 10 * time.Second
+	OutboxMaxBackoff     = time.Hour
+)
+
+// OutboxClaimLease is how long the events claimed by a relay are hidden from the other relays while they are published,
+// longer than publishing a default batch with the default webhook timeout so a slow batch is not published twice.
+// The events of a relay that crashed before marking them are picked up again once the lease expired.
+const OutboxClaimLease = 30 * time.Minute
+
+// OutboxEvent represents a domain event written in the same database transaction as the change it describes
+// The relay publishes pending events, so an event is never lost nor published for a rolled back change
+type OutboxEvent struct {
+	ID            uint
+	AggregateType string
+	AggregateID   uint
+	EventType     EventType
+	Payload       string // JSON encoded event payload
+	Status        OutboxStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	DeliveredAt   time.Time
+	LastError     string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// NewOutboxEvent creates a pending event of an account with the JSON encoded payload
+func NewOutboxEvent(eventType EventType, accountID uint, payload interface{}) (*OutboxEvent, error) {
+	encodedPayload, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutboxEvent{
+		AggregateType: AggregateTypeAccount,
+		AggregateID:   accountID,
+		EventType:     eventType,
+		Payload:       string(encodedPayload),
+		Status:        OutboxStatusPending,
+		NextAttemptAt: time.Now(),
+	}, nil
+}
+
+// Claim leases the event to a relay until OutboxClaimLease elapsed
+func (e *OutboxEvent) Claim(now time.Time) {
+	e.NextAttemptAt = now.Add(OutboxClaimLease)
+}
+
+// MarkDelivered marks the event as published
+func (e *OutboxEvent) MarkDelivered(now time.Time) {
+	e.Status = OutboxStatusDelivered
+	e.Attempts++
+	e.DeliveredAt = now
+	e.LastError = ""
+}
+
+// MarkFailed records a failed publish attempt and schedules the next one with an exponential backoff
+// The event is marked as failed once it reached MaxOutboxAttempts
+func (e *OutboxEvent) MarkFailed(err error, now time.Time) {
+	e.Attempts++
+	e.LastError = err.Error()
+
+	if e.Attempts >= MaxOutboxAttempts {
+		e.Status = OutboxStatusFailed
+		return
+	}
+
+	backoff := OutboxInitialBackoff << (e.Attempts - 1)
+	if backoff > OutboxMaxBackoff || backoff <= 0 {
+		backoff = OutboxMaxBackoff
+	}
+
+	e.NextAttemptAt = now.Add(backoff)
+}
+
+// AccountCreatedPayload is the payload of the AccountCreated event
+type AccountCreatedPayload struct {
+	AccountID     uint        `json:"account_id"`
+	AccountNumber string      `json:"account_number"`
+	CustomerID    uint        `json:"customer_id"`
+	AccountType   AccountType `json:"account_type"`
+	Currency      Currency    `json:"currency"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+// NewAccountCreatedPayload creates the payload of the AccountCreated event
+func NewAccountCreatedPayload(account *Account) AccountCreatedPayload {
+	return AccountCreatedPayload{
+		AccountID:     account.ID,
+		AccountNumber: account.AccountNumber,
+		CustomerID:    account.CustomerID,
+		AccountType:   account.AccountType,
+		Currency:      account.Currency,
+		CreatedAt:     account.CreatedAt,
+	}
+}
+
+// BalanceChangedPayload is the payload of the BalanceCredited and BalanceDebited events
+type BalanceChangedPayload struct {
+	AccountID      uint            `json:"account_id"`
+	AccountNumber  string          `json:"account_number"`
+	TransactionID  uint            `json:"transaction_id"`
+	Amount         decimal.Decimal `json:"amount"`
+	InitialBalance decimal.Decimal `json:"initial_balance"`
+	FinalBalance   decimal.Decimal `json:"final_balance"`
+	Currency       Currency        `json:"currency"`
+	Channel        Channel         `json:"channel,omitempty"`
+	Reference      string          `json:"reference,omitempty"`
+	Description    string          `json:"description,omitempty"`
+	OccurredAt     time.Time       `json:"occurred_at"`
+}
+
+// NewBalanceChangedEvent creates the BalanceCredited or BalanceDebited event of a transaction
+func NewBalanceChangedEvent(account *Account, transaction *Transaction) (*OutboxEvent, error) {
+	eventType := EventTypeBalanceCredited
+	if transaction.Type.IsDebit() {
+		eventType = EventTypeBalanceDebited
+	}
+
+	return NewOutboxEvent(eventType, account.ID, BalanceChangedPayload{
+		AccountID:      account.ID,
+		AccountNumber:  account.AccountNumber,
+		TransactionID:  transaction.ID,
+		Amount:         transaction.Amount,
+		InitialBalance: transaction.InitialBalance,
+		FinalBalance:   transaction.FinalBalance,
+		Currency:       transaction.Currency,
+		Channel:        transaction.Channel,
+		Reference:      transaction.Reference,
+		Description:    transaction.Description,
+		OccurredAt:     transaction.CreatedAt,
+	})
+}
+
+// RelayReport summarizes a relay of the pending outbox events
+type RelayReport struct {
+	Delivered int // events published successfully
+	Retried   int // events that failed and are scheduled for another attempt
+	Failed    int // events that reached MaxOutboxAttempts
+}
+
+// Processed returns the number of events picked up by the relay
+func (r RelayReport) Processed() int {
+	return r.Delivered + r.Retried + r.Failed
+}
@@ -0,0 +1,33 @@
+package entity
+
+import "github.com/shopspring/decimal"
+
+// ReconciliationMismatchType represents the kind of drift found between the transactions and the account balance
+type ReconciliationMismatchType string
+
+// Enumeration of reconciliation mismatch types
+const (
+	// ReconciliationBrokenChain means the initial balance of a transaction differs from the final balance of the previous one
+	ReconciliationBrokenChain ReconciliationMismatchType = "BROKEN_CHAIN"
+	// ReconciliationInvalidAmount means the final balance of a transaction differs from its initial balance plus or minus its amount
+	ReconciliationInvalidAmount ReconciliationMismatchType = "INVALID_AMOUNT"
+	// ReconciliationBalanceMismatch means the final balance of the last transaction differs from the stored account balance
+	ReconciliationBalanceMismatch ReconciliationMismatchType = "BALANCE_MISMATCH"
+)
+
+// ReconciliationMismatch represents a single drift found while replaying the transactions of an account
+type ReconciliationMismatch struct {
+	AccountID     uint
+	AccountNumber string
+	TransactionID uint // zero for a balance mismatch of an account without transactions
+	Type          ReconciliationMismatchType
+	Expected      decimal.Decimal
+	Actual        decimal.Decimal
+}
+
+// ReconciliationReport represents the result of replaying the transactions of every account
+type ReconciliationReport struct {
+	CheckedAccounts     int
+	CheckedTransactions int
+	Mismatches          []*ReconciliationMismatch
+}
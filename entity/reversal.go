@@ -0,0 +1,37 @@
+package entity
+
+import "time"
+
+// TransactionReversal records who reversed a transaction and why
+// A transaction can be reversed once
+type TransactionReversal struct {
+	ID                    uint
+	TransactionID         uint // the reversed transaction
+	ReversalTransactionID uint // the opposite-direction transaction posted by the reversal
+	Operator              string
+	Reason                string
+	Forced                bool // the reversal made the balance of the account negative
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+// ReverseTransactionParams represents the request to reverse a transaction posted by mistake
+// Will be used as parameters for the use case of reversing a transaction
+type ReverseTransactionParams struct {
+	TransactionID uint
+	Operator      string
+	Reason        string
+	Force         bool // accept a negative balance, only when the reversal policy allows it
+}
+
+// ReversalPolicy represents what happens when a reversal would make the balance of an account negative
+type ReversalPolicy struct {
+	AllowForce bool // whether an operator can force the reversal and leave the balance negative
+}
+
+// Reversal represents a reversed transaction with the transaction reversing it
+type Reversal struct {
+	Original *Transaction
+	Reversal *Transaction
+	Record   *TransactionReversal
+}
@@ -0,0 +1,150 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// MaxStatementPeriod is the longest period a single statement covers
+const MaxStatementPeriod = 366 * 24 * time.Hour
+
+// StatementFormat represents the document format a statement is rendered as
+type StatementFormat string
+
+// Enumeration of statement formats
+const (
+	StatementFormatCSV StatementFormat = "csv"
+	StatementFormatPDF StatementFormat = "pdf"
+)
+
+// DefaultStatementFormat is the format of the requests that don't specify one
+const DefaultStatementFormat = StatementFormatPDF
+
+// ContentType returns the MIME type of the format
+func (f StatementFormat) ContentType() string {
+	if f == StatementFormatCSV {
+		return "text/csv"
+	}
+
+	return "application/pdf"
+}
+
+// StatementLine represents a transaction of a statement with the balance of the account after it
+type StatementLine struct {
+	Transaction *Transaction
+	Balance     decimal.Decimal // running balance, the opening balance plus the credits minus the debits up to the transaction
+}
+
+// Statement represents the transactions (rekening koran) of an account in a period
+type Statement struct {
+	Account        *Account
+	CustomerName   string
+	From           time.Time // inclusive
+	To             time.Time // exclusive
+	OpeningBalance decimal.Decimal
+	ClosingBalance decimal.Decimal
+	TotalCredit    decimal.Decimal
+	TotalDebit     decimal.Decimal
+	Lines          []*StatementLine
+}
+
+// NewStatement creates the statement of an account from its balance at the start of the period
+// and the transactions of the period in the order they were applied to the balance
+func NewStatement(account *Account, customerName string, from, to time.Time, openingBalance decimal.Decimal, transactions []*Transaction) *Statement {
+	statement := &Statement{
+		Account:        account,
+		CustomerName:   customerName,
+		From:           from,
+		To:             to,
+		OpeningBalance: openingBalance,
+		ClosingBalance: openingBalance,
+		TotalCredit:    decimal.Zero,
+		TotalDebit:     decimal.Zero,
+		Lines:          make([]*StatementLine, 0, len(transactions)),
+	}
+
+	for _, transaction := range transactions {
+		if transaction.Type.IsDebit() {
+			statement.TotalDebit = statement.TotalDebit.Add(transaction.Amount)
+			statement.ClosingBalance = statement.ClosingBalance.Sub(transaction.Amount)
+		} else {
+			statement.TotalCredit = statement.TotalCredit.Add(transaction.Amount)
+			statement.ClosingBalance = statement.ClosingBalance.Add(transaction.Amount)
+		}
+
+		statement.Lines = append(statement.Lines, &StatementLine{
+			Transaction: transaction,
+			Balance:     statement.ClosingBalance,
+		})
+	}
+
+	return statement
+}
+
+// LastDay returns the last day covered by the statement
+func (s Statement) LastDay() time.Time {
+	return s.To.AddDate(0, 0, -1)
+}
+
+// GenerateStatementParams represents the request to generate the statement of an account
+// Will be used as parameters for the use case of generating a statement
+type GenerateStatementParams struct {
+	AccountNumber string
+	From          time.Time // inclusive, the start of a day
+	To            time.Time // exclusive, the start of the day after the last day
+	Format        StatementFormat
+}
+
+// Validate checks that the period is not empty and not longer than MaxStatementPeriod
+func (p GenerateStatementParams) Validate() error {
+	if !p.From.Before(p.To) || p.To.Sub(p.From) > MaxStatementPeriod {
+		return ErrInvalidStatementPeriod
+	}
+
+	return nil
+}
+
+// StatementDocument represents a statement rendered in a format
+type StatementDocument struct {
+	Statement *Statement
+	Format    StatementFormat
+	Content   []byte
+}
+
+// Filename returns the name the document is saved or downloaded as
+// e.g. rekening-koran-1234567897-20250501-20250531.pdf
+func (d StatementDocument) Filename() string {
+	return fmt.Sprintf(
+		"rekening-koran-%s-%s-%s.%s",
+		d.Statement.Account.AccountNumber,
+		d.Statement.From.Format("20060102"),
+		d.Statement.LastDay().Format("20060102"),
+		d.Format,
+	)
+}
+
+// GeneratedStatement represents a statement saved by the month-end statement job
+type GeneratedStatement struct {
+	AccountNumber  string
+	Filename       string
+	Transactions   int
+	ClosingBalance decimal.Decimal
+	Currency       Currency
+}
+
+// FailedStatement represents a statement the month-end statement job couldn't generate or save
+type FailedStatement struct {
+	AccountNumber string
+	Format        StatementFormat
+	Reason        string // domain error code, or the error message of an unexpected error
+}
+
+// StatementBatchReport summarizes a run of the month-end statement job
+type StatementBatchReport struct {
+	Month     time.Time
+	Generated []*GeneratedStatement
+	Failed    []*FailedStatement
+	Skipped   int // accounts opened after the month or closed before it
+}
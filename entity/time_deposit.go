@@ -0,0 +1,165 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// TimeDepositTerms are the terms in months a time deposit can be opened for
+var TimeDepositTerms = []int{1, 3, 6, 12, 24}
+
+// DaysPerYear is the day count basis of the interest of the time deposits and the saving accounts (actual/365)
+const DaysPerYear = 365
+
+// MaturityInstruction represents what happens to a time deposit at maturity
+type MaturityInstruction int16
+
+// MaturityInstruction is an enumeration of maturity instructions
+// The enumeration values are:
+// 0 - Unspecified
+// 1 - PayOut (the principal and the interest are paid to the funding account, the deposit is closed)
+// 2 - RolloverPrincipal (ARO pokok, the interest is paid to the funding account, the principal is placed again)
+// 3 - RolloverPrincipalAndInterest (ARO pokok dan bunga, the principal and the interest are placed again)
+const (
+	MaturityInstructionUnspecified MaturityInstruction = iota
+	MaturityInstructionPayOut
+	MaturityInstructionRolloverPrincipal
+	MaturityInstructionRolloverPrincipalAndInterest
+)
+
+// TimeDepositStatus represents the status of a time deposit
+type TimeDepositStatus int16
+
+// TimeDepositStatus is an enumeration of time deposit statuses
+// The enumeration values are:
+// 0 - Unspecified
+// 1 - Active (placed until the maturity date, renewed by a rollover)
+// 2 - PaidOut (paid to the funding account at maturity, the deposit account is closed)
+const (
+	TimeDepositStatusUnspecified TimeDepositStatus = iota
+	TimeDepositStatusActive
+	TimeDepositStatusPaidOut
+)
+
+// TimeDeposit represents the placement of a time deposit (deposito) account
+// The funds are moved from a saving account (the funding account) and can't be withdrawn until the maturity date
+type TimeDeposit struct {
+	ID                  uint
+	AccountID           uint
+	FundingAccountID    uint
+	Principal           decimal.Decimal // placed amount, includes the interest of the previous terms when rolled over
+	InterestRate        decimal.Decimal // annual rate in percent e.g. 4.25
+	TermMonths          int
+	MaturityInstruction MaturityInstruction
+	Status              TimeDepositStatus
+	OpenDate            time.Time // start of the first term, the terms are anchored to its day of the month
+	StartDate           time.Time // start of the current term
+	MaturityDate        time.Time // end of the current term
+	Rollovers           int       // number of terms renewed at maturity
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// IsSupportedTimeDepositTerm checks whether a time deposit can be opened for the term
+func IsSupportedTimeDepositTerm(termMonths int) bool {
+	for _, term := range TimeDepositTerms {
+		if term == termMonths {
+			return true
+		}
+	}
+
+	return false
+}
+
+// TimeDepositMaturityDate returns the end of a term starting at the start date
+// A term ends on the same day of the month, or on the last day of the month when it has no such day
+// e.g. a 1 month term starting on 31 January ends on 28 February
+func TimeDepositMaturityDate(startDate time.Time, termMonths int) time.Time {
+	year, month, day := startDate.Date()
+	firstOfMonth := time.Date(year, month+time.Month(termMonths), 1, 0, 0, 0, 0, startDate.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
+
+// IsMatured checks whether the current term has ended at now
+func (d TimeDeposit) IsMatured(now time.Time) bool {
+	return d.Status == TimeDepositStatusActive && !now.Before(d.MaturityDate)
+}
+
+// Interest computes the simple interest of the current term on the actual number of days (actual/365),
+// rounded down to the minor unit of the currency
+func (d TimeDeposit) Interest(currency Currency) decimal.Decimal {
+	days := int64(d.MaturityDate.Sub(d.StartDate).Hours() / 24)
+
+	return d.Principal.
+		Mul(d.InterestRate).
+		Mul(decimal.NewFromInt(days)).
+		Div(decimal.NewFromInt(100 * DaysPerYear)).
+		Truncate(currency.MinorUnits())
+}
+
+// Rollover renews the deposit for another term starting at the maturity date
+// The interest, net of the withholding tax, is added to the principal when the instruction rolls it over.
+// The term ends on the day of the month of the open date, so a deposit opened on 31 January
+// matures on 28 February then on 31 March instead of drifting to the 28th
+func (d *TimeDeposit) Rollover(interest decimal.Decimal) {
+	if d.MaturityInstruction == MaturityInstructionRolloverPrincipalAndInterest {
+		d.Principal = d.Principal.Add(interest)
+	}
+
+	d.Rollovers++
+	d.StartDate = d.MaturityDate
+	d.MaturityDate = TimeDepositMaturityDate(d.OpenDate, d.TermMonths*(d.Rollovers+1))
+}
+
+// OpenTimeDepositParams represents the request to open a time deposit with the funds of a saving account
+// Will be used as parameters for the use case of opening a time deposit
+type OpenTimeDepositParams struct {
+	FundingAccountNumber string
+	Principal            decimal.Decimal
+	Currency             Currency // must be the currency of the funding account
+	TermMonths           int      // the interest rate is the rate of the term in time_deposit_rates
+	MaturityInstruction  MaturityInstruction
+	IdempotencyKey       string // optional, empty means the request is not idempotent
+}
+
+// TimeDepositAccount represents a time deposit with its account and its funding account
+type TimeDepositAccount struct {
+	Account        *Account
+	FundingAccount *Account
+	TimeDeposit    *TimeDeposit
+}
+
+// TimeDepositMaturityStatus represents the outcome of a time deposit at maturity
+type TimeDepositMaturityStatus string
+
+// Enumeration of the maturity outcomes
+const (
+	TimeDepositPaidOut    TimeDepositMaturityStatus = "PAID_OUT"    // paid to the funding account, the deposit is closed
+	TimeDepositRolledOver TimeDepositMaturityStatus = "ROLLED_OVER" // renewed for another term
+	TimeDepositFailed     TimeDepositMaturityStatus = "FAILED"      // left unchanged, e.g. the funding account is closed, retried by the next run
+)
+
+// TimeDepositMaturity represents the maturity of a single time deposit
+type TimeDepositMaturity struct {
+	TimeDepositID    uint
+	AccountNumber    string
+	Principal        decimal.Decimal // principal of the matured term
+	Interest         decimal.Decimal // gross interest of the matured term
+	Tax              decimal.Decimal // withholding tax deducted from the interest
+	Currency         Currency
+	Status           TimeDepositMaturityStatus
+	NextMaturityDate time.Time // zero unless rolled over
+	Reason           string    // domain error code, only when failed
+}
+
+// TimeDepositMaturityReport summarizes a run of the time deposit maturity job
+type TimeDepositMaturityReport struct {
+	Date       time.Time // the deposits matured at this time are processed
+	Maturities []*TimeDepositMaturity
+}
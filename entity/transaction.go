@@ -1,6 +1,10 @@
 package entity
 
 import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/shopspring/decimal"
@@ -14,20 +18,243 @@ type TransactionType int16
 // 0 - Unspecified
 // 1 - Credit
 // 2 - Debit
+// 3 - Interest (credit of the monthly interest)
+// 4 - WithholdingTax (debit of the tax withheld from the monthly interest)
+// 5 - Fee (debit of the fee charged for a withdrawal or a transfer)
+// 6 - ReversalDebit (debit reversing a credit transaction posted by mistake)
+// 7 - ReversalCredit (credit reversing a debit transaction posted by mistake)
 const (
 	TransactionTypeUnspecified TransactionType = iota
 	TransactionTypeCredit
 	TransactionTypeDebit
+	TransactionTypeInterest
+	TransactionTypeWithholdingTax
+	TransactionTypeFee
+	TransactionTypeReversalDebit
+	TransactionTypeReversalCredit
 )
 
+// IsDebit checks whether the transaction decreases the balance of the account
+func (t TransactionType) IsDebit() bool {
+	return t == TransactionTypeDebit || t == TransactionTypeWithholdingTax || t == TransactionTypeFee || t == TransactionTypeReversalDebit
+}
+
+// IsReversal checks whether the transaction reverses another transaction
+func (t TransactionType) IsReversal() bool {
+	return t == TransactionTypeReversalDebit || t == TransactionTypeReversalCredit
+}
+
+// ReversalType returns the type of the transaction reversing a transaction of the type
+func (t TransactionType) ReversalType() TransactionType {
+	if t.IsDebit() {
+		return TransactionTypeReversalCredit
+	}
+
+	return TransactionTypeReversalDebit
+}
+
 type Transaction struct {
-	ID             uint
-	AccountID      uint
-	Type           TransactionType
+	ID                  uint
+	AccountID           uint
+	Type                TransactionType
+	Amount              decimal.Decimal
+	InitialBalance      decimal.Decimal
+	FinalBalance        decimal.Decimal
+	Currency            Currency
+	LinkedTransactionID uint                // counterpart of a transfer, the transaction a fee is charged for or the transaction a reversal reverses, zero when not linked
+	Conversion          *CurrencyConversion // exchange of a cross-currency transfer, nil when not converted
+	Channel             Channel             // unspecified for the transactions posted by the service e.g. interest
+	Reference           string              // external reference ID, unique per channel, empty when not given
+	Description         string              // free text shown on the statement, empty when not given
+	Counterparty        *Counterparty       // the other party of a deposit or a withdrawal, nil when not given
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// IsReversible checks whether the transaction can be reversed on its own: a deposit or a withdrawal made through
+// a channel. The legs of transfers and time deposits, fees and the transactions posted by the service
+// (e.g. interest) are part of a movement with other legs, reversing a single leg would create money
+func (t Transaction) IsReversible() bool {
+	return (t.Type == TransactionTypeCredit || t.Type == TransactionTypeDebit) && t.LinkedTransactionID == 0 && t.Channel != ChannelUnspecified
+}
+
+// Counterparty represents the other party of a transaction e.g. the depositor at the teller
+// or the merchant of a partner top-up, the account is outside of this service
+type Counterparty struct {
+	Name          string
+	AccountNumber string // optional
+}
+
+// Transfer represents the linked debit and credit transactions
+// created when money is moved from one account to another
+type Transfer struct {
+	Debit  *Transaction
+	Credit *Transaction
+	Fee    *Transaction // charged to the source account, nil when the transfer is free
+}
+
+// SourceBalance returns the balance of the source account after the transfer and its fee
+func (t Transfer) SourceBalance() decimal.Decimal {
+	if t.Fee != nil {
+		return t.Fee.FinalBalance
+	}
+
+	return t.Debit.FinalBalance
+}
+
+// FeeAmount returns the fee charged for the transfer, zero when the transfer is free
+func (t Transfer) FeeAmount() decimal.Decimal {
+	if t.Fee != nil {
+		return t.Fee.Amount
+	}
+
+	return decimal.Zero
+}
+
+// Withdrawal represents the debit transaction created when money is withdrawn from an account
+type Withdrawal struct {
+	Debit *Transaction
+	Fee   *Transaction // nil when the withdrawal is free
+}
+
+// Balance returns the balance of the account after the withdrawal and its fee
+func (w Withdrawal) Balance() decimal.Decimal {
+	if w.Fee != nil {
+		return w.Fee.FinalBalance
+	}
+
+	return w.Debit.FinalBalance
+}
+
+// FeeAmount returns the fee charged for the withdrawal, zero when the withdrawal is free
+func (w Withdrawal) FeeAmount() decimal.Decimal {
+	if w.Fee != nil {
+		return w.Fee.Amount
+	}
+
+	return decimal.Zero
+}
+
+// TransferParams represents the request to transfer money between accounts
+// Will be used as parameters for the use case of transferring money
+type TransferParams struct {
+	SourceAccountNumber      string
+	DestinationAccountNumber string
+	Amount                   decimal.Decimal
+	Currency                 Currency // must be the currency of the source account, converted when the destination differs
+	Channel                  Channel
+	IdempotencyKey           string // optional, empty means the request is not idempotent
+}
+
+// DepositParams represents the request to deposit money into an account
+// Will be used as parameters for the use case of depositing money
+type DepositParams struct {
+	AccountNumber  string
+	Amount         decimal.Decimal
+	Currency       Currency // must be the currency of the account
+	Channel        Channel
+	Reference      string        // optional, a reference repeated on the same channel is rejected
+	Description    string        // optional
+	Counterparty   *Counterparty // optional
+	IdempotencyKey string        // optional, empty means the request is not idempotent
+}
+
+// WithdrawParams represents the request to withdraw money from an account
+// Will be used as parameters for the use case of withdrawing money
+type WithdrawParams struct {
+	AccountNumber  string
 	Amount         decimal.Decimal
-	InitialBalance decimal.Decimal
-	FinalBalance   decimal.Decimal
-	Currency       Currency
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	Currency       Currency // must be the currency of the account
+	Channel        Channel
+	Reference      string        // optional, a reference repeated on the same channel is rejected
+	Description    string        // optional
+	Counterparty   *Counterparty // optional
+	IdempotencyKey string        // optional, empty means the request is not idempotent
+}
+
+// DefaultTransactionPageSize is the number of transactions returned per page when no limit is given
+const DefaultTransactionPageSize = 20
+
+// MaxTransactionPageSize is the maximum number of transactions returned per page
+const MaxTransactionPageSize = 100
+
+// ListTransactionsParams represents the request to list the transactions of an account
+// Will be used as parameters for the use case of listing the transaction history (mutasi)
+type ListTransactionsParams struct {
+	AccountNumber string
+	Type          TransactionType // zero value means every type
+	StartTime     time.Time       // inclusive, zero value means unbounded
+	EndTime       time.Time       // exclusive, zero value means unbounded
+	MinAmount     decimal.Decimal // inclusive, zero value means unbounded
+	MaxAmount     decimal.Decimal // inclusive, zero value means unbounded
+	Cursor        string          // opaque cursor returned by the previous page
+	Limit         int
+}
+
+// TransactionFilter represents the filters used to query the transactions of an account
+// The transactions are always ordered from the newest to the oldest
+type TransactionFilter struct {
+	AccountID uint
+	Type      TransactionType
+	StartTime time.Time
+	EndTime   time.Time
+	MinAmount decimal.Decimal
+	MaxAmount decimal.Decimal
+	After     *TransactionCursor // only return transactions older than this cursor
+	Limit     int
+}
+
+// TransactionPage represents a single page of the transaction history
+type TransactionPage struct {
+	Transactions []*Transaction
+	NextCursor   string // empty when there is no next page
+}
+
+// TransactionCursor points to the last transaction of a page.
+// Transactions are ordered by (created_at, id) so the cursor is stable while new transactions are written.
+type TransactionCursor struct {
+	CreatedAt time.Time
+	ID        uint
+}
+
+// NewTransactionCursor creates a cursor pointing to the given transaction
+func NewTransactionCursor(transaction *Transaction) *TransactionCursor {
+	return &TransactionCursor{
+		CreatedAt: transaction.CreatedAt,
+		ID:        transaction.ID,
+	}
+}
+
+// Encode encodes the cursor into an opaque string
+func (c TransactionCursor) Encode() string {
+	raw := fmt.Sprintf("%d:%d", c.CreatedAt.UnixNano(), c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeTransactionCursor decodes an opaque string created by TransactionCursor.Encode
+func DecodeTransactionCursor(cursor string) (*TransactionCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	createdAt, id, found := strings.Cut(string(raw), ":")
+	if !found {
+		return nil, ErrInvalidCursor
+	}
+
+	unixNano, err := strconv.ParseInt(createdAt, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	transactionID, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &TransactionCursor{
+		CreatedAt: time.Unix(0, unixNano).UTC(),
+		ID:        uint(transactionID),
+	}, nil
 }
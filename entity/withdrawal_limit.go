@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// LimitType represents a withdrawal limit that can be exceeded
+type LimitType string
+
+// Enumeration of the withdrawal limits
+const (
+	LimitPerTransaction LimitType = "PER_TRANSACTION"
+	LimitDaily          LimitType = "DAILY"       // cumulative amount withdrawn today
+	LimitMonthly        LimitType = "MONTHLY"     // cumulative amount withdrawn this month
+	LimitDailyCount     LimitType = "DAILY_COUNT" // number of withdrawals today
+)
+
+// WithdrawalLimit represents the withdrawal limits of a product (account type and currency) for a customer tier,
+// or of a single account when the back office overrides the limits of its product.
+// A zero limit means unlimited
+type WithdrawalLimit struct {
+	ID                uint
+	AccountType       AccountType  // only set on the limits of a product
+	CustomerTier      CustomerTier // only set on the limits of a product
+	Currency          Currency     // only set on the limits of a product
+	AccountID         uint         // only set on the override of an account
+	MaxPerTransaction decimal.Decimal
+	MaxDaily          decimal.Decimal
+	MaxMonthly        decimal.Decimal
+	MaxDailyCount     int
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// WithdrawalUsage represents the withdrawals already made from an account
+type WithdrawalUsage struct {
+	DailyAmount   decimal.Decimal
+	DailyCount    int
+	MonthlyAmount decimal.Decimal // including the withdrawals of today
+}
+
+// Check checks whether withdrawing the amount on top of the usage stays within the limits
+// returns ErrLimitExceeded with the exceeded limit and the remaining allowance of the limit as details
+func (l WithdrawalLimit) Check(amount decimal.Decimal, usage WithdrawalUsage) error {
+	if l.MaxPerTransaction.IsPositive() && amount.GreaterThan(l.MaxPerTransaction) {
+		return newLimitExceededError(LimitPerTransaction, l.MaxPerTransaction.String())
+	}
+
+	if l.MaxDailyCount > 0 && usage.DailyCount >= l.MaxDailyCount {
+		return newLimitExceededError(LimitDailyCount, "0")
+	}
+
+	if l.MaxDaily.IsPositive() && usage.DailyAmount.Add(amount).GreaterThan(l.MaxDaily) {
+		return newLimitExceededError(LimitDaily, remainingAllowance(l.MaxDaily, usage.DailyAmount).String())
+	}
+
+	if l.MaxMonthly.IsPositive() && usage.MonthlyAmount.Add(amount).GreaterThan(l.MaxMonthly) {
+		return newLimitExceededError(LimitMonthly, remainingAllowance(l.MaxMonthly, usage.MonthlyAmount).String())
+	}
+
+	return nil
+}
+
+// remainingAllowance returns the amount left of a limit, never below zero
+// e.g. when the limit of an account was lowered after the withdrawals
+func remainingAllowance(limit, used decimal.Decimal) decimal.Decimal {
+	return decimal.Max(limit.Sub(used), decimal.Zero)
+}
+
+func newLimitExceededError(limitType LimitType, remaining string) error {
+	return ErrLimitExceeded.WithDetails(map[string]string{
+		"limit":     string(limitType),
+		"remaining": remaining,
+	})
+}
+
+// SetWithdrawalLimitParams represents the request to override the withdrawal limits of an account
+// Will be used as parameters for the use case of setting the withdrawal limits of an account
+type SetWithdrawalLimitParams struct {
+	AccountNumber     string
+	MaxPerTransaction decimal.Decimal // zero means unlimited
+	MaxDaily          decimal.Decimal // zero means unlimited
+	MaxMonthly        decimal.Decimal // zero means unlimited
+	MaxDailyCount     int             // zero means unlimited
+}
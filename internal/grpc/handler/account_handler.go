@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"imansohibul.my.id/account-domain-service/entity"
+	resthandler "imansohibul.my.id/account-domain-service/internal/rest/handler"
+	accountv1 "imansohibul.my.id/account-domain-service/proto/account/v1"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// accountHandler implements the AccountService with the usecases of the REST API
+// The requests are validated with the rules of the REST request bodies
+type accountHandler struct {
+	accountv1.UnimplementedAccountServiceServer
+
+	createAccountUsecase resthandler.CreateAccountUsecase
+	depositUsecase       resthandler.DepositUsecase
+	withdrawUsecase      resthandler.WithdrawUsecase
+	getBalanceUsecase    resthandler.GetBalanceUsecase
+}
+
+func NewAccountHandler(
+	createAccountUsecase resthandler.CreateAccountUsecase,
+	depositUsecase resthandler.DepositUsecase,
+	withdrawUsecase resthandler.WithdrawUsecase,
+	getBalanceUsecase resthandler.GetBalanceUsecase,
+) *accountHandler {
+	return &accountHandler{
+		createAccountUsecase: createAccountUsecase,
+		depositUsecase:       depositUsecase,
+		withdrawUsecase:      withdrawUsecase,
+		getBalanceUsecase:    getBalanceUsecase,
+	}
+}
+
+func (a accountHandler) CreateAccount(ctx context.Context, in *accountv1.CreateAccountRequest) (*accountv1.CreateAccountResponse, error) {
+	req := &resthandler.CreateAccountRequest{
+		Fullname:       in.GetFullname(),
+		PhoneNumber:    in.GetPhoneNumber(),
+		IdentityType:   in.GetIdentityType(),
+		IdentityNumber: in.GetIdentityNumber(),
+		BirthDate:      in.GetBirthDate(),
+		Gender:         in.GetGender(),
+		Currency:       in.GetCurrency(),
+		IdempotencyKey: in.GetIdempotencyKey(),
+	}
+
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+
+	account, err := a.createAccountUsecase.CreateAccount(ctx, &entity.CreateAccountParams{
+		Fullname:       req.Fullname,
+		PhoneNumber:    req.PhoneNumber,
+		IdentityType:   req.GetIdentityType(),
+		IdentityNumber: req.GetIdentityNumber(),
+		BirthDate:      req.GetBirthDate(),
+		Gender:         req.GetGender(),
+		Currency:       req.GetCurrency(),
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &accountv1.CreateAccountResponse{
+		AccountNumber: account.AccountNumber,
+		Currency:      string(account.Currency),
+	}, nil
+}
+
+func (a accountHandler) Deposit(ctx context.Context, in *accountv1.DepositRequest) (*accountv1.DepositResponse, error) {
+	amount, err := parseAmount(in.GetAmount())
+	if err != nil {
+		return nil, err
+	}
+
+	req := &resthandler.DepositRequest{
+		AccountNumber:              in.GetAccountNumber(),
+		Amount:                     amount,
+		Currency:                   in.GetCurrency(),
+		IdempotencyKey:             in.GetIdempotencyKey(),
+		TransactionMetadataRequest: toTransactionMetadataRequest(in),
+	}
+
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+
+	transaction, err := a.depositUsecase.Deposit(ctx, &entity.DepositParams{
+		AccountNumber:  req.AccountNumber,
+		Amount:         req.GetAmount(),
+		Currency:       req.GetCurrency(),
+		Channel:        req.GetChannel(),
+		Reference:      req.Reference,
+		Description:    req.Description,
+		Counterparty:   req.GetCounterparty(),
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &accountv1.DepositResponse{
+		Balance:     transaction.FinalBalance.String(),
+		Currency:    string(transaction.Currency),
+		Transaction: toTransactionMessage(transaction),
+	}, nil
+}
+
+func (a accountHandler) Withdraw(ctx context.Context, in *accountv1.WithdrawRequest) (*accountv1.WithdrawResponse, error) {
+	amount, err := parseAmount(in.GetAmount())
+	if err != nil {
+		return nil, err
+	}
+
+	req := &resthandler.WithdrawRequest{
+		AccountNumber:              in.GetAccountNumber(),
+		Amount:                     amount,
+		Currency:                   in.GetCurrency(),
+		IdempotencyKey:             in.GetIdempotencyKey(),
+		TransactionMetadataRequest: toTransactionMetadataRequest(in),
+	}
+
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+
+	withdrawal, err := a.withdrawUsecase.Withdraw(ctx, &entity.WithdrawParams{
+		AccountNumber:  req.AccountNumber,
+		Amount:         req.GetAmount(),
+		Currency:       req.GetCurrency(),
+		Channel:        req.GetChannel(),
+		Reference:      req.Reference,
+		Description:    req.Description,
+		Counterparty:   req.GetCounterparty(),
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &accountv1.WithdrawResponse{
+		Balance:     withdrawal.Balance().String(),
+		Currency:    string(withdrawal.Debit.Currency),
+		Fee:         withdrawal.FeeAmount().String(),
+		Transaction: toTransactionMessage(withdrawal.Debit),
+	}, nil
+}
+
+func (a accountHandler) GetBalance(ctx context.Context, in *accountv1.GetBalanceRequest) (*accountv1.GetBalanceResponse, error) {
+	req := &resthandler.GetBalanceRequest{
+		AccountNumber: in.GetAccountNumber(),
+	}
+
+	if err := validate(req); err != nil {
+		return nil, err
+	}
+
+	balance, err := a.getBalanceUsecase.GetBalance(ctx, req.AccountNumber)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &accountv1.GetBalanceResponse{
+		Balance:          balance.Ledger.String(),
+		Currency:         string(balance.Currency),
+		AvailableBalance: balance.Available.String(),
+	}, nil
+}
+
+// transactionMetadata is the metadata of the deposit and the withdrawal requests
+type transactionMetadata interface {
+	GetChannel() string
+	GetReference() string
+	GetDescription() string
+	GetCounterparty() *accountv1.Counterparty
+}
+
+// toTransactionMetadataRequest converts the metadata of a request to be validated with the rules of the REST request bodies
+func toTransactionMetadataRequest(in transactionMetadata) resthandler.TransactionMetadataRequest {
+	metadata := resthandler.TransactionMetadataRequest{
+		Channel:     in.GetChannel(),
+		Reference:   in.GetReference(),
+		Description: in.GetDescription(),
+	}
+
+	if counterparty := in.GetCounterparty(); counterparty != nil {
+		metadata.Counterparty = &resthandler.CounterpartyRequest{
+			Name:          counterparty.GetName(),
+			AccountNumber: counterparty.GetAccountNumber(),
+		}
+	}
+
+	return metadata
+}
+
+// toTransactionMessage converts a transaction with the names of the REST response body
+func toTransactionMessage(transaction *entity.Transaction) *accountv1.Transaction {
+	response := resthandler.NewTransactionResponse(transaction)
+	message := &accountv1.Transaction{
+		Id:             uint64(response.ID),
+		Type:           response.Type,
+		Amount:         response.Amount.String(),
+		InitialBalance: response.InitialBalance.String(),
+		FinalBalance:   response.FinalBalance.String(),
+		Currency:       string(response.Currency),
+		Channel:        response.Channel,
+		Reference:      response.Reference,
+		Description:    response.Description,
+		CreatedAt:      response.CreatedAt.Format(time.RFC3339),
+	}
+
+	if counterparty := response.Counterparty; counterparty != nil {
+		message.Counterparty = &accountv1.Counterparty{
+			Name:          counterparty.Name,
+			AccountNumber: counterparty.AccountNumber,
+		}
+	}
+
+	return message
+}
+
+// parseAmount parses a decimal amount e.g. "10.50"
+func parseAmount(amount string) (decimal.Decimal, error) {
+	value, err := decimal.NewFromString(amount)
+	if err != nil {
+		return decimal.Zero, status.Errorf(codes.InvalidArgument, "%s: %s", entity.ErrInvalidRequest.Code, "amount must be a decimal number")
+	}
+
+	return value, nil
+}
+
+// validate validates the request with the shared validator
+func validate(req interface{}) error {
+	if err := util.GetValidator().Struct(req); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%s: %s", entity.ErrInvalidRequest.Code, err.Error())
+	}
+
+	return nil
+}
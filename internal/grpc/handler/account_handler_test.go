@@ -0,0 +1,151 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/internal/grpc/handler"
+	usecasemock "imansohibul.my.id/account-domain-service/internal/rest/handler/mock"
+	accountv1 "imansohibul.my.id/account-domain-service/proto/account/v1"
+)
+
+func TestDeposit(t *testing.T) {
+	tests := []struct {
+		name            string
+		request         *accountv1.DepositRequest
+		mockSetup       func(*testing.T, *usecasemock.MockDepositUsecase)
+		expectedCode    codes.Code
+		expectedBalance string
+	}{
+		{
+			name:    "Deposit - Success",
+			request: &accountv1.DepositRequest{AccountNumber: "1234567897", Amount: "50000", IdempotencyKey: "3f1c6a52"},
+			mockSetup: func(t *testing.T, depositUsecase *usecasemock.MockDepositUsecase) {
+				depositUsecase.EXPECT().
+					Deposit(gomock.Any(), &entity.DepositParams{
+						AccountNumber:  "1234567897",
+						Amount:         decimal.NewFromInt(50000),
+						Currency:       entity.CurrencyIDR,
+						Channel:        entity.ChannelTeller,
+						IdempotencyKey: "3f1c6a52",
+					}).
+					Return(&entity.Transaction{FinalBalance: decimal.NewFromInt(150000)}, nil)
+			},
+			expectedCode:    codes.OK,
+			expectedBalance: "150000",
+		},
+		{
+			name:    "Deposit - Account Not Found",
+			request: &accountv1.DepositRequest{AccountNumber: "1234567897", Amount: "50000"},
+			mockSetup: func(t *testing.T, depositUsecase *usecasemock.MockDepositUsecase) {
+				depositUsecase.EXPECT().
+					Deposit(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrAccountNotFound)
+			},
+			expectedCode: codes.NotFound,
+		},
+		{
+			name:    "Deposit - Account Blocked",
+			request: &accountv1.DepositRequest{AccountNumber: "1234567897", Amount: "50000"},
+			mockSetup: func(t *testing.T, depositUsecase *usecasemock.MockDepositUsecase) {
+				depositUsecase.EXPECT().
+					Deposit(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrAccountBlocked)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:    "Deposit - Invalid Amount",
+			request: &accountv1.DepositRequest{AccountNumber: "1234567897", Amount: "-1"},
+			mockSetup: func(t *testing.T, depositUsecase *usecasemock.MockDepositUsecase) {
+				// No need to mock since it's an error test case
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockDepositUsecase := usecasemock.NewMockDepositUsecase(ctrl)
+			tt.mockSetup(t, mockDepositUsecase)
+
+			handler := handler.NewAccountHandler(nil, mockDepositUsecase, nil, nil)
+
+			resp, err := handler.Deposit(context.Background(), tt.request)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			assert.Equal(t, tt.expectedBalance, resp.GetBalance())
+		})
+	}
+}
+
+func TestWithdraw(t *testing.T) {
+	tests := []struct {
+		name            string
+		request         *accountv1.WithdrawRequest
+		mockSetup       func(*testing.T, *usecasemock.MockWithdrawUsecase)
+		expectedCode    codes.Code
+		expectedMessage string
+		expectedBalance string
+		expectedFee     string
+	}{
+		{
+			name:    "Withdraw - Success With Fee",
+			request: &accountv1.WithdrawRequest{AccountNumber: "1234567897", Amount: "50000", Channel: "ATM"},
+			mockSetup: func(t *testing.T, withdrawUsecase *usecasemock.MockWithdrawUsecase) {
+				withdrawUsecase.EXPECT().
+					Withdraw(gomock.Any(), &entity.WithdrawParams{
+						AccountNumber: "1234567897",
+						Amount:        decimal.NewFromInt(50000),
+						Currency:      entity.CurrencyIDR,
+						Channel:       entity.ChannelATM,
+					}).
+					Return(&entity.Withdrawal{
+						Debit: &entity.Transaction{FinalBalance: decimal.NewFromInt(150000), Currency: entity.CurrencyIDR},
+						Fee:   &entity.Transaction{Amount: decimal.NewFromInt(2500), FinalBalance: decimal.NewFromInt(147500)},
+					}, nil)
+			},
+			expectedCode:    codes.OK,
+			expectedBalance: "147500",
+			expectedFee:     "2500",
+		},
+		{
+			name:    "Withdraw - Limit Exceeded",
+			request: &accountv1.WithdrawRequest{AccountNumber: "1234567897", Amount: "50000"},
+			mockSetup: func(t *testing.T, withdrawUsecase *usecasemock.MockWithdrawUsecase) {
+				withdrawUsecase.EXPECT().
+					Withdraw(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrLimitExceeded.WithDetails(map[string]string{"limit": "DAILY", "remaining": "20000"}))
+			},
+			expectedCode:    codes.ResourceExhausted,
+			expectedMessage: "LIMIT_EXCEEDED: Transaksi melebihi limit penarikan (limit=DAILY, remaining=20000)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			mockWithdrawUsecase := usecasemock.NewMockWithdrawUsecase(ctrl)
+			tt.mockSetup(t, mockWithdrawUsecase)
+
+			handler := handler.NewAccountHandler(nil, nil, mockWithdrawUsecase, nil)
+			resp, err := handler.Withdraw(context.Background(), tt.request)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			assert.Equal(t, tt.expectedBalance, resp.GetBalance())
+			assert.Equal(t, tt.expectedFee, resp.GetFee())
+			if tt.expectedMessage != "" {
+				assert.Equal(t, tt.expectedMessage, status.Convert(err).Message())
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// domainErrorCodes maps the domain error codes to gRPC status codes
+// Domain errors that aren't listed are returned as FailedPrecondition
+var domainErrorCodes = map[string]codes.Code{
+	entity.ErrInvalidRequest.Code:                    codes.InvalidArgument,
+	entity.ErrTransferToSameAccount.Code:             codes.InvalidArgument,
+	entity.ErrInvalidCursor.Code:                     codes.InvalidArgument,
+	entity.ErrUnsupportedCurrency.Code:               codes.InvalidArgument,
+	entity.ErrInvalidAmountPrecision.Code:            codes.InvalidArgument,
+	entity.ErrAmountExceedsMaximum.Code:              codes.InvalidArgument,
+	entity.ErrUnsupportedIdentityType.Code:           codes.InvalidArgument,
+	entity.ErrInvalidNIK.Code:                        codes.InvalidArgument,
+	entity.ErrInvalidPhoneNumber.Code:                codes.InvalidArgument,
+	entity.ErrCustomerIdentityMismatch.Code:          codes.InvalidArgument,
+	entity.ErrUnsupportedTimeDepositTerm.Code:        codes.InvalidArgument,
+	entity.ErrAccountNotFound.Code:                   codes.NotFound,
+	entity.ErrCustomerNotFound.Code:                  codes.NotFound,
+	entity.ErrCustomerIdentityNotFound.Code:          codes.NotFound,
+	entity.ErrTimeDepositNotFound.Code:               codes.NotFound,
+	entity.ErrWithdrawalLimitNotFound.Code:           codes.NotFound,
+	entity.ErrAccountAlreadyExists.Code:              codes.AlreadyExists,
+	entity.ErrPhoneNumberAlreadyExists.Code:          codes.AlreadyExists,
+	entity.ErrCustomerIdentityAlreadyExists.Code:     codes.AlreadyExists,
+	entity.ErrIdempotencyKeyReused.Code:              codes.AlreadyExists,
+	entity.ErrTransactionReferenceAlreadyExists.Code: codes.AlreadyExists,
+	entity.ErrAccountBlocked.Code:                    codes.PermissionDenied,
+	entity.ErrAccountDebitBlocked.Code:               codes.PermissionDenied,
+	entity.ErrAccountDormant.Code:                    codes.PermissionDenied,
+	entity.ErrAccountClosed.Code:                     codes.PermissionDenied,
+	entity.ErrTimeDepositLocked.Code:                 codes.PermissionDenied,
+	entity.ErrLimitExceeded.Code:                     codes.ResourceExhausted,
+	entity.ErrUnbalancedJournal.Code:                 codes.Internal,
+	entity.ErrInternal.Code:                          codes.Internal,
+}
+
+// toStatusError converts an usecase error to a gRPC status error
+// The domain error code is sent as the status message prefix, e.g. "ACCOUNT_NOT_FOUND: Nomor rekening tidak ditemukan",
+// and its details as the suffix, e.g. "LIMIT_EXCEEDED: Transaksi melebihi limit penarikan (limit=DAILY, remaining=1500000)"
+func toStatusError(err error) error {
+	var domainError *entity.DomainError
+	if !errors.As(err, &domainError) {
+		// Unexpected errors are logged by the usecase, their details are not exposed to the client
+		return status.Errorf(codes.Internal, "%s: %s", entity.ErrInternal.Code, entity.ErrInternal.Message)
+	}
+
+	code, ok := domainErrorCodes[domainError.Code]
+	if !ok {
+		code = codes.FailedPrecondition
+	}
+
+	if len(domainError.Details) == 0 {
+		return status.Errorf(code, "%s: %s", domainError.Code, domainError.Message)
+	}
+
+	details := make([]string, 0, len(domainError.Details))
+	for key, value := range domainError.Details {
+		details = append(details, key+"="+value)
+	}
+
+	sort.Strings(details)
+	return status.Errorf(code, "%s: %s (%s)", domainError.Code, domainError.Message, strings.Join(details, ", "))
+}
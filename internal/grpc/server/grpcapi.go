@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"imansohibul.my.id/account-domain-service/internal/grpc/handler"
+	resthandler "imansohibul.my.id/account-domain-service/internal/rest/handler"
+	accountv1 "imansohibul.my.id/account-domain-service/proto/account/v1"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// GRPCAPIServer encapsulates the gRPC server and usecases
+type GRPCAPIServer struct {
+	server *grpc.Server
+	logger util.Logger
+}
+
+// NewGRPCAPIServer constructs the server with injected usecases
+// The usecases are the same ones served by the REST API
+func NewGRPCAPIServer(
+	createAccountUsecase resthandler.CreateAccountUsecase,
+	depositUsecase resthandler.DepositUsecase,
+	withdrawUsecase resthandler.WithdrawUsecase,
+	getBalanceUsecase resthandler.GetBalanceUsecase,
+	logger util.Logger,
+) *GRPCAPIServer {
+	s := &GRPCAPIServer{logger: logger}
+
+	// Set up interceptors
+	s.server = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(s.recoverInterceptor, s.loggerInterceptor),
+	)
+
+	accountv1.RegisterAccountServiceServer(s.server, handler.NewAccountHandler(
+		createAccountUsecase,
+		depositUsecase,
+		withdrawUsecase,
+		getBalanceUsecase,
+	))
+
+	// Allows tools like grpcurl to list and call the services
+	reflection.Register(s.server)
+
+	return s
+}
+
+// Start launches the gRPC server
+func (s *GRPCAPIServer) Start(address string) error {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	return s.server.Serve(listener)
+}
+
+// Shutdown gracefully shuts down the server
+// It stops accepting new connections and waits for the pending RPCs to finish
+func (s *GRPCAPIServer) Shutdown(ctx context.Context) error {
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		return ctx.Err()
+	}
+}
+
+// loggerInterceptor logs every RPC with its duration and status code
+func (s *GRPCAPIServer) loggerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := next(ctx, req)
+
+	s.logger.Info(ctx, "gRPC request", map[string]interface{}{
+		"method":   info.FullMethod,
+		"code":     status.Code(err).String(),
+		"duration": time.Since(start),
+	})
+
+	return resp, err
+}
+
+// recoverInterceptor converts a panic into an Internal status error
+func (s *GRPCAPIServer) recoverInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.logger.Error(ctx, "gRPC request panicked", nil, map[string]interface{}{
+				"method": info.FullMethod,
+				"panic":  r,
+			})
+			err = status.Error(codes.Internal, "internal error")
+		}
+	}()
+
+	return next(ctx, req)
+}
@@ -0,0 +1,30 @@
+package publisher
+
+import (
+	"encoding/json"
+	"time"
+
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// message is the envelope of an outbox event sent to the downstream services
+// Events are delivered at least once, consumers should deduplicate by ID
+type message struct {
+	ID            uint            `json:"id"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   uint            `json:"aggregate_id"`
+	EventType     string          `json:"event_type"`
+	Payload       json.RawMessage `json:"payload"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+func newMessage(event *entity.OutboxEvent) message {
+	return message{
+		ID:            event.ID,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		EventType:     string(event.EventType),
+		Payload:       json.RawMessage(event.Payload),
+		CreatedAt:     event.CreatedAt,
+	}
+}
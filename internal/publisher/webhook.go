@@ -0,0 +1,72 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// Headers sent with every webhook request
+const (
+	HeaderEventID   = "X-Event-ID"
+	HeaderEventType = "X-Event-Type"
+)
+
+// DefaultWebhookTimeout is the timeout of a single webhook request
+const DefaultWebhookTimeout = 10 * time.Second
+
+// webhookPublisher posts every event as JSON to an HTTP endpoint
+// Any response other than 2xx is treated as a failed delivery
+type webhookPublisher struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhookPublisher(url string, timeout time.Duration) *webhookPublisher {
+	if timeout <= 0 {
+		timeout = DefaultWebhookTimeout
+	}
+
+	return &webhookPublisher{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (w webhookPublisher) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	body, err := json.Marshal(newMessage(event))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEventID, strconv.FormatUint(uint64(event.ID), 10))
+	req.Header.Set(HeaderEventType, string(event.EventType))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
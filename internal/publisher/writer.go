@@ -0,0 +1,33 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// writerPublisher writes every event as a JSON line (e.g. to stdout or a file)
+type writerPublisher struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+func NewWriterPublisher(writer io.Writer) *writerPublisher {
+	return &writerPublisher{writer: writer}
+}
+
+func (w *writerPublisher) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	line, err := json.Marshal(newMessage(event))
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, err = w.writer.Write(append(line, '\n'))
+	return err
+}
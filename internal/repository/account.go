@@ -21,7 +21,8 @@ type account struct {
 	AccountType   int             `db:"account_type"`
 	AccountNumber string          `db:"account_number"`
 	Balance       decimal.Decimal `db:"balance"`
-	Currency      int             `db:"currency"`
+	HeldAmount    decimal.Decimal `db:"held_amount"`
+	Currency      string          `db:"currency"`
 	Status        int             `db:"status"`
 	CreatedAt     time.Time       `db:"created_at"`
 	UpdatedAt     time.Time       `db:"updated_at"`
@@ -44,12 +45,34 @@ func (a accountRepository) CreateAccount(ctx context.Context, newAccount *entity
 	return a.toEntityAccount(accountRecord), nil
 }
 
-func (a accountRepository) FindByAccountNumber(ctx context.Context, accountType entity.AccountType, accountNumber string, lock bool) (*entity.Account, error) {
-	querier := []rel.Querier{
-		where.Eq("account_type", int(accountType)),
+// FindByAccountNumber finds a customer account of any type by its account number
+// Internal system accounts are excluded, they are found with FindSystemAccount
+func (a accountRepository) FindByAccountNumber(ctx context.Context, accountNumber string, lock bool) (*entity.Account, error) {
+	return a.findAccount(ctx, lock,
 		where.Eq("account_number", accountNumber),
-	}
+		where.Ne("account_type", int(entity.AccountTypeInternal)),
+	)
+}
+
+// FindByID finds a customer account of any type by its ID
+func (a accountRepository) FindByID(ctx context.Context, id uint, lock bool) (*entity.Account, error) {
+	return a.findAccount(ctx, lock,
+		where.Eq("id", id),
+		where.Ne("account_type", int(entity.AccountTypeInternal)),
+	)
+}
 
+// FindSystemAccount finds an internal system account by its account number
+// System accounts are never locked since their balance column is not maintained
+func (a accountRepository) FindSystemAccount(ctx context.Context, accountNumber string) (*entity.Account, error) {
+	applyLock := false
+	return a.findAccount(ctx, applyLock,
+		where.Eq("account_number", accountNumber),
+		where.Eq("account_type", int(entity.AccountTypeInternal)),
+	)
+}
+
+func (a accountRepository) findAccount(ctx context.Context, lock bool, querier ...rel.Querier) (*entity.Account, error) {
 	if lock {
 		querier = append(querier, rel.ForUpdate())
 	}
@@ -65,6 +88,69 @@ func (a accountRepository) FindByAccountNumber(ctx context.Context, accountType
 	return a.toEntityAccount(accountRecord), nil
 }
 
+// FindAccounts finds the customer accounts with an ID greater than afterID ordered by ID
+// Internal system accounts are excluded since their balance column is not maintained
+func (a accountRepository) FindAccounts(ctx context.Context, afterID uint, limit int) ([]*entity.Account, error) {
+	var accountRecords []account
+	err := a.db.FindAll(ctx, &accountRecords,
+		where.Gt("id", afterID),
+		where.Ne("account_type", int(entity.AccountTypeInternal)),
+		rel.SortAsc("id"),
+		rel.Limit(limit),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*entity.Account, 0, len(accountRecords))
+	for i := range accountRecords {
+		accounts = append(accounts, a.toEntityAccount(&accountRecords[i]))
+	}
+
+	return accounts, nil
+}
+
+// accountNumberSequence is the next value of the sequence of the account number bodies
+type accountNumberSequence struct {
+	Value int64 `db:"value"`
+}
+
+// NextAccountNumberSequence returns the next value of the sequence of the account number bodies
+// The value is consumed even when the transaction is rolled back
+func (a accountRepository) NextAccountNumberSequence(ctx context.Context) (int64, error) {
+	var sequenceRecords []accountNumberSequence
+	err := a.db.FindAll(ctx, &sequenceRecords, rel.SQL("SELECT nextval('account_number_seq') AS value"))
+	if err != nil {
+		return 0, err
+	}
+
+	if len(sequenceRecords) == 0 {
+		return 0, errors.New("account number sequence returned no value")
+	}
+
+	return sequenceRecords[0].Value, nil
+}
+
+// FindByCustomerID finds the accounts of a customer ordered by ID, the oldest account first
+func (a accountRepository) FindByCustomerID(ctx context.Context, customerID uint) ([]*entity.Account, error) {
+	var accountRecords []account
+	err := a.db.FindAll(ctx, &accountRecords,
+		where.Eq("customer_id", customerID),
+		where.Ne("account_type", int(entity.AccountTypeInternal)),
+		rel.SortAsc("id"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*entity.Account, 0, len(accountRecords))
+	for i := range accountRecords {
+		accounts = append(accounts, a.toEntityAccount(&accountRecords[i]))
+	}
+
+	return accounts, nil
+}
+
 func (a accountRepository) UpdateAccount(ctx context.Context, account *entity.Account) (*entity.Account, error) {
 	accountRecord := a.fromEntityAccount(account)
 	err := a.db.Update(ctx, accountRecord)
@@ -82,7 +168,8 @@ func (a accountRepository) fromEntityAccount(accountEntity *entity.Account) *acc
 		AccountType:   int(accountEntity.AccountType),
 		AccountNumber: accountEntity.AccountNumber,
 		Balance:       accountEntity.Balance,
-		Currency:      int(accountEntity.Currency),
+		HeldAmount:    accountEntity.HeldAmount,
+		Currency:      string(accountEntity.Currency),
 		Status:        int(accountEntity.Status),
 		CreatedAt:     accountEntity.CreatedAt,
 		UpdatedAt:     accountEntity.UpdatedAt,
@@ -96,6 +183,7 @@ func (a accountRepository) toEntityAccount(accountRecord *account) *entity.Accou
 		AccountType:   entity.AccountType(accountRecord.AccountType),
 		AccountNumber: accountRecord.AccountNumber,
 		Balance:       accountRecord.Balance,
+		HeldAmount:    accountRecord.HeldAmount,
 		Currency:      entity.Currency(accountRecord.Currency),
 		Status:        entity.AccountStatus(accountRecord.Status),
 		CreatedAt:     accountRecord.CreatedAt,
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-rel/rel"
+	"github.com/go-rel/rel/where"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+type accountStatusHistoryRepository struct {
+	db rel.Repository
+}
+
+type accountStatusHistory struct {
+	ID         uint      `db:"id"`
+	AccountID  uint      `db:"account_id"`
+	FromStatus int       `db:"from_status"`
+	ToStatus   int       `db:"to_status"`
+	Reason     string    `db:"reason"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+func NewAccountStatusHistoryRepository(db rel.Repository) *accountStatusHistoryRepository {
+	return &accountStatusHistoryRepository{db: db}
+}
+
+func (a accountStatusHistoryRepository) CreateAccountStatusHistory(ctx context.Context, history *entity.AccountStatusHistory) (*entity.AccountStatusHistory, error) {
+	historyRecord := a.fromEntityAccountStatusHistory(history)
+	err := a.db.Insert(ctx, historyRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.toEntityAccountStatusHistory(historyRecord), nil
+}
+
+// FindLastAccountStatusHistory finds the latest change of an account to the given status
+func (a accountStatusHistoryRepository) FindLastAccountStatusHistory(ctx context.Context, accountID uint, toStatus entity.AccountStatus) (*entity.AccountStatusHistory, error) {
+	historyRecord := new(accountStatusHistory)
+	err := a.db.Find(ctx, historyRecord,
+		where.Eq("account_id", accountID),
+		where.Eq("to_status", int(toStatus)),
+		rel.SortDesc("id"),
+	)
+	if err != nil && errors.Is(err, rel.ErrNotFound) {
+		return nil, entity.ErrAccountStatusHistoryNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return a.toEntityAccountStatusHistory(historyRecord), nil
+}
+
+func (a accountStatusHistoryRepository) fromEntityAccountStatusHistory(historyEntity *entity.AccountStatusHistory) *accountStatusHistory {
+	return &accountStatusHistory{
+		ID:         historyEntity.ID,
+		AccountID:  historyEntity.AccountID,
+		FromStatus: int(historyEntity.FromStatus),
+		ToStatus:   int(historyEntity.ToStatus),
+		Reason:     historyEntity.Reason,
+		CreatedAt:  historyEntity.CreatedAt,
+		UpdatedAt:  historyEntity.UpdatedAt,
+	}
+}
+
+func (a accountStatusHistoryRepository) toEntityAccountStatusHistory(historyRecord *accountStatusHistory) *entity.AccountStatusHistory {
+	return &entity.AccountStatusHistory{
+		ID:         historyRecord.ID,
+		AccountID:  historyRecord.AccountID,
+		FromStatus: entity.AccountStatus(historyRecord.FromStatus),
+		ToStatus:   entity.AccountStatus(historyRecord.ToStatus),
+		Reason:     historyRecord.Reason,
+		CreatedAt:  historyRecord.CreatedAt,
+		UpdatedAt:  historyRecord.UpdatedAt,
+	}
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/go-rel/rel"
@@ -22,10 +23,14 @@ type customer struct {
 	ID          uint      `db:"id"`
 	Fullname    string    `db:"fullname"`
 	PhoneNumber string    `db:"phone_number"`
+	Tier        int       `db:"tier"`
 	CreatedAt   time.Time `db:"created_at"`
 	UpdatedAt   time.Time `db:"updated_at"`
 }
 
+// likePatternEscaper escapes the wildcards of a LIKE pattern, so the search term is matched literally
+var likePatternEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 func (c customerRepository) CreateCustomer(ctx context.Context, newCustomer *entity.Customer) (*entity.Customer, error) {
 	customerRecord := c.fromEntityCustomer(newCustomer)
 
@@ -39,6 +44,21 @@ func (c customerRepository) CreateCustomer(ctx context.Context, newCustomer *ent
 	return c.toEntityCustomer(customerRecord), nil
 }
 
+// UpdateCustomer updates the profile of the customer
+// The phone number is unique, a phone number of another customer is rejected
+func (c customerRepository) UpdateCustomer(ctx context.Context, customer *entity.Customer) (*entity.Customer, error) {
+	customerRecord := c.fromEntityCustomer(customer)
+
+	err := c.db.Update(ctx, customerRecord)
+	if err != nil && !errors.Is(err, rel.ErrUniqueConstraint) {
+		return nil, err
+	} else if errors.Is(err, rel.ErrUniqueConstraint) {
+		return nil, entity.ErrPhoneNumberAlreadyExists
+	}
+
+	return c.toEntityCustomer(customerRecord), nil
+}
+
 func (c customerRepository) FindByPhoneNumber(ctx context.Context, phoneNumber string) (*entity.Customer, error) {
 	customerRecord := new(customer)
 	err := c.db.Find(ctx, customerRecord, where.Eq("phone_number", phoneNumber))
@@ -51,11 +71,117 @@ func (c customerRepository) FindByPhoneNumber(ctx context.Context, phoneNumber s
 	return c.toEntityCustomer(customerRecord), nil
 }
 
-func (c customerRepository) fromEntityCustomer(customerRecord *entity.Customer) *customer {
+func (c customerRepository) FindByID(ctx context.Context, id uint, lock bool) (*entity.Customer, error) {
+	querier := []rel.Querier{
+		where.Eq("id", id),
+	}
+
+	if lock {
+		querier = append(querier, rel.ForUpdate())
+	}
+
+	customerRecord := new(customer)
+	err := c.db.Find(ctx, customerRecord, querier...)
+	if err != nil && errors.Is(err, rel.ErrNotFound) {
+		return nil, entity.ErrCustomerNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return c.toEntityCustomer(customerRecord), nil
+}
+
+// FindCustomers finds the customers matching every filter ordered by full name
+func (c customerRepository) FindCustomers(ctx context.Context, filter *entity.CustomerFilter) ([]*entity.Customer, error) {
+	querier := []rel.Querier{
+		rel.SortAsc("fullname"),
+		rel.SortAsc("id"),
+		rel.Limit(filter.Limit),
+	}
+
+	if filter.ID != 0 {
+		querier = append(querier, where.Eq("id", filter.ID))
+	}
+
+	if filter.NamePrefix != "" {
+		pattern := likePatternEscaper.Replace(strings.ToLower(filter.NamePrefix)) + "%"
+		querier = append(querier, where.Fragment("LOWER(fullname) LIKE ?", pattern))
+	}
+
+	if filter.PhoneNumber != "" {
+		querier = append(querier, where.Eq("phone_number", filter.PhoneNumber))
+	}
+
+	var customerRecords []customer
+	err := c.db.FindAll(ctx, &customerRecords, querier...)
+	if err != nil {
+		return nil, err
+	}
+
+	customers := make([]*entity.Customer, 0, len(customerRecords))
+	for i := range customerRecords {
+		customers = append(customers, c.toEntityCustomer(&customerRecords[i]))
+	}
+
+	return customers, nil
+}
+
+// FindCustomersAfter finds the customers with an ID greater than afterID ordered by ID
+func (c customerRepository) FindCustomersAfter(ctx context.Context, afterID uint, limit int) ([]*entity.Customer, error) {
+	var customerRecords []customer
+	err := c.db.FindAll(ctx, &customerRecords,
+		where.Gt("id", afterID),
+		rel.SortAsc("id"),
+		rel.Limit(limit),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	customers := make([]*entity.Customer, 0, len(customerRecords))
+	for i := range customerRecords {
+		customers = append(customers, c.toEntityCustomer(&customerRecords[i]))
+	}
+
+	return customers, nil
+}
+
+// FindCustomersWithSimilarPhoneNumbers finds the customers whose phone number is the same as the number of another
+// customer once the separators and the +62, 62 or leading 0 prefix are removed, ordered by ID.
+// They're the candidates of the collisions of the phone number normalization, found in a single grouped query
+func (c customerRepository) FindCustomersWithSimilarPhoneNumbers(ctx context.Context) ([]*entity.Customer, error) {
+	var customerRecords []customer
+	err := c.db.FindAll(ctx, &customerRecords, rel.SQL(`
+		WITH keyed AS (
+			SELECT *, regexp_replace(regexp_replace(phone_number, '[^0-9+]', '', 'g'), '^(\+62|62|0)', '') AS phone_number_key
+			FROM customers
+			WHERE phone_number IS NOT NULL
+		)
+		SELECT id, fullname, phone_number, tier, created_at, updated_at
+		FROM keyed
+		WHERE phone_number_key IN (SELECT phone_number_key FROM keyed GROUP BY phone_number_key HAVING COUNT(*) > 1)
+		ORDER BY id`,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	customers := make([]*entity.Customer, 0, len(customerRecords))
+	for i := range customerRecords {
+		customers = append(customers, c.toEntityCustomer(&customerRecords[i]))
+	}
+
+	return customers, nil
+}
+
+func (c customerRepository) fromEntityCustomer(customerEntity *entity.Customer) *customer {
 	return &customer{
-		ID:          customerRecord.ID,
-		Fullname:    customerRecord.Fullname,
-		PhoneNumber: customerRecord.PhoneNumber,
+		ID:          customerEntity.ID,
+		Fullname:    customerEntity.Fullname,
+		PhoneNumber: customerEntity.PhoneNumber,
+		Tier:        int(customerEntity.Tier),
+		CreatedAt:   customerEntity.CreatedAt,
+		UpdatedAt:   customerEntity.UpdatedAt,
 	}
 }
 
@@ -64,5 +190,8 @@ func (c customerRepository) toEntityCustomer(customerRecord *customer) *entity.C
 		ID:          customerRecord.ID,
 		Fullname:    customerRecord.Fullname,
 		PhoneNumber: customerRecord.PhoneNumber,
+		Tier:        entity.CustomerTier(customerRecord.Tier),
+		CreatedAt:   customerRecord.CreatedAt,
+		UpdatedAt:   customerRecord.UpdatedAt,
 	}
 }
@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-rel/rel"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+type customerHistoryRepository struct {
+	db rel.Repository
+}
+
+type customerHistory struct {
+	ID         uint      `db:"id"`
+	CustomerID uint      `db:"customer_id"`
+	Field      string    `db:"field"`
+	OldValue   string    `db:"old_value"`
+	NewValue   string    `db:"new_value"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+func NewCustomerHistoryRepository(db rel.Repository) *customerHistoryRepository {
+	return &customerHistoryRepository{db: db}
+}
+
+func (c customerHistoryRepository) CreateCustomerHistory(ctx context.Context, history *entity.CustomerHistory) (*entity.CustomerHistory, error) {
+	historyRecord := c.fromEntityCustomerHistory(history)
+	err := c.db.Insert(ctx, historyRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.toEntityCustomerHistory(historyRecord), nil
+}
+
+func (c customerHistoryRepository) fromEntityCustomerHistory(historyEntity *entity.CustomerHistory) *customerHistory {
+	return &customerHistory{
+		ID:         historyEntity.ID,
+		CustomerID: historyEntity.CustomerID,
+		Field:      string(historyEntity.Field),
+		OldValue:   historyEntity.OldValue,
+		NewValue:   historyEntity.NewValue,
+		CreatedAt:  historyEntity.CreatedAt,
+		UpdatedAt:  historyEntity.UpdatedAt,
+	}
+}
+
+func (c customerHistoryRepository) toEntityCustomerHistory(historyRecord *customerHistory) *entity.CustomerHistory {
+	return &entity.CustomerHistory{
+		ID:         historyRecord.ID,
+		CustomerID: historyRecord.CustomerID,
+		Field:      entity.CustomerField(historyRecord.Field),
+		OldValue:   historyRecord.OldValue,
+		NewValue:   historyRecord.NewValue,
+		CreatedAt:  historyRecord.CreatedAt,
+		UpdatedAt:  historyRecord.UpdatedAt,
+	}
+}
@@ -51,19 +51,40 @@ func (c customerIdentityRepository) FindByIdentity(ctx context.Context, identity
 	return c.toEntityCustomerIdentity(customerIdentityRecord), nil
 }
 
+// FindByCustomerID finds the identities of a customer ordered by identity type
+func (c customerIdentityRepository) FindByCustomerID(ctx context.Context, customerID uint) ([]*entity.CustomerIdentity, error) {
+	var customerIdentityRecords []customerIdentity
+	err := c.db.FindAll(ctx, &customerIdentityRecords, where.Eq("customer_id", customerID), rel.SortAsc("identity_type"))
+	if err != nil {
+		return nil, err
+	}
+
+	customerIdentities := make([]*entity.CustomerIdentity, 0, len(customerIdentityRecords))
+	for i := range customerIdentityRecords {
+		customerIdentities = append(customerIdentities, c.toEntityCustomerIdentity(&customerIdentityRecords[i]))
+	}
+
+	return customerIdentities, nil
+}
+
 func (c customerIdentityRepository) fromEntityCustomerIdentity(customerIdentityRecord *entity.CustomerIdentity) *customerIdentity {
 	return &customerIdentity{
 		ID:             customerIdentityRecord.ID,
 		CustomerID:     customerIdentityRecord.CustomerID,
 		IdentityType:   int(customerIdentityRecord.IdentityType),
 		IdentityNumber: customerIdentityRecord.IdentityNumber,
+		CreatedAt:      customerIdentityRecord.CreatedAt,
+		UpdatedAt:      customerIdentityRecord.UpdatedAt,
 	}
 }
+
 func (c customerIdentityRepository) toEntityCustomerIdentity(customerIdentityRecord *customerIdentity) *entity.CustomerIdentity {
 	return &entity.CustomerIdentity{
 		ID:             customerIdentityRecord.ID,
 		CustomerID:     customerIdentityRecord.CustomerID,
 		IdentityType:   entity.CustomerIdentityType(customerIdentityRecord.IdentityType),
 		IdentityNumber: customerIdentityRecord.IdentityNumber,
+		CreatedAt:      customerIdentityRecord.CreatedAt,
+		UpdatedAt:      customerIdentityRecord.UpdatedAt,
 	}
 }
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-rel/rel"
+	"github.com/go-rel/rel/where"
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+type exchangeRateRepository struct {
+	db rel.Repository
+}
+
+type exchangeRate struct {
+	ID            uint            `db:"id"`
+	BaseCurrency  string          `db:"base_currency"`
+	QuoteCurrency string          `db:"quote_currency"`
+	BuyRate       decimal.Decimal `db:"buy_rate"`
+	SellRate      decimal.Decimal `db:"sell_rate"`
+	EffectiveAt   time.Time       `db:"effective_at"`
+	CreatedAt     time.Time       `db:"created_at"`
+	UpdatedAt     time.Time       `db:"updated_at"`
+}
+
+func NewExchangeRateRepository(db rel.Repository) *exchangeRateRepository {
+	return &exchangeRateRepository{db: db}
+}
+
+// CreateExchangeRates stores the exchange rates
+// A rate of a pair that is already effective at the same time is replaced, so a corrected file can be loaded again
+func (e exchangeRateRepository) CreateExchangeRates(ctx context.Context, exchangeRates []*entity.ExchangeRate) error {
+	exchangeRateRecords := make([]exchangeRate, 0, len(exchangeRates))
+	for _, rate := range exchangeRates {
+		exchangeRateRecords = append(exchangeRateRecords, *e.fromEntityExchangeRate(rate))
+	}
+
+	return e.db.InsertAll(ctx, &exchangeRateRecords,
+		rel.OnConflictKeysReplace([]string{"base_currency", "quote_currency", "effective_at"}),
+	)
+}
+
+// FindExchangeRate finds the latest rate of the currency pair that is effective at the given time
+func (e exchangeRateRepository) FindExchangeRate(ctx context.Context, baseCurrency, quoteCurrency entity.Currency, at time.Time) (*entity.ExchangeRate, error) {
+	exchangeRateRecord := new(exchangeRate)
+	err := e.db.Find(ctx, exchangeRateRecord,
+		where.Eq("base_currency", string(baseCurrency)),
+		where.Eq("quote_currency", string(quoteCurrency)),
+		where.Lte("effective_at", at),
+		rel.SortDesc("effective_at"),
+	)
+	if err != nil && errors.Is(err, rel.ErrNotFound) {
+		return nil, entity.ErrExchangeRateNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return e.toEntityExchangeRate(exchangeRateRecord), nil
+}
+
+func (e exchangeRateRepository) fromEntityExchangeRate(exchangeRateEntity *entity.ExchangeRate) *exchangeRate {
+	return &exchangeRate{
+		ID:            exchangeRateEntity.ID,
+		BaseCurrency:  string(exchangeRateEntity.BaseCurrency),
+		QuoteCurrency: string(exchangeRateEntity.QuoteCurrency),
+		BuyRate:       exchangeRateEntity.BuyRate,
+		SellRate:      exchangeRateEntity.SellRate,
+		EffectiveAt:   exchangeRateEntity.EffectiveAt,
+		CreatedAt:     exchangeRateEntity.CreatedAt,
+		UpdatedAt:     exchangeRateEntity.UpdatedAt,
+	}
+}
+
+func (e exchangeRateRepository) toEntityExchangeRate(exchangeRateRecord *exchangeRate) *entity.ExchangeRate {
+	return &entity.ExchangeRate{
+		ID:            exchangeRateRecord.ID,
+		BaseCurrency:  entity.Currency(exchangeRateRecord.BaseCurrency),
+		QuoteCurrency: entity.Currency(exchangeRateRecord.QuoteCurrency),
+		BuyRate:       exchangeRateRecord.BuyRate,
+		SellRate:      exchangeRateRecord.SellRate,
+		EffectiveAt:   exchangeRateRecord.EffectiveAt,
+		CreatedAt:     exchangeRateRecord.CreatedAt,
+		UpdatedAt:     exchangeRateRecord.UpdatedAt,
+	}
+}
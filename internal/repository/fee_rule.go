@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-rel/rel"
+	"github.com/go-rel/rel/where"
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+type feeRuleRepository struct {
+	db rel.Repository
+}
+
+type feeRule struct {
+	ID          uint            `db:"id"`
+	AccountType int             `db:"account_type"`
+	Operation   int             `db:"operation"`
+	Channel     int             `db:"channel"`
+	Currency    string          `db:"currency"`
+	MinAmount   decimal.Decimal `db:"min_amount"`
+	FlatFee     decimal.Decimal `db:"flat_fee"`
+	Percentage  decimal.Decimal `db:"percentage"`
+	MinFee      decimal.Decimal `db:"min_fee"`
+	MaxFee      decimal.Decimal `db:"max_fee"`
+	CreatedAt   time.Time       `db:"created_at"`
+	UpdatedAt   time.Time       `db:"updated_at"`
+}
+
+func NewFeeRuleRepository(db rel.Repository) *feeRuleRepository {
+	return &feeRuleRepository{db: db}
+}
+
+// FindFeeRules finds the tiers of the fee schedule of an operation made through a channel
+// by the accounts of a product, ordered by minimum amount
+func (f feeRuleRepository) FindFeeRules(ctx context.Context, accountType entity.AccountType, operation entity.FeeOperation, channel entity.Channel, currency entity.Currency) ([]*entity.FeeRule, error) {
+	var ruleRecords []feeRule
+	err := f.db.FindAll(ctx, &ruleRecords,
+		where.Eq("account_type", int(accountType)),
+		where.Eq("operation", int(operation)),
+		where.Eq("channel", int(channel)),
+		where.Eq("currency", string(currency)),
+		rel.SortAsc("min_amount"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*entity.FeeRule, 0, len(ruleRecords))
+	for _, ruleRecord := range ruleRecords {
+		rules = append(rules, &entity.FeeRule{
+			ID:          ruleRecord.ID,
+			AccountType: entity.AccountType(ruleRecord.AccountType),
+			Operation:   entity.FeeOperation(ruleRecord.Operation),
+			Channel:     entity.Channel(ruleRecord.Channel),
+			Currency:    entity.Currency(ruleRecord.Currency),
+			MinAmount:   ruleRecord.MinAmount,
+			FlatFee:     ruleRecord.FlatFee,
+			Percentage:  ruleRecord.Percentage,
+			MinFee:      ruleRecord.MinFee,
+			MaxFee:      ruleRecord.MaxFee,
+			CreatedAt:   ruleRecord.CreatedAt,
+			UpdatedAt:   ruleRecord.UpdatedAt,
+		})
+	}
+
+	return rules, nil
+}
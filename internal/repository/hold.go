@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-rel/rel"
+	"github.com/go-rel/rel/where"
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+type holdRepository struct {
+	db rel.Repository
+}
+
+type hold struct {
+	ID             uint            `db:"id"`
+	AccountID      uint            `db:"account_id"`
+	Amount         decimal.Decimal `db:"amount"`
+	CapturedAmount decimal.Decimal `db:"captured_amount"`
+	Currency       string          `db:"currency"`
+	Status         int             `db:"status"`
+	Reference      string          `db:"reference"`
+	ExpiresAt      time.Time       `db:"expires_at"`
+	TransactionID  *uint           `db:"transaction_id"`
+	CreatedAt      time.Time       `db:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at"`
+}
+
+func NewHoldRepository(db rel.Repository) *holdRepository {
+	return &holdRepository{db: db}
+}
+
+func (h holdRepository) CreateHold(ctx context.Context, newHold *entity.Hold) (*entity.Hold, error) {
+	holdRecord := h.fromEntityHold(newHold)
+	err := h.db.Insert(ctx, holdRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.toEntityHold(holdRecord), nil
+}
+
+func (h holdRepository) FindHoldByID(ctx context.Context, id uint, lock bool) (*entity.Hold, error) {
+	querier := []rel.Querier{
+		where.Eq("id", id),
+	}
+
+	if lock {
+		querier = append(querier, rel.ForUpdate())
+	}
+
+	holdRecord := new(hold)
+	err := h.db.Find(ctx, holdRecord, querier...)
+	if err != nil && errors.Is(err, rel.ErrNotFound) {
+		return nil, entity.ErrHoldNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return h.toEntityHold(holdRecord), nil
+}
+
+// FindHoldByTransactionID finds the hold captured into the debit transaction
+func (h holdRepository) FindHoldByTransactionID(ctx context.Context, transactionID uint) (*entity.Hold, error) {
+	holdRecord := new(hold)
+	err := h.db.Find(ctx, holdRecord, where.Eq("transaction_id", transactionID))
+	if err != nil && errors.Is(err, rel.ErrNotFound) {
+		return nil, entity.ErrHoldNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return h.toEntityHold(holdRecord), nil
+}
+
+// FindExpiredHolds finds the active holds with an ID greater than afterID
+// that reached their expiry at now, ordered by ID
+func (h holdRepository) FindExpiredHolds(ctx context.Context, now time.Time, afterID uint, limit int) ([]*entity.Hold, error) {
+	var holdRecords []hold
+	err := h.db.FindAll(ctx, &holdRecords,
+		where.Eq("status", int(entity.HoldStatusActive)),
+		where.Lte("expires_at", now),
+		where.Gt("id", afterID),
+		rel.SortAsc("id"),
+		rel.Limit(limit),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	holds := make([]*entity.Hold, 0, len(holdRecords))
+	for i := range holdRecords {
+		holds = append(holds, h.toEntityHold(&holdRecords[i]))
+	}
+
+	return holds, nil
+}
+
+func (h holdRepository) UpdateHold(ctx context.Context, holdEntity *entity.Hold) (*entity.Hold, error) {
+	holdRecord := h.fromEntityHold(holdEntity)
+	err := h.db.Update(ctx, holdRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.toEntityHold(holdRecord), nil
+}
+
+func (h holdRepository) fromEntityHold(holdEntity *entity.Hold) *hold {
+	holdRecord := &hold{
+		ID:             holdEntity.ID,
+		AccountID:      holdEntity.AccountID,
+		Amount:         holdEntity.Amount,
+		CapturedAmount: holdEntity.CapturedAmount,
+		Currency:       string(holdEntity.Currency),
+		Status:         int(holdEntity.Status),
+		Reference:      holdEntity.Reference,
+		ExpiresAt:      holdEntity.ExpiresAt,
+		CreatedAt:      holdEntity.CreatedAt,
+		UpdatedAt:      holdEntity.UpdatedAt,
+	}
+
+	if holdEntity.TransactionID != 0 {
+		transactionID := holdEntity.TransactionID
+		holdRecord.TransactionID = &transactionID
+	}
+
+	return holdRecord
+}
+
+func (h holdRepository) toEntityHold(holdRecord *hold) *entity.Hold {
+	holdEntity := &entity.Hold{
+		ID:             holdRecord.ID,
+		AccountID:      holdRecord.AccountID,
+		Amount:         holdRecord.Amount,
+		CapturedAmount: holdRecord.CapturedAmount,
+		Currency:       entity.Currency(holdRecord.Currency),
+		Status:         entity.HoldStatus(holdRecord.Status),
+		Reference:      holdRecord.Reference,
+		ExpiresAt:      holdRecord.ExpiresAt,
+		CreatedAt:      holdRecord.CreatedAt,
+		UpdatedAt:      holdRecord.UpdatedAt,
+	}
+
+	if holdRecord.TransactionID != nil {
+		holdEntity.TransactionID = *holdRecord.TransactionID
+	}
+
+	return holdEntity
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-rel/rel"
+	"github.com/go-rel/rel/where"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+type idempotencyKeyRepository struct {
+	db rel.Repository
+}
+
+type idempotencyKey struct {
+	ID             uint      `db:"id"`
+	Scope          string    `db:"scope"`
+	IdempotencyKey string    `db:"idempotency_key"`
+	RequestHash    string    `db:"request_hash"`
+	Response       string    `db:"response"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+}
+
+func NewIdempotencyKeyRepository(db rel.Repository) *idempotencyKeyRepository {
+	return &idempotencyKeyRepository{db: db}
+}
+
+func (i idempotencyKeyRepository) CreateIdempotencyKey(ctx context.Context, newIdempotencyKey *entity.IdempotencyKey) (*entity.IdempotencyKey, error) {
+	idempotencyKeyRecord := i.fromEntityIdempotencyKey(newIdempotencyKey)
+
+	err := i.db.Insert(ctx, idempotencyKeyRecord)
+	if err != nil && !errors.Is(err, rel.ErrUniqueConstraint) {
+		return nil, err
+	} else if errors.Is(err, rel.ErrUniqueConstraint) {
+		return nil, entity.ErrIdempotencyKeyAlreadyExists
+	}
+
+	return i.toEntityIdempotencyKey(idempotencyKeyRecord), nil
+}
+
+func (i idempotencyKeyRepository) FindIdempotencyKey(ctx context.Context, scope entity.IdempotencyScope, key string) (*entity.IdempotencyKey, error) {
+	idempotencyKeyRecord := new(idempotencyKey)
+	err := i.db.Find(ctx, idempotencyKeyRecord, where.Eq("scope", string(scope)), where.Eq("idempotency_key", key))
+	if err != nil && errors.Is(err, rel.ErrNotFound) {
+		return nil, entity.ErrIdempotencyKeyNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return i.toEntityIdempotencyKey(idempotencyKeyRecord), nil
+}
+
+func (i idempotencyKeyRepository) UpdateIdempotencyKey(ctx context.Context, idempotencyKey *entity.IdempotencyKey) (*entity.IdempotencyKey, error) {
+	idempotencyKeyRecord := i.fromEntityIdempotencyKey(idempotencyKey)
+	err := i.db.Update(ctx, idempotencyKeyRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.toEntityIdempotencyKey(idempotencyKeyRecord), nil
+}
+
+func (i idempotencyKeyRepository) fromEntityIdempotencyKey(idempotencyKeyEntity *entity.IdempotencyKey) *idempotencyKey {
+	return &idempotencyKey{
+		ID:             idempotencyKeyEntity.ID,
+		Scope:          string(idempotencyKeyEntity.Scope),
+		IdempotencyKey: idempotencyKeyEntity.Key,
+		RequestHash:    idempotencyKeyEntity.RequestHash,
+		Response:       idempotencyKeyEntity.Response,
+		CreatedAt:      idempotencyKeyEntity.CreatedAt,
+		UpdatedAt:      idempotencyKeyEntity.UpdatedAt,
+	}
+}
+
+func (i idempotencyKeyRepository) toEntityIdempotencyKey(idempotencyKeyRecord *idempotencyKey) *entity.IdempotencyKey {
+	return &entity.IdempotencyKey{
+		ID:          idempotencyKeyRecord.ID,
+		Scope:       entity.IdempotencyScope(idempotencyKeyRecord.Scope),
+		Key:         idempotencyKeyRecord.IdempotencyKey,
+		RequestHash: idempotencyKeyRecord.RequestHash,
+		Response:    idempotencyKeyRecord.Response,
+		CreatedAt:   idempotencyKeyRecord.CreatedAt,
+		UpdatedAt:   idempotencyKeyRecord.UpdatedAt,
+	}
+}
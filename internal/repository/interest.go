@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-rel/rel"
+	"github.com/go-rel/rel/where"
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+type interestRepository struct {
+	db rel.Repository
+}
+
+type interestRateTier struct {
+	ID          uint            `db:"id"`
+	AccountType int             `db:"account_type"`
+	Currency    string          `db:"currency"`
+	MinBalance  decimal.Decimal `db:"min_balance"`
+	AnnualRate  decimal.Decimal `db:"annual_rate"`
+	CreatedAt   time.Time       `db:"created_at"`
+	UpdatedAt   time.Time       `db:"updated_at"`
+}
+
+type interestAccrual struct {
+	ID            uint            `db:"id"`
+	AccountID     uint            `db:"account_id"`
+	AccrualDate   time.Time       `db:"accrual_date"`
+	Balance       decimal.Decimal `db:"balance"`
+	AnnualRate    decimal.Decimal `db:"annual_rate"`
+	Amount        decimal.Decimal `db:"amount"`
+	TransactionID *uint           `db:"transaction_id"`
+	CarriedOver   bool            `db:"carried_over"`
+	CreatedAt     time.Time       `db:"created_at"`
+	UpdatedAt     time.Time       `db:"updated_at"`
+}
+
+// interestAccountID is the account of the unposted accruals
+type interestAccountID struct {
+	AccountID uint `db:"account_id"`
+}
+
+func NewInterestRepository(db rel.Repository) *interestRepository {
+	return &interestRepository{db: db}
+}
+
+// FindInterestRateTiers finds the rate tiers of every product
+func (i interestRepository) FindInterestRateTiers(ctx context.Context) ([]*entity.InterestRateTier, error) {
+	var tierRecords []interestRateTier
+	err := i.db.FindAll(ctx, &tierRecords,
+		rel.SortAsc("account_type"),
+		rel.SortAsc("currency"),
+		rel.SortAsc("min_balance"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers := make([]*entity.InterestRateTier, 0, len(tierRecords))
+	for _, tierRecord := range tierRecords {
+		tiers = append(tiers, &entity.InterestRateTier{
+			ID:          tierRecord.ID,
+			AccountType: entity.AccountType(tierRecord.AccountType),
+			Currency:    entity.Currency(tierRecord.Currency),
+			MinBalance:  tierRecord.MinBalance,
+			AnnualRate:  tierRecord.AnnualRate,
+			CreatedAt:   tierRecord.CreatedAt,
+			UpdatedAt:   tierRecord.UpdatedAt,
+		})
+	}
+
+	return tiers, nil
+}
+
+// CreateInterestAccrual stores the accrual of a day or the remainder carried over to it
+// An account is accrued once per day, a second accrual of the same day returns entity.ErrInterestAlreadyAccrued
+func (i interestRepository) CreateInterestAccrual(ctx context.Context, accrual *entity.InterestAccrual) (*entity.InterestAccrual, error) {
+	accrualRecord := i.fromEntityInterestAccrual(accrual)
+	err := i.db.Insert(ctx, accrualRecord)
+	if err != nil && !errors.Is(err, rel.ErrUniqueConstraint) {
+		return nil, err
+	} else if errors.Is(err, rel.ErrUniqueConstraint) {
+		return nil, entity.ErrInterestAlreadyAccrued
+	}
+
+	return i.toEntityInterestAccrual(accrualRecord), nil
+}
+
+// FindUnpostedInterestAccountIDs finds the accounts with an ID greater than afterID
+// that have unposted accruals before the given date, ordered by ID
+func (i interestRepository) FindUnpostedInterestAccountIDs(ctx context.Context, before time.Time, afterID uint, limit int) ([]uint, error) {
+	var accountIDRecords []interestAccountID
+	err := i.db.FindAll(ctx, &accountIDRecords, rel.SQL(`
+		SELECT DISTINCT account_id
+		FROM interest_accruals
+		WHERE transaction_id IS NULL AND accrual_date < $1 AND account_id > $2
+		ORDER BY account_id
+		LIMIT $3`,
+		before,
+		afterID,
+		limit,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	accountIDs := make([]uint, 0, len(accountIDRecords))
+	for _, accountIDRecord := range accountIDRecords {
+		accountIDs = append(accountIDs, accountIDRecord.AccountID)
+	}
+
+	return accountIDs, nil
+}
+
+// FindUnpostedInterestAccruals finds the unposted accruals of an account before the given date, ordered by date
+func (i interestRepository) FindUnpostedInterestAccruals(ctx context.Context, accountID uint, before time.Time, lock bool) ([]*entity.InterestAccrual, error) {
+	querier := []rel.Querier{
+		where.Eq("account_id", accountID),
+		where.Nil("transaction_id"),
+		where.Lt("accrual_date", before),
+		rel.SortAsc("accrual_date"),
+	}
+
+	if lock {
+		querier = append(querier, rel.ForUpdate())
+	}
+
+	var accrualRecords []interestAccrual
+	err := i.db.FindAll(ctx, &accrualRecords, querier...)
+	if err != nil {
+		return nil, err
+	}
+
+	accruals := make([]*entity.InterestAccrual, 0, len(accrualRecords))
+	for j := range accrualRecords {
+		accruals = append(accruals, i.toEntityInterestAccrual(&accrualRecords[j]))
+	}
+
+	return accruals, nil
+}
+
+// MarkInterestAccrualsPosted links the accruals to the interest transaction they are posted with
+func (i interestRepository) MarkInterestAccrualsPosted(ctx context.Context, accrualIDs []uint, transactionID uint) error {
+	ids := make([]interface{}, 0, len(accrualIDs))
+	for _, id := range accrualIDs {
+		ids = append(ids, id)
+	}
+
+	_, err := i.db.UpdateAny(ctx,
+		rel.From("interest_accruals").Where(where.In("id", ids...)),
+		rel.Set("transaction_id", transactionID),
+		rel.Set("updated_at", time.Now()),
+	)
+	return err
+}
+
+func (i interestRepository) fromEntityInterestAccrual(accrualEntity *entity.InterestAccrual) *interestAccrual {
+	accrualRecord := &interestAccrual{
+		ID:          accrualEntity.ID,
+		AccountID:   accrualEntity.AccountID,
+		AccrualDate: accrualEntity.AccrualDate,
+		Balance:     accrualEntity.Balance,
+		AnnualRate:  accrualEntity.AnnualRate,
+		Amount:      accrualEntity.Amount,
+		CarriedOver: accrualEntity.CarriedOver,
+		CreatedAt:   accrualEntity.CreatedAt,
+		UpdatedAt:   accrualEntity.UpdatedAt,
+	}
+
+	if accrualEntity.TransactionID != 0 {
+		transactionID := accrualEntity.TransactionID
+		accrualRecord.TransactionID = &transactionID
+	}
+
+	return accrualRecord
+}
+
+func (i interestRepository) toEntityInterestAccrual(accrualRecord *interestAccrual) *entity.InterestAccrual {
+	accrualEntity := &entity.InterestAccrual{
+		ID:          accrualRecord.ID,
+		AccountID:   accrualRecord.AccountID,
+		AccrualDate: accrualRecord.AccrualDate,
+		Balance:     accrualRecord.Balance,
+		AnnualRate:  accrualRecord.AnnualRate,
+		Amount:      accrualRecord.Amount,
+		CarriedOver: accrualRecord.CarriedOver,
+		CreatedAt:   accrualRecord.CreatedAt,
+		UpdatedAt:   accrualRecord.UpdatedAt,
+	}
+
+	if accrualRecord.TransactionID != nil {
+		accrualEntity.TransactionID = *accrualRecord.TransactionID
+	}
+
+	return accrualEntity
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-rel/rel"
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+type journalRepository struct {
+	db rel.Repository
+}
+
+type journal struct {
+	ID          uint      `db:"id"`
+	Description string    `db:"description"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+type journalEntry struct {
+	ID            uint            `db:"id"`
+	JournalID     uint            `db:"journal_id"`
+	AccountID     uint            `db:"account_id"`
+	TransactionID *uint           `db:"transaction_id"`
+	Type          int             `db:"type"`
+	Amount        decimal.Decimal `db:"amount"`
+	Currency      string          `db:"currency"`
+	CreatedAt     time.Time       `db:"created_at"`
+	UpdatedAt     time.Time       `db:"updated_at"`
+}
+
+type trialBalanceLine struct {
+	AccountID     uint            `db:"account_id"`
+	AccountNumber string          `db:"account_number"`
+	AccountType   int             `db:"account_type"`
+	Currency      string          `db:"currency"`
+	TotalDebit    decimal.Decimal `db:"total_debit"`
+	TotalCredit   decimal.Decimal `db:"total_credit"`
+}
+
+func NewJournalRepository(db rel.Repository) *journalRepository {
+	return &journalRepository{db: db}
+}
+
+// CreateJournal inserts the journal together with its entries
+// It must be called inside the database transaction of the balance update
+func (j journalRepository) CreateJournal(ctx context.Context, newJournal *entity.Journal) (*entity.Journal, error) {
+	journalRecord := &journal{
+		Description: newJournal.Description,
+	}
+
+	err := j.db.Insert(ctx, journalRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	entryRecords := make([]journalEntry, 0, len(newJournal.Entries))
+	for _, entry := range newJournal.Entries {
+		entryRecord := j.fromEntityJournalEntry(entry)
+		entryRecord.JournalID = journalRecord.ID
+		entryRecords = append(entryRecords, *entryRecord)
+	}
+
+	err = j.db.InsertAll(ctx, &entryRecords)
+	if err != nil {
+		return nil, err
+	}
+
+	createdJournal := &entity.Journal{
+		ID:          journalRecord.ID,
+		Description: journalRecord.Description,
+		Entries:     make([]*entity.JournalEntry, 0, len(entryRecords)),
+		CreatedAt:   journalRecord.CreatedAt,
+		UpdatedAt:   journalRecord.UpdatedAt,
+	}
+
+	for i := range entryRecords {
+		createdJournal.Entries = append(createdJournal.Entries, j.toEntityJournalEntry(&entryRecords[i]))
+	}
+
+	return createdJournal, nil
+}
+
+// FindTrialBalance sums the debit and credit entries of every account and currency
+func (j journalRepository) FindTrialBalance(ctx context.Context) ([]*entity.TrialBalanceLine, error) {
+	var lineRecords []trialBalanceLine
+	err := j.db.FindAll(ctx, &lineRecords, rel.SQL(`
+		SELECT journal_entries.account_id, accounts.account_number, accounts.account_type, journal_entries.currency,
+			COALESCE(SUM(journal_entries.amount) FILTER (WHERE journal_entries.type = $1), 0) AS total_debit,
+			COALESCE(SUM(journal_entries.amount) FILTER (WHERE journal_entries.type = $2), 0) AS total_credit
+		FROM journal_entries
+		JOIN accounts ON accounts.id = journal_entries.account_id
+		GROUP BY journal_entries.account_id, accounts.account_number, accounts.account_type, journal_entries.currency
+		ORDER BY accounts.account_number, journal_entries.currency`,
+		int(entity.TransactionTypeDebit),
+		int(entity.TransactionTypeCredit),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]*entity.TrialBalanceLine, 0, len(lineRecords))
+	for _, lineRecord := range lineRecords {
+		lines = append(lines, &entity.TrialBalanceLine{
+			AccountID:     lineRecord.AccountID,
+			AccountNumber: lineRecord.AccountNumber,
+			AccountType:   entity.AccountType(lineRecord.AccountType),
+			Currency:      entity.Currency(lineRecord.Currency),
+			TotalDebit:    lineRecord.TotalDebit,
+			TotalCredit:   lineRecord.TotalCredit,
+		})
+	}
+
+	return lines, nil
+}
+
+func (j journalRepository) fromEntityJournalEntry(entryEntity *entity.JournalEntry) *journalEntry {
+	entryRecord := &journalEntry{
+		ID:        entryEntity.ID,
+		JournalID: entryEntity.JournalID,
+		AccountID: entryEntity.AccountID,
+		Type:      int(entryEntity.Type),
+		Amount:    entryEntity.Amount,
+		Currency:  string(entryEntity.Currency),
+		CreatedAt: entryEntity.CreatedAt,
+		UpdatedAt: entryEntity.UpdatedAt,
+	}
+
+	if entryEntity.TransactionID != 0 {
+		transactionID := entryEntity.TransactionID
+		entryRecord.TransactionID = &transactionID
+	}
+
+	return entryRecord
+}
+
+func (j journalRepository) toEntityJournalEntry(entryRecord *journalEntry) *entity.JournalEntry {
+	entryEntity := &entity.JournalEntry{
+		ID:        entryRecord.ID,
+		JournalID: entryRecord.JournalID,
+		AccountID: entryRecord.AccountID,
+		Type:      entity.TransactionType(entryRecord.Type),
+		Amount:    entryRecord.Amount,
+		Currency:  entity.Currency(entryRecord.Currency),
+		CreatedAt: entryRecord.CreatedAt,
+		UpdatedAt: entryRecord.UpdatedAt,
+	}
+
+	if entryRecord.TransactionID != nil {
+		entryEntity.TransactionID = *entryRecord.TransactionID
+	}
+
+	return entryEntity
+}
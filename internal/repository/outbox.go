@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-rel/rel"
+	"github.com/go-rel/rel/where"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+type outboxRepository struct {
+	db rel.Repository
+}
+
+type outboxEvent struct {
+	ID            uint       `db:"id"`
+	AggregateType string     `db:"aggregate_type"`
+	AggregateID   uint       `db:"aggregate_id"`
+	EventType     string     `db:"event_type"`
+	Payload       string     `db:"payload"`
+	Status        int        `db:"status"`
+	Attempts      int        `db:"attempts"`
+	NextAttemptAt time.Time  `db:"next_attempt_at"`
+	DeliveredAt   *time.Time `db:"delivered_at"`
+	LastError     string     `db:"last_error"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+}
+
+func NewOutboxRepository(db rel.Repository) *outboxRepository {
+	return &outboxRepository{db: db}
+}
+
+func (o outboxRepository) CreateOutboxEvent(ctx context.Context, event *entity.OutboxEvent) (*entity.OutboxEvent, error) {
+	eventRecord := o.fromEntityOutboxEvent(event)
+	err := o.db.Insert(ctx, eventRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.toEntityOutboxEvent(eventRecord), nil
+}
+
+// FindPendingOutboxEvents finds the pending events that are due at now in insertion order
+// The rows are locked and rows locked by another relay are skipped, so several relays can run concurrently
+func (o outboxRepository) FindPendingOutboxEvents(ctx context.Context, now time.Time, limit int) ([]*entity.OutboxEvent, error) {
+	var eventRecords []outboxEvent
+	err := o.db.FindAll(ctx, &eventRecords,
+		where.Eq("status", int(entity.OutboxStatusPending)),
+		where.Lte("next_attempt_at", now),
+		rel.SortAsc("id"),
+		rel.Limit(limit),
+		rel.Lock("FOR UPDATE SKIP LOCKED"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*entity.OutboxEvent, 0, len(eventRecords))
+	for i := range eventRecords {
+		events = append(events, o.toEntityOutboxEvent(&eventRecords[i]))
+	}
+
+	return events, nil
+}
+
+func (o outboxRepository) UpdateOutboxEvent(ctx context.Context, event *entity.OutboxEvent) (*entity.OutboxEvent, error) {
+	eventRecord := o.fromEntityOutboxEvent(event)
+	err := o.db.Update(ctx, eventRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.toEntityOutboxEvent(eventRecord), nil
+}
+
+func (o outboxRepository) fromEntityOutboxEvent(eventEntity *entity.OutboxEvent) *outboxEvent {
+	eventRecord := &outboxEvent{
+		ID:            eventEntity.ID,
+		AggregateType: eventEntity.AggregateType,
+		AggregateID:   eventEntity.AggregateID,
+		EventType:     string(eventEntity.EventType),
+		Payload:       eventEntity.Payload,
+		Status:        int(eventEntity.Status),
+		Attempts:      eventEntity.Attempts,
+		NextAttemptAt: eventEntity.NextAttemptAt,
+		LastError:     eventEntity.LastError,
+		CreatedAt:     eventEntity.CreatedAt,
+		UpdatedAt:     eventEntity.UpdatedAt,
+	}
+
+	if !eventEntity.DeliveredAt.IsZero() {
+		deliveredAt := eventEntity.DeliveredAt
+		eventRecord.DeliveredAt = &deliveredAt
+	}
+
+	return eventRecord
+}
+
+func (o outboxRepository) toEntityOutboxEvent(eventRecord *outboxEvent) *entity.OutboxEvent {
+	eventEntity := &entity.OutboxEvent{
+		ID:            eventRecord.ID,
+		AggregateType: eventRecord.AggregateType,
+		AggregateID:   eventRecord.AggregateID,
+		EventType:     entity.EventType(eventRecord.EventType),
+		Payload:       eventRecord.Payload,
+		Status:        entity.OutboxStatus(eventRecord.Status),
+		Attempts:      eventRecord.Attempts,
+		NextAttemptAt: eventRecord.NextAttemptAt,
+		LastError:     eventRecord.LastError,
+		CreatedAt:     eventRecord.CreatedAt,
+		UpdatedAt:     eventRecord.UpdatedAt,
+	}
+
+	if eventRecord.DeliveredAt != nil {
+		eventEntity.DeliveredAt = *eventRecord.DeliveredAt
+	}
+
+	return eventEntity
+}
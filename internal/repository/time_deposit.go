@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-rel/rel"
+	"github.com/go-rel/rel/where"
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+type timeDepositRepository struct {
+	db rel.Repository
+}
+
+type timeDeposit struct {
+	ID                  uint            `db:"id"`
+	AccountID           uint            `db:"account_id"`
+	FundingAccountID    uint            `db:"funding_account_id"`
+	Principal           decimal.Decimal `db:"principal"`
+	InterestRate        decimal.Decimal `db:"interest_rate"`
+	TermMonths          int             `db:"term_months"`
+	MaturityInstruction int             `db:"maturity_instruction"`
+	Status              int             `db:"status"`
+	OpenDate            time.Time       `db:"open_date"`
+	StartDate           time.Time       `db:"start_date"`
+	MaturityDate        time.Time       `db:"maturity_date"`
+	Rollovers           int             `db:"rollovers"`
+	CreatedAt           time.Time       `db:"created_at"`
+	UpdatedAt           time.Time       `db:"updated_at"`
+}
+
+type timeDepositRate struct {
+	ID           uint            `db:"id"`
+	Currency     string          `db:"currency"`
+	TermMonths   int             `db:"term_months"`
+	InterestRate decimal.Decimal `db:"interest_rate"`
+	CreatedAt    time.Time       `db:"created_at"`
+	UpdatedAt    time.Time       `db:"updated_at"`
+}
+
+func NewTimeDepositRepository(db rel.Repository) *timeDepositRepository {
+	return &timeDepositRepository{db: db}
+}
+
+func (t timeDepositRepository) CreateTimeDeposit(ctx context.Context, deposit *entity.TimeDeposit) (*entity.TimeDeposit, error) {
+	depositRecord := t.fromEntityTimeDeposit(deposit)
+	err := t.db.Insert(ctx, depositRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.toEntityTimeDeposit(depositRecord), nil
+}
+
+func (t timeDepositRepository) FindTimeDepositByID(ctx context.Context, id uint, lock bool) (*entity.TimeDeposit, error) {
+	querier := []rel.Querier{
+		where.Eq("id", id),
+	}
+
+	if lock {
+		querier = append(querier, rel.ForUpdate())
+	}
+
+	depositRecord := new(timeDeposit)
+	err := t.db.Find(ctx, depositRecord, querier...)
+	if err != nil && errors.Is(err, rel.ErrNotFound) {
+		return nil, entity.ErrTimeDepositNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return t.toEntityTimeDeposit(depositRecord), nil
+}
+
+// FindTimeDepositRate finds the annual interest rate of the time deposits opened for the term in the currency
+func (t timeDepositRepository) FindTimeDepositRate(ctx context.Context, currency entity.Currency, termMonths int) (decimal.Decimal, error) {
+	rateRecord := new(timeDepositRate)
+	err := t.db.Find(ctx, rateRecord,
+		where.Eq("currency", string(currency)),
+		where.Eq("term_months", termMonths),
+	)
+	if err != nil && errors.Is(err, rel.ErrNotFound) {
+		return decimal.Zero, entity.ErrTimeDepositRateNotFound
+	} else if err != nil {
+		return decimal.Zero, err
+	}
+
+	return rateRecord.InterestRate, nil
+}
+
+// FindMaturedTimeDeposits finds the active deposits with an ID greater than afterID
+// that reached their maturity date at now, ordered by ID
+func (t timeDepositRepository) FindMaturedTimeDeposits(ctx context.Context, now time.Time, afterID uint, limit int) ([]*entity.TimeDeposit, error) {
+	var depositRecords []timeDeposit
+	err := t.db.FindAll(ctx, &depositRecords,
+		where.Eq("status", int(entity.TimeDepositStatusActive)),
+		where.Lte("maturity_date", now),
+		where.Gt("id", afterID),
+		rel.SortAsc("id"),
+		rel.Limit(limit),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	deposits := make([]*entity.TimeDeposit, 0, len(depositRecords))
+	for i := range depositRecords {
+		deposits = append(deposits, t.toEntityTimeDeposit(&depositRecords[i]))
+	}
+
+	return deposits, nil
+}
+
+func (t timeDepositRepository) UpdateTimeDeposit(ctx context.Context, deposit *entity.TimeDeposit) (*entity.TimeDeposit, error) {
+	depositRecord := t.fromEntityTimeDeposit(deposit)
+	err := t.db.Update(ctx, depositRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.toEntityTimeDeposit(depositRecord), nil
+}
+
+func (t timeDepositRepository) fromEntityTimeDeposit(depositEntity *entity.TimeDeposit) *timeDeposit {
+	return &timeDeposit{
+		ID:                  depositEntity.ID,
+		AccountID:           depositEntity.AccountID,
+		FundingAccountID:    depositEntity.FundingAccountID,
+		Principal:           depositEntity.Principal,
+		InterestRate:        depositEntity.InterestRate,
+		TermMonths:          depositEntity.TermMonths,
+		MaturityInstruction: int(depositEntity.MaturityInstruction),
+		Status:              int(depositEntity.Status),
+		OpenDate:            depositEntity.OpenDate,
+		StartDate:           depositEntity.StartDate,
+		MaturityDate:        depositEntity.MaturityDate,
+		Rollovers:           depositEntity.Rollovers,
+		CreatedAt:           depositEntity.CreatedAt,
+		UpdatedAt:           depositEntity.UpdatedAt,
+	}
+}
+
+func (t timeDepositRepository) toEntityTimeDeposit(depositRecord *timeDeposit) *entity.TimeDeposit {
+	return &entity.TimeDeposit{
+		ID:                  depositRecord.ID,
+		AccountID:           depositRecord.AccountID,
+		FundingAccountID:    depositRecord.FundingAccountID,
+		Principal:           depositRecord.Principal,
+		InterestRate:        depositRecord.InterestRate,
+		TermMonths:          depositRecord.TermMonths,
+		MaturityInstruction: entity.MaturityInstruction(depositRecord.MaturityInstruction),
+		Status:              entity.TimeDepositStatus(depositRecord.Status),
+		OpenDate:            depositRecord.OpenDate,
+		StartDate:           depositRecord.StartDate,
+		MaturityDate:        depositRecord.MaturityDate,
+		Rollovers:           depositRecord.Rollovers,
+		CreatedAt:           depositRecord.CreatedAt,
+		UpdatedAt:           depositRecord.UpdatedAt,
+	}
+}
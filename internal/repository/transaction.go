@@ -2,9 +2,11 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/go-rel/rel"
+	"github.com/go-rel/rel/where"
 	"github.com/shopspring/decimal"
 	"imansohibul.my.id/account-domain-service/entity"
 )
@@ -14,24 +16,50 @@ type transactionRepository struct {
 }
 
 type transaction struct {
-	ID             uint            `db:"id"`
-	AccountID      uint            `db:"account_id"`
-	Type           int             `db:"type"`
-	Amount         decimal.Decimal `db:"amount"`
-	InitialBalance decimal.Decimal `db:"initial_balance"`
-	FinalBalance   decimal.Decimal `db:"final_balance"`
-	Currency       int             `db:"currency"`
-	CreatedAt      time.Time       `db:"created_at"`
-	UpdatedAt      time.Time       `db:"updated_at"`
+	ID                  uint             `db:"id"`
+	AccountID           uint             `db:"account_id"`
+	Type                int              `db:"type"`
+	Amount              decimal.Decimal  `db:"amount"`
+	InitialBalance      decimal.Decimal  `db:"initial_balance"`
+	FinalBalance        decimal.Decimal  `db:"final_balance"`
+	Currency            string           `db:"currency"`
+	LinkedTransactionID *uint            `db:"linked_transaction_id"`
+	ExchangeRateID      *uint            `db:"exchange_rate_id"`
+	ExchangeRate        *decimal.Decimal `db:"exchange_rate"`
+	SourceAmount        *decimal.Decimal `db:"source_amount"`
+	SourceCurrency      *string          `db:"source_currency"`
+	TargetAmount        *decimal.Decimal `db:"target_amount"`
+	TargetCurrency      *string          `db:"target_currency"`
+	Channel             int              `db:"channel"`
+	Reference           *string          `db:"reference"`
+	Description         *string          `db:"description"`
+	CounterpartyName    *string          `db:"counterparty_name"`
+	CounterpartyAccount *string          `db:"counterparty_account_number"`
+	CreatedAt           time.Time        `db:"created_at"`
+	UpdatedAt           time.Time        `db:"updated_at"`
 }
 
 func NewTransactionRepository(db rel.Repository) *transactionRepository {
 	return &transactionRepository{db: db}
 }
 
+// CreateTransaction stores a transaction
+// returns ErrTransactionReferenceAlreadyExists when its reference is already used on the channel
 func (t transactionRepository) CreateTransaction(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error) {
 	transactionRecord := t.fromEntityTransaction(transaction)
 	err := t.db.Insert(ctx, transactionRecord)
+	if err != nil && !errors.Is(err, rel.ErrUniqueConstraint) {
+		return nil, err
+	} else if errors.Is(err, rel.ErrUniqueConstraint) {
+		return nil, entity.ErrTransactionReferenceAlreadyExists
+	}
+
+	return t.toEntityTransaction(transactionRecord), nil
+}
+
+func (t transactionRepository) UpdateTransaction(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error) {
+	transactionRecord := t.fromEntityTransaction(transaction)
+	err := t.db.Update(ctx, transactionRecord)
 	if err != nil {
 		return nil, err
 	}
@@ -39,20 +67,231 @@ func (t transactionRepository) CreateTransaction(ctx context.Context, transactio
 	return t.toEntityTransaction(transactionRecord), nil
 }
 
+func (t transactionRepository) FindTransactionByID(ctx context.Context, id uint) (*entity.Transaction, error) {
+	transactionRecord := new(transaction)
+	err := t.db.Find(ctx, transactionRecord, where.Eq("id", id))
+	if err != nil && errors.Is(err, rel.ErrNotFound) {
+		return nil, entity.ErrTransactionNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return t.toEntityTransaction(transactionRecord), nil
+}
+
+func (t transactionRepository) FindTransactions(ctx context.Context, filter *entity.TransactionFilter) ([]*entity.Transaction, error) {
+	querier := []rel.Querier{
+		where.Eq("account_id", filter.AccountID),
+		rel.SortDesc("created_at"),
+		rel.SortDesc("id"),
+		rel.Limit(filter.Limit),
+	}
+
+	if filter.Type != entity.TransactionTypeUnspecified {
+		querier = append(querier, where.Eq("type", int(filter.Type)))
+	}
+
+	if !filter.StartTime.IsZero() {
+		querier = append(querier, where.Gte("created_at", filter.StartTime))
+	}
+
+	if !filter.EndTime.IsZero() {
+		querier = append(querier, where.Lt("created_at", filter.EndTime))
+	}
+
+	if !filter.MinAmount.IsZero() {
+		querier = append(querier, where.Gte("amount", filter.MinAmount))
+	}
+
+	if !filter.MaxAmount.IsZero() {
+		querier = append(querier, where.Lte("amount", filter.MaxAmount))
+	}
+
+	// Keyset pagination, only rows strictly older than the cursor:
+	// created_at < cursor.created_at OR (created_at = cursor.created_at AND id < cursor.id)
+	if filter.After != nil {
+		querier = append(querier, where.Or(
+			where.Lt("created_at", filter.After.CreatedAt),
+			where.And(
+				where.Eq("created_at", filter.After.CreatedAt),
+				where.Lt("id", filter.After.ID),
+			),
+		))
+	}
+
+	var transactionRecords []transaction
+	err := t.db.FindAll(ctx, &transactionRecords, querier...)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*entity.Transaction, 0, len(transactionRecords))
+	for i := range transactionRecords {
+		transactions = append(transactions, t.toEntityTransaction(&transactionRecords[i]))
+	}
+
+	return transactions, nil
+}
+
+// FindTransactionsByAccountID finds the transactions of an account with an ID greater than afterID
+// ordered by ID, which is the order the transactions were applied to the balance
+func (t transactionRepository) FindTransactionsByAccountID(ctx context.Context, accountID, afterID uint, limit int) ([]*entity.Transaction, error) {
+	var transactionRecords []transaction
+	err := t.db.FindAll(ctx, &transactionRecords,
+		where.Eq("account_id", accountID),
+		where.Gt("id", afterID),
+		rel.SortAsc("id"),
+		rel.Limit(limit),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*entity.Transaction, 0, len(transactionRecords))
+	for i := range transactionRecords {
+		transactions = append(transactions, t.toEntityTransaction(&transactionRecords[i]))
+	}
+
+	return transactions, nil
+}
+
+// FindTransactionsBetween finds the transactions of an account created from start (inclusive) to end (exclusive)
+// with an ID greater than afterID ordered by ID, which is the order the transactions were applied to the balance
+func (t transactionRepository) FindTransactionsBetween(ctx context.Context, accountID uint, start, end time.Time, afterID uint, limit int) ([]*entity.Transaction, error) {
+	var transactionRecords []transaction
+	err := t.db.FindAll(ctx, &transactionRecords,
+		where.Eq("account_id", accountID),
+		where.Gte("created_at", start),
+		where.Lt("created_at", end),
+		where.Gt("id", afterID),
+		rel.SortAsc("id"),
+		rel.Limit(limit),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*entity.Transaction, 0, len(transactionRecords))
+	for i := range transactionRecords {
+		transactions = append(transactions, t.toEntityTransaction(&transactionRecords[i]))
+	}
+
+	return transactions, nil
+}
+
+// FindBalanceAt finds the balance of an account at the given time,
+// which is the final balance of the last transaction created before it, zero when there is none
+func (t transactionRepository) FindBalanceAt(ctx context.Context, accountID uint, at time.Time) (decimal.Decimal, error) {
+	transactionRecord := new(transaction)
+	err := t.db.Find(ctx, transactionRecord,
+		where.Eq("account_id", accountID),
+		where.Lt("created_at", at),
+		rel.SortDesc("id"),
+	)
+	if err != nil && errors.Is(err, rel.ErrNotFound) {
+		return decimal.Zero, nil
+	} else if err != nil {
+		return decimal.Zero, err
+	}
+
+	return transactionRecord.FinalBalance, nil
+}
+
+type withdrawalUsage struct {
+	DailyAmount   decimal.Decimal `db:"daily_amount"`
+	DailyCount    int             `db:"daily_count"`
+	MonthlyAmount decimal.Decimal `db:"monthly_amount"`
+}
+
+// FindWithdrawalUsage sums the withdrawals of an account made since the start of the day and of the month.
+// A withdrawal is a debit without a linked transaction, the debits of transfers and time deposits have one.
+// The debits of the captured holds are payments, not withdrawals, and a reversed withdrawal doesn't count
+func (t transactionRepository) FindWithdrawalUsage(ctx context.Context, accountID uint, dayStart, monthStart time.Time) (*entity.WithdrawalUsage, error) {
+	var usageRecords []withdrawalUsage
+	err := t.db.FindAll(ctx, &usageRecords, rel.SQL(`
+		SELECT COALESCE(SUM(amount) FILTER (WHERE created_at >= $2), 0) AS daily_amount,
+			COUNT(*) FILTER (WHERE created_at >= $2) AS daily_count,
+			COALESCE(SUM(amount), 0) AS monthly_amount
+		FROM transactions
+		WHERE account_id = $1 AND type = $4 AND linked_transaction_id IS NULL AND created_at >= $3
+			AND NOT EXISTS (SELECT 1 FROM holds WHERE holds.transaction_id = transactions.id)
+			AND NOT EXISTS (SELECT 1 FROM transaction_reversals WHERE transaction_reversals.transaction_id = transactions.id)`,
+		accountID,
+		dayStart,
+		monthStart,
+		int(entity.TransactionTypeDebit),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(usageRecords) == 0 {
+		return &entity.WithdrawalUsage{}, nil
+	}
+
+	return &entity.WithdrawalUsage{
+		DailyAmount:   usageRecords[0].DailyAmount,
+		DailyCount:    usageRecords[0].DailyCount,
+		MonthlyAmount: usageRecords[0].MonthlyAmount,
+	}, nil
+}
+
 func (t transactionRepository) fromEntityTransaction(transactionEntity *entity.Transaction) *transaction {
-	return &transaction{
+	transactionRecord := &transaction{
 		ID:             transactionEntity.ID,
 		AccountID:      transactionEntity.AccountID,
 		Type:           int(transactionEntity.Type),
 		Amount:         transactionEntity.Amount,
 		InitialBalance: transactionEntity.InitialBalance,
 		FinalBalance:   transactionEntity.FinalBalance,
-		Currency:       int(transactionEntity.Currency),
+		Currency:       string(transactionEntity.Currency),
+		Channel:        int(transactionEntity.Channel),
+		CreatedAt:      transactionEntity.CreatedAt,
+		UpdatedAt:      transactionEntity.UpdatedAt,
+	}
+
+	if transactionEntity.Reference != "" {
+		reference := transactionEntity.Reference
+		transactionRecord.Reference = &reference
+	}
+
+	if transactionEntity.Description != "" {
+		description := transactionEntity.Description
+		transactionRecord.Description = &description
 	}
+
+	if counterparty := transactionEntity.Counterparty; counterparty != nil {
+		transactionRecord.CounterpartyName = &counterparty.Name
+		if counterparty.AccountNumber != "" {
+			transactionRecord.CounterpartyAccount = &counterparty.AccountNumber
+		}
+	}
+
+	if transactionEntity.LinkedTransactionID != 0 {
+		linkedTransactionID := transactionEntity.LinkedTransactionID
+		transactionRecord.LinkedTransactionID = &linkedTransactionID
+	}
+
+	if conversion := transactionEntity.Conversion; conversion != nil {
+		var (
+			exchangeRateID = conversion.ExchangeRateID
+			sourceCurrency = string(conversion.SourceCurrency)
+			targetCurrency = string(conversion.TargetCurrency)
+		)
+
+		transactionRecord.ExchangeRateID = &exchangeRateID
+		transactionRecord.ExchangeRate = &conversion.Rate
+		transactionRecord.SourceAmount = &conversion.SourceAmount
+		transactionRecord.SourceCurrency = &sourceCurrency
+		transactionRecord.TargetAmount = &conversion.TargetAmount
+		transactionRecord.TargetCurrency = &targetCurrency
+	}
+
+	return transactionRecord
 }
 
 func (t transactionRepository) toEntityTransaction(transactionRecord *transaction) *entity.Transaction {
-	return &entity.Transaction{
+	transactionEntity := &entity.Transaction{
 		ID:             transactionRecord.ID,
 		AccountID:      transactionRecord.AccountID,
 		Type:           entity.TransactionType(transactionRecord.Type),
@@ -60,5 +299,40 @@ func (t transactionRepository) toEntityTransaction(transactionRecord *transactio
 		InitialBalance: transactionRecord.InitialBalance,
 		FinalBalance:   transactionRecord.FinalBalance,
 		Currency:       entity.Currency(transactionRecord.Currency),
+		Channel:        entity.Channel(transactionRecord.Channel),
+		CreatedAt:      transactionRecord.CreatedAt,
+		UpdatedAt:      transactionRecord.UpdatedAt,
 	}
+
+	if transactionRecord.Reference != nil {
+		transactionEntity.Reference = *transactionRecord.Reference
+	}
+
+	if transactionRecord.Description != nil {
+		transactionEntity.Description = *transactionRecord.Description
+	}
+
+	if transactionRecord.CounterpartyName != nil {
+		transactionEntity.Counterparty = &entity.Counterparty{Name: *transactionRecord.CounterpartyName}
+		if transactionRecord.CounterpartyAccount != nil {
+			transactionEntity.Counterparty.AccountNumber = *transactionRecord.CounterpartyAccount
+		}
+	}
+
+	if transactionRecord.LinkedTransactionID != nil {
+		transactionEntity.LinkedTransactionID = *transactionRecord.LinkedTransactionID
+	}
+
+	if transactionRecord.ExchangeRateID != nil {
+		transactionEntity.Conversion = &entity.CurrencyConversion{
+			ExchangeRateID: *transactionRecord.ExchangeRateID,
+			Rate:           *transactionRecord.ExchangeRate,
+			SourceAmount:   *transactionRecord.SourceAmount,
+			SourceCurrency: entity.Currency(*transactionRecord.SourceCurrency),
+			TargetAmount:   *transactionRecord.TargetAmount,
+			TargetCurrency: entity.Currency(*transactionRecord.TargetCurrency),
+		}
+	}
+
+	return transactionEntity
 }
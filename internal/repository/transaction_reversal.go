@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-rel/rel"
+	"github.com/go-rel/rel/where"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+type transactionReversalRepository struct {
+	db rel.Repository
+}
+
+type transactionReversal struct {
+	ID                    uint      `db:"id"`
+	TransactionID         uint      `db:"transaction_id"`
+	ReversalTransactionID uint      `db:"reversal_transaction_id"`
+	Operator              string    `db:"operator"`
+	Reason                string    `db:"reason"`
+	Forced                bool      `db:"forced"`
+	CreatedAt             time.Time `db:"created_at"`
+	UpdatedAt             time.Time `db:"updated_at"`
+}
+
+func NewTransactionReversalRepository(db rel.Repository) *transactionReversalRepository {
+	return &transactionReversalRepository{db: db}
+}
+
+// CreateTransactionReversal records the reversal of a transaction
+// returns ErrTransactionAlreadyReversed when the transaction is already reversed
+func (t transactionReversalRepository) CreateTransactionReversal(ctx context.Context, reversal *entity.TransactionReversal) (*entity.TransactionReversal, error) {
+	reversalRecord := t.fromEntityTransactionReversal(reversal)
+
+	err := t.db.Insert(ctx, reversalRecord)
+	if err != nil && !errors.Is(err, rel.ErrUniqueConstraint) {
+		return nil, err
+	} else if errors.Is(err, rel.ErrUniqueConstraint) {
+		return nil, entity.ErrTransactionAlreadyReversed
+	}
+
+	return t.toEntityTransactionReversal(reversalRecord), nil
+}
+
+// FindTransactionReversal finds the reversal of a transaction
+func (t transactionReversalRepository) FindTransactionReversal(ctx context.Context, transactionID uint) (*entity.TransactionReversal, error) {
+	reversalRecord := new(transactionReversal)
+	err := t.db.Find(ctx, reversalRecord, where.Eq("transaction_id", transactionID))
+	if err != nil && errors.Is(err, rel.ErrNotFound) {
+		return nil, entity.ErrTransactionReversalNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return t.toEntityTransactionReversal(reversalRecord), nil
+}
+
+func (t transactionReversalRepository) fromEntityTransactionReversal(reversalEntity *entity.TransactionReversal) *transactionReversal {
+	return &transactionReversal{
+		ID:                    reversalEntity.ID,
+		TransactionID:         reversalEntity.TransactionID,
+		ReversalTransactionID: reversalEntity.ReversalTransactionID,
+		Operator:              reversalEntity.Operator,
+		Reason:                reversalEntity.Reason,
+		Forced:                reversalEntity.Forced,
+		CreatedAt:             reversalEntity.CreatedAt,
+		UpdatedAt:             reversalEntity.UpdatedAt,
+	}
+}
+
+func (t transactionReversalRepository) toEntityTransactionReversal(reversalRecord *transactionReversal) *entity.TransactionReversal {
+	return &entity.TransactionReversal{
+		ID:                    reversalRecord.ID,
+		TransactionID:         reversalRecord.TransactionID,
+		ReversalTransactionID: reversalRecord.ReversalTransactionID,
+		Operator:              reversalRecord.Operator,
+		Reason:                reversalRecord.Reason,
+		Forced:                reversalRecord.Forced,
+		CreatedAt:             reversalRecord.CreatedAt,
+		UpdatedAt:             reversalRecord.UpdatedAt,
+	}
+}
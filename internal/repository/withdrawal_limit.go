@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-rel/rel"
+	"github.com/go-rel/rel/where"
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+type withdrawalLimitRepository struct {
+	db rel.Repository
+}
+
+type withdrawalLimit struct {
+	ID                uint            `db:"id"`
+	AccountType       int             `db:"account_type"`
+	CustomerTier      int             `db:"customer_tier"`
+	Currency          string          `db:"currency"`
+	MaxPerTransaction decimal.Decimal `db:"max_per_transaction"`
+	MaxDaily          decimal.Decimal `db:"max_daily"`
+	MaxMonthly        decimal.Decimal `db:"max_monthly"`
+	MaxDailyCount     int             `db:"max_daily_count"`
+	CreatedAt         time.Time       `db:"created_at"`
+	UpdatedAt         time.Time       `db:"updated_at"`
+}
+
+type accountWithdrawalLimit struct {
+	ID                uint            `db:"id"`
+	AccountID         uint            `db:"account_id"`
+	MaxPerTransaction decimal.Decimal `db:"max_per_transaction"`
+	MaxDaily          decimal.Decimal `db:"max_daily"`
+	MaxMonthly        decimal.Decimal `db:"max_monthly"`
+	MaxDailyCount     int             `db:"max_daily_count"`
+	CreatedAt         time.Time       `db:"created_at"`
+	UpdatedAt         time.Time       `db:"updated_at"`
+}
+
+func NewWithdrawalLimitRepository(db rel.Repository) *withdrawalLimitRepository {
+	return &withdrawalLimitRepository{db: db}
+}
+
+// FindWithdrawalLimit finds the withdrawal limits of a product for a customer tier
+func (w withdrawalLimitRepository) FindWithdrawalLimit(ctx context.Context, accountType entity.AccountType, customerTier entity.CustomerTier, currency entity.Currency) (*entity.WithdrawalLimit, error) {
+	limitRecord := new(withdrawalLimit)
+	err := w.db.Find(ctx, limitRecord,
+		where.Eq("account_type", int(accountType)),
+		where.Eq("customer_tier", int(customerTier)),
+		where.Eq("currency", string(currency)),
+	)
+	if err != nil && errors.Is(err, rel.ErrNotFound) {
+		return nil, entity.ErrWithdrawalLimitNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return &entity.WithdrawalLimit{
+		ID:                limitRecord.ID,
+		AccountType:       entity.AccountType(limitRecord.AccountType),
+		CustomerTier:      entity.CustomerTier(limitRecord.CustomerTier),
+		Currency:          entity.Currency(limitRecord.Currency),
+		MaxPerTransaction: limitRecord.MaxPerTransaction,
+		MaxDaily:          limitRecord.MaxDaily,
+		MaxMonthly:        limitRecord.MaxMonthly,
+		MaxDailyCount:     limitRecord.MaxDailyCount,
+		CreatedAt:         limitRecord.CreatedAt,
+		UpdatedAt:         limitRecord.UpdatedAt,
+	}, nil
+}
+
+// FindAccountWithdrawalLimit finds the withdrawal limits that override the limits of the product of an account
+func (w withdrawalLimitRepository) FindAccountWithdrawalLimit(ctx context.Context, accountID uint) (*entity.WithdrawalLimit, error) {
+	limitRecord := new(accountWithdrawalLimit)
+	err := w.db.Find(ctx, limitRecord, where.Eq("account_id", accountID))
+	if err != nil && errors.Is(err, rel.ErrNotFound) {
+		return nil, entity.ErrWithdrawalLimitNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	return w.toEntityAccountWithdrawalLimit(limitRecord), nil
+}
+
+func (w withdrawalLimitRepository) CreateAccountWithdrawalLimit(ctx context.Context, limit *entity.WithdrawalLimit) (*entity.WithdrawalLimit, error) {
+	limitRecord := w.fromEntityAccountWithdrawalLimit(limit)
+	err := w.db.Insert(ctx, limitRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.toEntityAccountWithdrawalLimit(limitRecord), nil
+}
+
+func (w withdrawalLimitRepository) UpdateAccountWithdrawalLimit(ctx context.Context, limit *entity.WithdrawalLimit) (*entity.WithdrawalLimit, error) {
+	limitRecord := w.fromEntityAccountWithdrawalLimit(limit)
+	err := w.db.Update(ctx, limitRecord)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.toEntityAccountWithdrawalLimit(limitRecord), nil
+}
+
+// DeleteAccountWithdrawalLimit removes the override of the withdrawal limits of an account,
+// so the account is limited by its product again
+func (w withdrawalLimitRepository) DeleteAccountWithdrawalLimit(ctx context.Context, limit *entity.WithdrawalLimit) error {
+	return w.db.Delete(ctx, w.fromEntityAccountWithdrawalLimit(limit))
+}
+
+func (w withdrawalLimitRepository) fromEntityAccountWithdrawalLimit(limitEntity *entity.WithdrawalLimit) *accountWithdrawalLimit {
+	return &accountWithdrawalLimit{
+		ID:                limitEntity.ID,
+		AccountID:         limitEntity.AccountID,
+		MaxPerTransaction: limitEntity.MaxPerTransaction,
+		MaxDaily:          limitEntity.MaxDaily,
+		MaxMonthly:        limitEntity.MaxMonthly,
+		MaxDailyCount:     limitEntity.MaxDailyCount,
+		CreatedAt:         limitEntity.CreatedAt,
+		UpdatedAt:         limitEntity.UpdatedAt,
+	}
+}
+
+func (w withdrawalLimitRepository) toEntityAccountWithdrawalLimit(limitRecord *accountWithdrawalLimit) *entity.WithdrawalLimit {
+	return &entity.WithdrawalLimit{
+		ID:                limitRecord.ID,
+		AccountID:         limitRecord.AccountID,
+		MaxPerTransaction: limitRecord.MaxPerTransaction,
+		MaxDaily:          limitRecord.MaxDaily,
+		MaxMonthly:        limitRecord.MaxMonthly,
+		MaxDailyCount:     limitRecord.MaxDailyCount,
+		CreatedAt:         limitRecord.CreatedAt,
+		UpdatedAt:         limitRecord.UpdatedAt,
+	}
+}
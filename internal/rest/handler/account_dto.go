@@ -1,52 +1,219 @@
 package handler
 
-import "github.com/shopspring/decimal"
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// HeaderIdempotencyKey is the header used by clients to safely retry a request
+const HeaderIdempotencyKey = "Idempotency-Key"
 
 // CreateAccountRequest	is the request body for creating an account
+// The customer is identified by jenis_identitas (NIK when empty) and no_identitas,
+// nik is still accepted for clients that only register with a NIK
 type CreateAccountRequest struct {
 	Fullname       string `json:"nama" validate:"required,fullname"`
-	PhoneNumber    string `json:"no_hp" validate:"required,e164"`
-	IdentityNumber string `json:"nik" validate:"required,nik"`
+	PhoneNumber    string `json:"no_hp" validate:"required,phone_number"`
+	IdentityType   string `json:"jenis_identitas" validate:"omitempty,oneof=NIK PASPOR KITAS NPWP"`
+	IdentityNumber string `json:"no_identitas" validate:"required_without=NIK,omitempty,identity_number=IdentityType"`
+	NIK            string `json:"nik" validate:"omitempty,nik"`
+	BirthDate      string `json:"tanggal_lahir" validate:"omitempty,datetime=2006-01-02"`
+	Gender         string `json:"jenis_kelamin" validate:"omitempty,oneof=L P"`
+	Currency       string `json:"mata_uang" validate:"omitempty,iso4217"`
+	IdempotencyKey string `json:"-" header:"Idempotency-Key" validate:"omitempty,max=64"`
+}
+
+// GetIdentityType returns the type of the identity document, NIK when not specified
+func (c CreateAccountRequest) GetIdentityType() entity.CustomerIdentityType {
+	if c.IdentityNumber == "" {
+		return entity.IdentityTypeNIK
+	}
+
+	return getIdentityType(c.IdentityType)
+}
+
+// GetBirthDate returns the birth date to cross-check with the NIK, zero when not specified
+func (c CreateAccountRequest) GetBirthDate() time.Time {
+	if c.BirthDate == "" {
+		return time.Time{}
+	}
+
+	// The date is already validated by the datetime rule
+	birthDate, _ := time.Parse(DateLayout, c.BirthDate)
+	return birthDate
+}
+
+// GetGender returns the gender to cross-check with the NIK, L (laki-laki) or P (perempuan)
+func (c CreateAccountRequest) GetGender() entity.Gender {
+	switch c.Gender {
+	case "L":
+		return entity.GenderMale
+	case "P":
+		return entity.GenderFemale
+	default:
+		return entity.GenderUnspecified
+	}
+}
+
+// GetIdentityNumber returns the number of the identity document, the nik when no_identitas is not specified
+func (c CreateAccountRequest) GetIdentityNumber() string {
+	if c.IdentityNumber == "" {
+		return c.NIK
+	}
+
+	return c.IdentityNumber
+}
+
+// GetCurrency returns the currency of the account, IDR when not specified
+func (c CreateAccountRequest) GetCurrency() entity.Currency {
+	return getCurrency(c.Currency)
 }
 
 // CreateAccountResponse is the response body for creating an account
 type CreateAccountResponse struct {
-	AccountNumber string `json:"no_rekening"`
+	AccountNumber string          `json:"no_rekening"`
+	Currency      entity.Currency `json:"mata_uang"`
+}
+
+// TransactionMetadataRequest is the metadata of a deposit or a withdrawal shown on the statement
+// The referensi can be used once per kanal
+type TransactionMetadataRequest struct {
+	Channel      string               `json:"kanal" validate:"omitempty,oneof=TELLER ATM MOBILE INTERNET PARTNER"`
+	Reference    string               `json:"referensi" validate:"omitempty,max=64"`
+	Description  string               `json:"keterangan" validate:"omitempty,max=255"`
+	Counterparty *CounterpartyRequest `json:"pihak_lawan"`
+}
+
+// GetChannel returns the channel of the transaction, TELLER when not specified
+func (t TransactionMetadataRequest) GetChannel() entity.Channel {
+	return getChannel(t.Channel)
+}
+
+// GetCounterparty returns the other party of the transaction, nil when not specified
+func (t TransactionMetadataRequest) GetCounterparty() *entity.Counterparty {
+	if t.Counterparty == nil {
+		return nil
+	}
+
+	return &entity.Counterparty{
+		Name:          t.Counterparty.Name,
+		AccountNumber: t.Counterparty.AccountNumber,
+	}
+}
+
+// CounterpartyRequest is the other party of a deposit or a withdrawal, its account is outside of this service
+type CounterpartyRequest struct {
+	Name          string `json:"nama" validate:"required,max=100"`
+	AccountNumber string `json:"no_rekening" validate:"omitempty,max=34,alphanum"`
 }
 
 // DepositRequest is the request body for depositing money into an account
 type DepositRequest struct {
-	AccountNumber string `json:"no_rekening" validate:"required"`
-	Amount        int64  `json:"nominal" validate:"required,gt=0,lt=1000000000"`
+	AccountNumber  string          `json:"no_rekening" validate:"required,account_number"`
+	Amount         decimal.Decimal `json:"nominal" validate:"required,gt=0"`
+	Currency       string          `json:"mata_uang" validate:"omitempty,iso4217"`
+	IdempotencyKey string          `json:"-" header:"Idempotency-Key" validate:"omitempty,max=64"`
+	TransactionMetadataRequest
 }
 
-// GetAmount converts the amount from int64 to decimal.Decimal
+// GetAmount returns the amount of the deposit
 func (d DepositRequest) GetAmount() decimal.Decimal {
-	return decimal.NewFromInt(d.Amount)
+	return d.Amount
+}
+
+// GetCurrency returns the currency of the amount, IDR when not specified
+func (d DepositRequest) GetCurrency() entity.Currency {
+	return getCurrency(d.Currency)
 }
 
 // DepositResponse is the response body for depositing money into an account
 type DepositResponse struct {
-	AccountBalance decimal.Decimal `json:"saldo"`
+	AccountBalance decimal.Decimal     `json:"saldo"`
+	Currency       entity.Currency     `json:"mata_uang"`
+	Transaction    TransactionResponse `json:"transaksi"`
 }
 
 // WithdrawRequest is the request body for withdrawing money from an account
 type WithdrawRequest struct {
-	AccountNumber string `json:"no_rekening" validate:"required"`
-	Amount        int64  `json:"nominal" validate:"required,gt=0,lt=100000000"`
+	AccountNumber  string          `json:"no_rekening" validate:"required,account_number"`
+	Amount         decimal.Decimal `json:"nominal" validate:"required,gt=0"`
+	Currency       string          `json:"mata_uang" validate:"omitempty,iso4217"`
+	IdempotencyKey string          `json:"-" header:"Idempotency-Key" validate:"omitempty,max=64"`
+	TransactionMetadataRequest
 }
 
-// GetAmount converts the amount from int64 to decimal.Decimal
+// GetAmount returns the amount of the withdrawal
 func (w WithdrawRequest) GetAmount() decimal.Decimal {
-	return decimal.NewFromInt(w.Amount)
+	return w.Amount
+}
+
+// GetCurrency returns the currency of the amount, IDR when not specified
+func (w WithdrawRequest) GetCurrency() entity.Currency {
+	return getCurrency(w.Currency)
 }
 
 // WithdrawResponse is the response body for withdrawing money from an account
 type WithdrawResponse struct {
-	AccountBalance decimal.Decimal `json:"saldo"`
+	AccountBalance decimal.Decimal     `json:"saldo"`
+	Fee            decimal.Decimal     `json:"biaya"`
+	Currency       entity.Currency     `json:"mata_uang"`
+	Transaction    TransactionResponse `json:"transaksi"`
+}
+
+// GetBalanceRequest is the request for getting the balance of an account
+type GetBalanceRequest struct {
+	AccountNumber string `param:"account_number" validate:"required,account_number"`
 }
 
 // GetBalanceResponse is the response body for getting the balance of an account
+// saldo is the ledger balance, saldo_tersedia is the balance less the active holds
 type GetBalanceResponse struct {
-	AccountBalance decimal.Decimal `json:"saldo"`
+	AccountBalance   decimal.Decimal `json:"saldo"`
+	AvailableBalance decimal.Decimal `json:"saldo_tersedia"`
+	Currency         entity.Currency `json:"mata_uang"`
+}
+
+// TransferRequest is the request body for transferring money between accounts
+type TransferRequest struct {
+	SourceAccountNumber      string          `json:"no_rekening_asal" validate:"required,account_number"`
+	DestinationAccountNumber string          `json:"no_rekening_tujuan" validate:"required,account_number,nefield=SourceAccountNumber"`
+	Amount                   decimal.Decimal `json:"nominal" validate:"required,gt=0"`
+	Currency                 string          `json:"mata_uang" validate:"omitempty,iso4217"`
+	Channel                  string          `json:"kanal" validate:"omitempty,oneof=TELLER ATM MOBILE INTERNET PARTNER"`
+	IdempotencyKey           string          `json:"-" header:"Idempotency-Key" validate:"omitempty,max=64"`
+}
+
+// GetAmount returns the amount of the transfer
+func (t TransferRequest) GetAmount() decimal.Decimal {
+	return t.Amount
+}
+
+// GetCurrency returns the currency of the amount, IDR when not specified
+func (t TransferRequest) GetCurrency() entity.Currency {
+	return getCurrency(t.Currency)
+}
+
+// GetChannel returns the channel of the transfer, TELLER when not specified
+func (t TransferRequest) GetChannel() entity.Channel {
+	return getChannel(t.Channel)
+}
+
+// TransferResponse is the response body for transferring money between accounts
+type TransferResponse struct {
+	AccountBalance decimal.Decimal     `json:"saldo"`
+	Fee            decimal.Decimal     `json:"biaya"`
+	Currency       entity.Currency     `json:"mata_uang"`
+	Transaction    TransactionResponse `json:"transaksi"` // the debit of the source account
+}
+
+// getCurrency converts the ISO 4217 code of a request, the default currency is used when it's empty
+// The code is already validated by the iso4217 rule, the usecase rejects unsupported currencies
+func getCurrency(code string) entity.Currency {
+	if code == "" {
+		return entity.DefaultCurrency
+	}
+
+	return entity.Currency(code)
 }
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -12,6 +13,7 @@ type accountHandler struct {
 	depositUsecaase      DepositUsecase
 	withdrawUsecase      WithdrawUsecase
 	getBalanceUsecase    GetBalanceUsecase
+	transferUsecase      TransferUsecase
 }
 
 func NewAccountHandler(
@@ -19,12 +21,14 @@ func NewAccountHandler(
 	depositUsecase DepositUsecase,
 	withdrawUsecase WithdrawUsecase,
 	getBalanceUsecase GetBalanceUsecase,
+	transferUsecase TransferUsecase,
 ) *accountHandler {
 	return &accountHandler{
 		createAccountUsecase: createAccountUsecase,
 		depositUsecaase:      depositUsecase,
 		withdrawUsecase:      withdrawUsecase,
 		getBalanceUsecase:    getBalanceUsecase,
+		transferUsecase:      transferUsecase,
 	}
 }
 
@@ -35,11 +39,10 @@ func (a accountHandler) CreateAccount(c echo.Context) error {
 	)
 
 	if err := c.Bind(req); err != nil {
-		return c.JSON(http.StatusBadRequest,
-			map[string]string{"remark": entity.ErrInvalidRequest.Error()},
-		)
+		return entity.ErrInvalidRequest
 	}
 
+	req.IdempotencyKey = c.Request().Header.Get(HeaderIdempotencyKey)
 	if err := c.Validate(req); err != nil {
 		return err
 	}
@@ -47,16 +50,22 @@ func (a accountHandler) CreateAccount(c echo.Context) error {
 	params := &entity.CreateAccountParams{
 		Fullname:       req.Fullname,
 		PhoneNumber:    req.PhoneNumber,
-		IdentityNumber: req.IdentityNumber,
+		IdentityType:   req.GetIdentityType(),
+		IdentityNumber: req.GetIdentityNumber(),
+		BirthDate:      req.GetBirthDate(),
+		Gender:         req.GetGender(),
+		Currency:       req.GetCurrency(),
+		IdempotencyKey: req.IdempotencyKey,
 	}
 
 	account, err := a.createAccountUsecase.CreateAccount(ctx, params)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{"remark": err.Error()})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, &CreateAccountResponse{
 		AccountNumber: account.AccountNumber,
+		Currency:      account.Currency,
 	})
 }
 
@@ -67,27 +76,39 @@ func (a accountHandler) Deposit(c echo.Context) error {
 	)
 
 	if err := c.Bind(req); err != nil {
-		return c.JSON(http.StatusBadRequest,
-			map[string]string{"remark": entity.ErrInvalidRequest.Error()},
-		)
+		return entity.ErrInvalidRequest
 	}
 
+	req.IdempotencyKey = c.Request().Header.Get(HeaderIdempotencyKey)
 	if err := c.Validate(req); err != nil {
 		return err
 	}
 
-	transaction, err := a.depositUsecaase.Deposit(ctx, req.AccountNumber, req.GetAmount())
+	params := &entity.DepositParams{
+		AccountNumber:  req.AccountNumber,
+		Amount:         req.GetAmount(),
+		Currency:       req.GetCurrency(),
+		Channel:        req.GetChannel(),
+		Reference:      req.Reference,
+		Description:    req.Description,
+		Counterparty:   req.GetCounterparty(),
+		IdempotencyKey: req.IdempotencyKey,
+	}
+
+	transaction, err := a.depositUsecaase.Deposit(ctx, params)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{"remark": err.Error()})
+		return err
 	}
 
 	// Check if the transaction is nil
 	if transaction == nil {
-		return c.JSON(http.StatusInternalServerError, map[string]string{"remark": "transaction is nil"})
+		return errors.New("transaction is nil")
 	}
 
 	return c.JSON(http.StatusOK, &DepositResponse{
 		AccountBalance: transaction.FinalBalance,
+		Currency:       transaction.Currency,
+		Transaction:    NewTransactionResponse(transaction),
 	})
 }
 
@@ -98,44 +119,97 @@ func (a accountHandler) Withdraw(c echo.Context) error {
 	)
 
 	if err := c.Bind(req); err != nil {
-		return c.JSON(http.StatusBadRequest,
-			map[string]string{"remark": entity.ErrInvalidRequest.Error()},
-		)
+		return entity.ErrInvalidRequest
 	}
 
+	req.IdempotencyKey = c.Request().Header.Get(HeaderIdempotencyKey)
 	if err := c.Validate(req); err != nil {
 		return err
 	}
 
-	transaction, err := a.withdrawUsecase.Withdraw(ctx, req.AccountNumber, req.GetAmount())
+	params := &entity.WithdrawParams{
+		AccountNumber:  req.AccountNumber,
+		Amount:         req.GetAmount(),
+		Currency:       req.GetCurrency(),
+		Channel:        req.GetChannel(),
+		Reference:      req.Reference,
+		Description:    req.Description,
+		Counterparty:   req.GetCounterparty(),
+		IdempotencyKey: req.IdempotencyKey,
+	}
+
+	withdrawal, err := a.withdrawUsecase.Withdraw(ctx, params)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{"remark": err.Error()})
+		return err
 	}
 
-	return c.JSON(http.StatusOK, &DepositResponse{
-		AccountBalance: transaction.FinalBalance,
+	return c.JSON(http.StatusOK, &WithdrawResponse{
+		AccountBalance: withdrawal.Balance(),
+		Fee:            withdrawal.FeeAmount(),
+		Currency:       withdrawal.Debit.Currency,
+		Transaction:    NewTransactionResponse(withdrawal.Debit),
 	})
 }
 
 func (a accountHandler) GetBalance(c echo.Context) error {
 	var (
-		ctx           = c.Request().Context()
-		accountNumber = c.Param("account_number") // Get account number from path parameter
+		ctx = c.Request().Context()
+		req = new(GetBalanceRequest)
 	)
 
-	// If account number is not provided, return bad request
-	if accountNumber == "" {
-		return c.JSON(http.StatusBadRequest,
-			map[string]string{"remark": entity.ErrInvalidRequest.Error()},
-		)
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
 	}
 
-	balance, err := a.getBalanceUsecase.GetBalance(ctx, accountNumber)
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	balance, err := a.getBalanceUsecase.GetBalance(ctx, req.AccountNumber)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{"remark": err.Error()})
+		return err
 	}
 
 	return c.JSON(http.StatusOK, &GetBalanceResponse{
-		AccountBalance: balance,
+		AccountBalance:   balance.Ledger,
+		AvailableBalance: balance.Available,
+		Currency:         balance.Currency,
+	})
+}
+
+func (a accountHandler) Transfer(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+		req = new(TransferRequest)
+	)
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	req.IdempotencyKey = c.Request().Header.Get(HeaderIdempotencyKey)
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	params := &entity.TransferParams{
+		SourceAccountNumber:      req.SourceAccountNumber,
+		DestinationAccountNumber: req.DestinationAccountNumber,
+		Amount:                   req.GetAmount(),
+		Currency:                 req.GetCurrency(),
+		Channel:                  req.GetChannel(),
+		IdempotencyKey:           req.IdempotencyKey,
+	}
+
+	transfer, err := a.transferUsecase.Transfer(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &TransferResponse{
+		AccountBalance: transfer.SourceBalance(),
+		Fee:            transfer.FeeAmount(),
+		Currency:       transfer.Debit.Currency,
+		Transaction:    NewTransactionResponse(transfer.Debit),
 	})
 }
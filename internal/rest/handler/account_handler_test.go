@@ -3,12 +3,14 @@ package handler_test
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"imansohibul.my.id/account-domain-service/entity"
 	"imansohibul.my.id/account-domain-service/internal/rest/handler"
@@ -27,7 +29,7 @@ func TestCreateAccount(t *testing.T) {
 	}{
 		{
 			name:        "Create Account - Success",
-			requestBody: &handler.CreateAccountRequest{Fullname: "John Doe", PhoneNumber: "+6234567890222", IdentityNumber: "3204081901970002"},
+			requestBody: &handler.CreateAccountRequest{Fullname: "John Doe", PhoneNumber: "+6281234567890", IdentityNumber: "3204081901970002"},
 			mockSetup: func(t *testing.T, createAccountUsecase *usecasemock.MockCreateAccountUsecase) {
 				createAccountUsecase.EXPECT().
 					CreateAccount(gomock.Any(), gomock.Any()).
@@ -45,6 +47,72 @@ func TestCreateAccount(t *testing.T) {
 			expectedStatusCode: http.StatusBadRequest,
 			expectedBody:       "Permintaan tidak valid", // Assuming error message from the handler
 		},
+		{
+			name:        "Create Account - Invalid Identity Number",
+			requestBody: &handler.CreateAccountRequest{Fullname: "John Doe", PhoneNumber: "+6281234567890", NIK: "12345"},
+			mockSetup: func(t *testing.T, createAccountUsecase *usecasemock.MockCreateAccountUsecase) {
+				// No need to mock since it's an error test case
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"errors":[{"field":"nik","rule":"nik","message":"NIK harus 16 digit angka dengan kode wilayah dan tanggal lahir yang valid"}]`,
+		},
+		{
+			name:        "Create Account - Local Phone Number Format",
+			requestBody: &handler.CreateAccountRequest{Fullname: "John Doe", PhoneNumber: "0812-3456-7890", IdentityNumber: "3204081901970002"},
+			mockSetup: func(t *testing.T, createAccountUsecase *usecasemock.MockCreateAccountUsecase) {
+				createAccountUsecase.EXPECT().
+					CreateAccount(gomock.Any(), gomock.Any()).
+					Return(&entity.Account{AccountNumber: "123456"}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "123456",
+		},
+		{
+			name:        "Create Account - Landline Phone Number",
+			requestBody: &handler.CreateAccountRequest{Fullname: "John Doe", PhoneNumber: "021-555-1234", IdentityNumber: "3204081901970002"},
+			mockSetup: func(t *testing.T, createAccountUsecase *usecasemock.MockCreateAccountUsecase) {
+				// No need to mock since it's an error test case
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"errors":[{"field":"no_hp","rule":"phone_number"`,
+		},
+		{
+			name:        "Create Account - Passport",
+			requestBody: &handler.CreateAccountRequest{Fullname: "John Doe", PhoneNumber: "+6281234567890", IdentityType: "PASPOR", IdentityNumber: "A1234567"},
+			mockSetup: func(t *testing.T, createAccountUsecase *usecasemock.MockCreateAccountUsecase) {
+				createAccountUsecase.EXPECT().
+					CreateAccount(gomock.Any(), &entity.CreateAccountParams{
+						Fullname:       "John Doe",
+						PhoneNumber:    "+6281234567890",
+						IdentityType:   entity.IdentityTypePassport,
+						IdentityNumber: "A1234567",
+						Currency:       entity.CurrencyIDR,
+					}).
+					Return(&entity.Account{AccountNumber: "123456"}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "123456",
+		},
+		{
+			name:        "Create Account - Identity Number Does Not Match Identity Type",
+			requestBody: &handler.CreateAccountRequest{Fullname: "John Doe", PhoneNumber: "+6281234567890", IdentityType: "NPWP", IdentityNumber: "A1234567"},
+			mockSetup: func(t *testing.T, createAccountUsecase *usecasemock.MockCreateAccountUsecase) {
+				// No need to mock since it's an error test case
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"errors":[{"field":"no_identitas","rule":"identity_number"`,
+		},
+		{
+			name:        "Create Account - Unexpected Error",
+			requestBody: &handler.CreateAccountRequest{Fullname: "John Doe", PhoneNumber: "+6281234567890", IdentityNumber: "3204081901970002"},
+			mockSetup: func(t *testing.T, createAccountUsecase *usecasemock.MockCreateAccountUsecase) {
+				createAccountUsecase.EXPECT().
+					CreateAccount(gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("connection refused"))
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedBody:       `"code":"INTERNAL_ERROR"`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -52,6 +120,7 @@ func TestCreateAccount(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			e := echo.New()
 			e.Validator = server.NewCommonValidator(util.GetValidator())
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
 
 			bodyBytes, _ := json.Marshal(tt.requestBody)
 			req := httptest.NewRequest(http.MethodPost, "/daftar", bytes.NewReader(bodyBytes))
@@ -64,12 +133,296 @@ func TestCreateAccount(t *testing.T) {
 			mockCreateAccountUsecase := usecasemock.NewMockCreateAccountUsecase(ctrl)
 			tt.mockSetup(t, mockCreateAccountUsecase)
 
-			handler := handler.NewAccountHandler(mockCreateAccountUsecase, nil, nil, nil)
+			handler := handler.NewAccountHandler(mockCreateAccountUsecase, nil, nil, nil, nil)
+
+			c := e.NewContext(req, rec)
+			if err := handler.CreateAccount(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestTransfer(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        interface{}
+		mockSetup          func(*testing.T, *usecasemock.MockTransferUsecase)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:        "Transfer - Success",
+			requestBody: &handler.TransferRequest{SourceAccountNumber: "1234567897", DestinationAccountNumber: "0987654324", Amount: decimal.NewFromInt(50000)},
+			mockSetup: func(t *testing.T, transferUsecase *usecasemock.MockTransferUsecase) {
+				transferUsecase.EXPECT().
+					Transfer(gomock.Any(), &entity.TransferParams{
+						SourceAccountNumber:      "1234567897",
+						DestinationAccountNumber: "0987654324",
+						Amount:                   decimal.NewFromInt(50000),
+						Currency:                 entity.CurrencyIDR,
+						Channel:                  entity.ChannelTeller,
+					}).
+					Return(&entity.Transfer{
+						Debit:  &entity.Transaction{FinalBalance: decimal.NewFromInt(150000)},
+						Credit: &entity.Transaction{FinalBalance: decimal.NewFromInt(50000)},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "150000",
+		},
+		{
+			name:        "Transfer - With Fee",
+			requestBody: &handler.TransferRequest{SourceAccountNumber: "1234567897", DestinationAccountNumber: "0987654324", Amount: decimal.NewFromInt(50000), Channel: "MOBILE"},
+			mockSetup: func(t *testing.T, transferUsecase *usecasemock.MockTransferUsecase) {
+				transferUsecase.EXPECT().
+					Transfer(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, params *entity.TransferParams) (*entity.Transfer, error) {
+						assert.Equal(t, entity.ChannelMobile, params.Channel)
+
+						return &entity.Transfer{
+							Debit:  &entity.Transaction{FinalBalance: decimal.NewFromInt(150000)},
+							Credit: &entity.Transaction{FinalBalance: decimal.NewFromInt(50000)},
+							Fee:    &entity.Transaction{Amount: decimal.NewFromInt(2500), FinalBalance: decimal.NewFromInt(147500)},
+						}, nil
+					})
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"saldo":"147500","biaya":"2500"`,
+		},
+		{
+			name:        "Transfer - Unknown Channel",
+			requestBody: &handler.TransferRequest{SourceAccountNumber: "1234567897", DestinationAccountNumber: "0987654324", Amount: decimal.NewFromInt(50000), Channel: "SMS"},
+			mockSetup: func(t *testing.T, transferUsecase *usecasemock.MockTransferUsecase) {
+				// No need to mock since it's an error test case
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "kanal",
+		},
+		{
+			name:        "Transfer - Insufficient Balance",
+			requestBody: &handler.TransferRequest{SourceAccountNumber: "1234567897", DestinationAccountNumber: "0987654324", Amount: decimal.NewFromInt(50000)},
+			mockSetup: func(t *testing.T, transferUsecase *usecasemock.MockTransferUsecase) {
+				transferUsecase.EXPECT().
+					Transfer(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrInsufficientBalance)
+			},
+			expectedStatusCode: http.StatusUnprocessableEntity,
+			expectedBody:       `"code":"ACCOUNT_INSUFFICIENT_BALANCE"`,
+		},
+		{
+			name:        "Transfer - Invalid Request",
+			requestBody: nil, // Invalid body to trigger error
+			mockSetup: func(t *testing.T, transferUsecase *usecasemock.MockTransferUsecase) {
+				// No need to mock since it's an error test case
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Permintaan tidak valid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := echo.New()
+			e.Validator = server.NewCommonValidator(util.GetValidator())
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/transfer", bytes.NewReader(bodyBytes))
+
+			if tt.requestBody != nil {
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			}
+			rec := httptest.NewRecorder()
+
+			mockTransferUsecase := usecasemock.NewMockTransferUsecase(ctrl)
+			tt.mockSetup(t, mockTransferUsecase)
+
+			handler := handler.NewAccountHandler(nil, nil, nil, nil, mockTransferUsecase)
+
+			c := e.NewContext(req, rec)
+			if err := handler.Transfer(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestDeposit(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        interface{}
+		idempotencyKey     string
+		mockSetup          func(*testing.T, *usecasemock.MockDepositUsecase)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:           "Deposit - Success With Idempotency Key",
+			requestBody:    &handler.DepositRequest{AccountNumber: "1234567897", Amount: decimal.NewFromInt(50000)},
+			idempotencyKey: "3f1c6a52-1f7b-4f7e-9a59-0f6a3c1b2d4e",
+			mockSetup: func(t *testing.T, depositUsecase *usecasemock.MockDepositUsecase) {
+				depositUsecase.EXPECT().
+					Deposit(gomock.Any(), &entity.DepositParams{
+						AccountNumber:  "1234567897",
+						Amount:         decimal.NewFromInt(50000),
+						Currency:       entity.CurrencyIDR,
+						Channel:        entity.ChannelTeller,
+						IdempotencyKey: "3f1c6a52-1f7b-4f7e-9a59-0f6a3c1b2d4e",
+					}).
+					Return(&entity.Transaction{FinalBalance: decimal.NewFromInt(150000)}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       "150000",
+		},
+		{
+			name:        "Deposit - Mistyped Account Number",
+			requestBody: &handler.DepositRequest{AccountNumber: "1234567807", Amount: decimal.NewFromInt(50000)},
+			mockSetup: func(t *testing.T, depositUsecase *usecasemock.MockDepositUsecase) {
+				// The check digit is validated before the account is looked up
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"errors":[{"field":"no_rekening","rule":"account_number"`,
+		},
+		{
+			name:        "Deposit - USD With Cents",
+			requestBody: &handler.DepositRequest{AccountNumber: "1234567897", Amount: decimal.RequireFromString("10.5"), Currency: "USD"},
+			mockSetup: func(t *testing.T, depositUsecase *usecasemock.MockDepositUsecase) {
+				depositUsecase.EXPECT().
+					Deposit(gomock.Any(), &entity.DepositParams{
+						AccountNumber: "1234567897",
+						Amount:        decimal.RequireFromString("10.5"),
+						Currency:      entity.CurrencyUSD,
+						Channel:       entity.ChannelTeller,
+					}).
+					Return(&entity.Transaction{FinalBalance: decimal.RequireFromString("110.5"), Currency: entity.CurrencyUSD}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"saldo":"110.5","mata_uang":"USD",`,
+		},
+		{
+			name: "Deposit - Partner Top-Up With Metadata",
+			requestBody: map[string]interface{}{
+				"no_rekening": "1234567897",
+				"nominal":     100000,
+				"kanal":       "PARTNER",
+				"referensi":   "TOPUP-20250607-0001",
+				"keterangan":  "Top up dompet digital",
+				"pihak_lawan": map[string]string{"nama": "PT Dompet Digital", "no_rekening": "8800123456"},
+			},
+			mockSetup: func(t *testing.T, depositUsecase *usecasemock.MockDepositUsecase) {
+				counterparty := &entity.Counterparty{Name: "PT Dompet Digital", AccountNumber: "8800123456"}
+				depositUsecase.EXPECT().
+					Deposit(gomock.Any(), &entity.DepositParams{
+						AccountNumber: "1234567897",
+						Amount:        decimal.NewFromInt(100000),
+						Currency:      entity.CurrencyIDR,
+						Channel:       entity.ChannelPartnerAPI,
+						Reference:     "TOPUP-20250607-0001",
+						Description:   "Top up dompet digital",
+						Counterparty:  counterparty,
+					}).
+					Return(&entity.Transaction{
+						ID:           42,
+						Type:         entity.TransactionTypeCredit,
+						Amount:       decimal.NewFromInt(100000),
+						FinalBalance: decimal.NewFromInt(250000),
+						Currency:     entity.CurrencyIDR,
+						Channel:      entity.ChannelPartnerAPI,
+						Reference:    "TOPUP-20250607-0001",
+						Description:  "Top up dompet digital",
+						Counterparty: counterparty,
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"kanal":"PARTNER","referensi":"TOPUP-20250607-0001","keterangan":"Top up dompet digital","pihak_lawan":{"nama":"PT Dompet Digital","no_rekening":"8800123456"}`,
+		},
+		{
+			name: "Deposit - Counterparty Without Name",
+			requestBody: map[string]interface{}{
+				"no_rekening": "1234567897",
+				"nominal":     100000,
+				"pihak_lawan": map[string]string{"no_rekening": "8800123456"},
+			},
+			mockSetup: func(t *testing.T, depositUsecase *usecasemock.MockDepositUsecase) {
+				// No need to mock since it's an error test case
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"field":"nama","rule":"required"`,
+		},
+		{
+			name:        "Deposit - Reference Already Used",
+			requestBody: map[string]interface{}{"no_rekening": "1234567897", "nominal": 100000, "kanal": "PARTNER", "referensi": "TOPUP-20250607-0001"},
+			mockSetup: func(t *testing.T, depositUsecase *usecasemock.MockDepositUsecase) {
+				depositUsecase.EXPECT().
+					Deposit(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrTransactionReferenceAlreadyExists)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `"code":"TRANSACTION_REFERENCE_ALREADY_EXISTS"`,
+		},
+		{
+			name:        "Deposit - Currency Mismatch",
+			requestBody: &handler.DepositRequest{AccountNumber: "1234567897", Amount: decimal.NewFromInt(50000), Currency: "SGD"},
+			mockSetup: func(t *testing.T, depositUsecase *usecasemock.MockDepositUsecase) {
+				depositUsecase.EXPECT().
+					Deposit(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrCurrencyMismatch)
+			},
+			expectedStatusCode: http.StatusUnprocessableEntity,
+			expectedBody:       `"code":"CURRENCY_MISMATCH"`,
+		},
+		{
+			name:        "Deposit - Invalid Currency Code",
+			requestBody: &handler.DepositRequest{AccountNumber: "1234567897", Amount: decimal.NewFromInt(50000), Currency: "RUPIAH"},
+			mockSetup: func(t *testing.T, depositUsecase *usecasemock.MockDepositUsecase) {
+				// No need to mock since it's an error test case
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"field":"mata_uang","rule":"iso4217"`,
+		},
+		{
+			name:           "Deposit - Idempotency Key Reused",
+			requestBody:    &handler.DepositRequest{AccountNumber: "1234567897", Amount: decimal.NewFromInt(75000)},
+			idempotencyKey: "3f1c6a52-1f7b-4f7e-9a59-0f6a3c1b2d4e",
+			mockSetup: func(t *testing.T, depositUsecase *usecasemock.MockDepositUsecase) {
+				depositUsecase.EXPECT().
+					Deposit(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrIdempotencyKeyReused)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       entity.ErrIdempotencyKeyReused.Message,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := echo.New()
+			e.Validator = server.NewCommonValidator(util.GetValidator())
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/tabung", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(handler.HeaderIdempotencyKey, tt.idempotencyKey)
+			rec := httptest.NewRecorder()
+
+			mockDepositUsecase := usecasemock.NewMockDepositUsecase(ctrl)
+			tt.mockSetup(t, mockDepositUsecase)
+
+			handler := handler.NewAccountHandler(nil, mockDepositUsecase, nil, nil, nil)
 
 			c := e.NewContext(req, rec)
-			err := handler.CreateAccount(c)
-			if err != nil {
-				t.Errorf("Error: %v", err)
+			if err := handler.Deposit(c); err != nil {
+				e.HTTPErrorHandler(err, c)
 			}
 
 			assert.Equal(t, tt.expectedStatusCode, rec.Code)
@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// accountStatusNames maps the account statuses to their names in the API
+var accountStatusNames = map[entity.AccountStatus]string{
+	entity.AccountStatusActive:       "AKTIF",
+	entity.AccountStatusBlocked:      "BLOKIR",
+	entity.AccountStatusDebitBlocked: "BLOKIR_DEBIT",
+	entity.AccountStatusDormant:      "DORMAN",
+	entity.AccountStatusClosed:       "TUTUP",
+}
+
+// UpdateAccountStatusRequest is the request body for changing the status of an account
+type UpdateAccountStatusRequest struct {
+	AccountNumber string `param:"account_number" validate:"required,account_number"`
+	Status        string `json:"status" validate:"required,oneof=AKTIF BLOKIR BLOKIR_DEBIT DORMAN TUTUP"`
+	Reason        string `json:"alasan" validate:"required,max=255"`
+}
+
+// GetStatus converts the status name into the account status
+func (u UpdateAccountStatusRequest) GetStatus() entity.AccountStatus {
+	for status, name := range accountStatusNames {
+		if name == u.Status {
+			return status
+		}
+	}
+
+	return entity.AccountStatusUnspecified
+}
+
+// UpdateAccountStatusResponse is the response body for changing the status of an account
+type UpdateAccountStatusResponse struct {
+	AccountNumber string `json:"no_rekening"`
+	Status        string `json:"status"`
+}
+
+// SetWithdrawalLimitRequest is the request body for overriding the withdrawal limits of an account
+// A zero limit means unlimited
+type SetWithdrawalLimitRequest struct {
+	AccountNumber     string          `param:"account_number" validate:"required,account_number"`
+	MaxPerTransaction decimal.Decimal `json:"per_transaksi" validate:"gte=0"`
+	MaxDaily          decimal.Decimal `json:"harian" validate:"gte=0"`
+	MaxMonthly        decimal.Decimal `json:"bulanan" validate:"gte=0"`
+	MaxDailyCount     int             `json:"frekuensi_harian" validate:"gte=0"`
+}
+
+// WithdrawalLimitResponse is the response body for overriding the withdrawal limits of an account
+type WithdrawalLimitResponse struct {
+	AccountNumber     string          `json:"no_rekening"`
+	MaxPerTransaction decimal.Decimal `json:"per_transaksi"`
+	MaxDaily          decimal.Decimal `json:"harian"`
+	MaxMonthly        decimal.Decimal `json:"bulanan"`
+	MaxDailyCount     int             `json:"frekuensi_harian"`
+}
+
+// RemoveWithdrawalLimitRequest is the request for limiting an account by the limits of its product again
+type RemoveWithdrawalLimitRequest struct {
+	AccountNumber string `param:"account_number" validate:"required,account_number"`
+}
+
+// ReverseTransactionRequest is the request body for reversing a transaction posted by mistake
+// paksa accepts a negative balance when reversing a credit, only if the service allows it.
+// The operator isn't part of the body, it's the operator authenticated by the gateway
+type ReverseTransactionRequest struct {
+	TransactionID uint   `param:"id" validate:"required"`
+	Reason        string `json:"alasan" validate:"required,max=255"`
+	Force         bool   `json:"paksa"`
+}
+
+// ReverseTransactionResponse is the response body for reversing a transaction
+type ReverseTransactionResponse struct {
+	OriginalTransactionID uint                `json:"id_transaksi_asal"`
+	Transaction           TransactionResponse `json:"transaksi"`
+	Operator              string              `json:"petugas"`
+	Reason                string              `json:"alasan"`
+	Forced                bool                `json:"paksa"`
+}
+
+// NewReverseTransactionResponse converts a reversal into its response body
+func NewReverseTransactionResponse(reversal *entity.Reversal) *ReverseTransactionResponse {
+	return &ReverseTransactionResponse{
+		OriginalTransactionID: reversal.Original.ID,
+		Transaction:           NewTransactionResponse(reversal.Reversal),
+		Operator:              reversal.Record.Operator,
+		Reason:                reversal.Record.Reason,
+		Forced:                reversal.Record.Forced,
+	}
+}
+
+// TrialBalanceLineResponse represents the totals of a single account in the trial balance
+type TrialBalanceLineResponse struct {
+	AccountNumber string          `json:"no_rekening"`
+	Internal      bool            `json:"internal"`
+	Currency      entity.Currency `json:"mata_uang"`
+	TotalDebit    decimal.Decimal `json:"total_debit"`
+	TotalCredit   decimal.Decimal `json:"total_kredit"`
+	Balance       decimal.Decimal `json:"saldo"`
+}
+
+// TrialBalanceTotalResponse represents the totals of a single currency in the trial balance
+type TrialBalanceTotalResponse struct {
+	Currency    entity.Currency `json:"mata_uang"`
+	TotalDebit  decimal.Decimal `json:"total_debit"`
+	TotalCredit decimal.Decimal `json:"total_kredit"`
+}
+
+// GetTrialBalanceResponse is the response body for the trial balance (neraca saldo) of the ledger
+type GetTrialBalanceResponse struct {
+	Balanced bool                        `json:"seimbang"`
+	Lines    []TrialBalanceLineResponse  `json:"rekening"`
+	Totals   []TrialBalanceTotalResponse `json:"total"`
+}
+
+// NewGetTrialBalanceResponse converts the trial balance into its response body
+func NewGetTrialBalanceResponse(trialBalance *entity.TrialBalance) *GetTrialBalanceResponse {
+	resp := &GetTrialBalanceResponse{
+		Balanced: trialBalance.IsBalanced(),
+		Lines:    make([]TrialBalanceLineResponse, 0, len(trialBalance.Lines)),
+		Totals:   make([]TrialBalanceTotalResponse, 0, len(trialBalance.TotalDebit)),
+	}
+
+	for _, line := range trialBalance.Lines {
+		resp.Lines = append(resp.Lines, TrialBalanceLineResponse{
+			AccountNumber: line.AccountNumber,
+			Internal:      line.AccountType == entity.AccountTypeInternal,
+			Currency:      line.Currency,
+			TotalDebit:    line.TotalDebit,
+			TotalCredit:   line.TotalCredit,
+			Balance:       line.Balance(),
+		})
+	}
+
+	for currency, totalDebit := range trialBalance.TotalDebit {
+		resp.Totals = append(resp.Totals, TrialBalanceTotalResponse{
+			Currency:    currency,
+			TotalDebit:  totalDebit,
+			TotalCredit: trialBalance.TotalCredit[currency],
+		})
+	}
+
+	return resp
+}
+
+// MaxExchangeRatesBodySize is the maximum size of the CSV body of the exchange rates
+const MaxExchangeRatesBodySize = 1 << 20
+
+// LoadExchangeRatesResponse is the response body for loading the exchange rates
+type LoadExchangeRatesResponse struct {
+	Count int `json:"jumlah"`
+}
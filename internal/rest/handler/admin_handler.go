@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/internal/rest/middleware"
+)
+
+type adminHandler struct {
+	updateAccountStatusUsecase   UpdateAccountStatusUsecase
+	getTrialBalanceUsecase       GetTrialBalanceUsecase
+	loadExchangeRatesUsecase     LoadExchangeRatesUsecase
+	setWithdrawalLimitUsecase    SetWithdrawalLimitUsecase
+	removeWithdrawalLimitUsecase RemoveWithdrawalLimitUsecase
+	reverseTransactionUsecase    ReverseTransactionUsecase
+}
+
+func NewAdminHandler(
+	updateAccountStatusUsecase UpdateAccountStatusUsecase,
+	getTrialBalanceUsecase GetTrialBalanceUsecase,
+	loadExchangeRatesUsecase LoadExchangeRatesUsecase,
+	setWithdrawalLimitUsecase SetWithdrawalLimitUsecase,
+	removeWithdrawalLimitUsecase RemoveWithdrawalLimitUsecase,
+	reverseTransactionUsecase ReverseTransactionUsecase,
+) *adminHandler {
+	return &adminHandler{
+		updateAccountStatusUsecase:   updateAccountStatusUsecase,
+		getTrialBalanceUsecase:       getTrialBalanceUsecase,
+		loadExchangeRatesUsecase:     loadExchangeRatesUsecase,
+		setWithdrawalLimitUsecase:    setWithdrawalLimitUsecase,
+		removeWithdrawalLimitUsecase: removeWithdrawalLimitUsecase,
+		reverseTransactionUsecase:    reverseTransactionUsecase,
+	}
+}
+
+func (a adminHandler) UpdateAccountStatus(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+		req = new(UpdateAccountStatusRequest)
+	)
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	params := &entity.UpdateAccountStatusParams{
+		AccountNumber: req.AccountNumber,
+		Status:        req.GetStatus(),
+		Reason:        req.Reason,
+	}
+
+	account, err := a.updateAccountStatusUsecase.UpdateAccountStatus(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &UpdateAccountStatusResponse{
+		AccountNumber: account.AccountNumber,
+		Status:        accountStatusNames[account.Status],
+	})
+}
+
+func (a adminHandler) GetTrialBalance(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	trialBalance, err := a.getTrialBalanceUsecase.GetTrialBalance(ctx)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, NewGetTrialBalanceResponse(trialBalance))
+}
+
+// LoadExchangeRates loads the exchange rates of a CSV body with the entity.ExchangeRatesCSVHeader
+func (a adminHandler) LoadExchangeRates(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	rates, err := entity.ParseExchangeRatesCSV(io.LimitReader(c.Request().Body, MaxExchangeRatesBodySize))
+	if err != nil {
+		return err
+	}
+
+	if err := a.loadExchangeRatesUsecase.LoadExchangeRates(ctx, rates); err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &LoadExchangeRatesResponse{
+		Count: len(rates),
+	})
+}
+
+// SetWithdrawalLimit overrides the withdrawal limits of the product of an account
+func (a adminHandler) SetWithdrawalLimit(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+		req = new(SetWithdrawalLimitRequest)
+	)
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	params := &entity.SetWithdrawalLimitParams{
+		AccountNumber:     req.AccountNumber,
+		MaxPerTransaction: req.MaxPerTransaction,
+		MaxDaily:          req.MaxDaily,
+		MaxMonthly:        req.MaxMonthly,
+		MaxDailyCount:     req.MaxDailyCount,
+	}
+
+	limit, err := a.setWithdrawalLimitUsecase.SetWithdrawalLimit(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &WithdrawalLimitResponse{
+		AccountNumber:     req.AccountNumber,
+		MaxPerTransaction: limit.MaxPerTransaction,
+		MaxDaily:          limit.MaxDaily,
+		MaxMonthly:        limit.MaxMonthly,
+		MaxDailyCount:     limit.MaxDailyCount,
+	})
+}
+
+// RemoveWithdrawalLimit removes the override of the withdrawal limits of an account
+func (a adminHandler) RemoveWithdrawalLimit(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+		req = new(RemoveWithdrawalLimitRequest)
+	)
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	if err := a.removeWithdrawalLimitUsecase.RemoveWithdrawalLimit(ctx, req.AccountNumber); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ReverseTransaction reverses a transaction posted by mistake on behalf of the authenticated operator
+func (a adminHandler) ReverseTransaction(c echo.Context) error {
+	var (
+		ctx      = c.Request().Context()
+		req      = new(ReverseTransactionRequest)
+		operator = middleware.Operator(c)
+	)
+
+	if operator == "" {
+		return entity.ErrUnauthenticated
+	}
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	params := &entity.ReverseTransactionParams{
+		TransactionID: req.TransactionID,
+		Operator:      operator,
+		Reason:        req.Reason,
+		Force:         req.Force,
+	}
+
+	reversal, err := a.reverseTransactionUsecase.ReverseTransaction(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusCreated, NewReverseTransactionResponse(reversal))
+}
@@ -0,0 +1,381 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/internal/rest/handler"
+	usecasemock "imansohibul.my.id/account-domain-service/internal/rest/handler/mock"
+	"imansohibul.my.id/account-domain-service/internal/rest/middleware"
+	"imansohibul.my.id/account-domain-service/internal/rest/server"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestUpdateAccountStatus(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        interface{}
+		mockSetup          func(*testing.T, *usecasemock.MockUpdateAccountStatusUsecase)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:        "Update Account Status - Success",
+			requestBody: map[string]string{"status": "BLOKIR", "alasan": "Permintaan kepolisian"},
+			mockSetup: func(t *testing.T, updateAccountStatusUsecase *usecasemock.MockUpdateAccountStatusUsecase) {
+				updateAccountStatusUsecase.EXPECT().
+					UpdateAccountStatus(gomock.Any(), &entity.UpdateAccountStatusParams{
+						AccountNumber: "1234567897",
+						Status:        entity.AccountStatusBlocked,
+						Reason:        "Permintaan kepolisian",
+					}).
+					Return(&entity.Account{AccountNumber: "1234567897", Status: entity.AccountStatusBlocked}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"status":"BLOKIR"`,
+		},
+		{
+			name:        "Update Account Status - Invalid Transition",
+			requestBody: map[string]string{"status": "AKTIF", "alasan": "Buka kembali"},
+			mockSetup: func(t *testing.T, updateAccountStatusUsecase *usecasemock.MockUpdateAccountStatusUsecase) {
+				updateAccountStatusUsecase.EXPECT().
+					UpdateAccountStatus(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrInvalidAccountStatusTransition)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       entity.ErrInvalidAccountStatusTransition.Message,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := echo.New()
+			e.Validator = server.NewCommonValidator(util.GetValidator())
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPut, "/admin/rekening/1234567897/status", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			mockUpdateAccountStatusUsecase := usecasemock.NewMockUpdateAccountStatusUsecase(ctrl)
+			tt.mockSetup(t, mockUpdateAccountStatusUsecase)
+
+			handler := handler.NewAdminHandler(mockUpdateAccountStatusUsecase, nil, nil, nil, nil, nil)
+
+			c := e.NewContext(req, rec)
+			c.SetParamNames("account_number")
+			c.SetParamValues("1234567897")
+
+			if err := handler.UpdateAccountStatus(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestGetTrialBalance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	e := echo.New()
+
+	req := httptest.NewRequest(http.MethodGet, "/admin/neraca-saldo", nil)
+	rec := httptest.NewRecorder()
+
+	mockGetTrialBalanceUsecase := usecasemock.NewMockGetTrialBalanceUsecase(ctrl)
+	mockGetTrialBalanceUsecase.EXPECT().
+		GetTrialBalance(gomock.Any()).
+		Return(entity.NewTrialBalance([]*entity.TrialBalanceLine{
+			{AccountNumber: entity.SystemAccountCashIn, AccountType: entity.AccountTypeInternal, Currency: entity.CurrencyIDR, TotalDebit: decimal.NewFromInt(50000), TotalCredit: decimal.Zero},
+			{AccountNumber: "1234567897", AccountType: entity.AccountTypeSaving, Currency: entity.CurrencyIDR, TotalDebit: decimal.Zero, TotalCredit: decimal.NewFromInt(50000)},
+		}), nil)
+
+	handler := handler.NewAdminHandler(nil, mockGetTrialBalanceUsecase, nil, nil, nil, nil)
+
+	c := e.NewContext(req, rec)
+	err := handler.GetTrialBalance(c)
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"seimbang":true`)
+	assert.Contains(t, rec.Body.String(), `"no_rekening":"1234567897"`)
+}
+
+func TestLoadExchangeRates(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        string
+		mockSetup          func(*testing.T, *usecasemock.MockLoadExchangeRatesUsecase)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name: "Load Exchange Rates - Success",
+			requestBody: "base_currency,quote_currency,buy_rate,sell_rate,effective_at\n" +
+				"USD,IDR,16000,16500,2025-05-20T09:00:00+07:00\n" +
+				"SGD,IDR,12000.50,12300.75,2025-05-20T09:00:00+07:00\n",
+			mockSetup: func(t *testing.T, loadExchangeRatesUsecase *usecasemock.MockLoadExchangeRatesUsecase) {
+				loadExchangeRatesUsecase.EXPECT().
+					LoadExchangeRates(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, rates []*entity.ExchangeRate) error {
+						assert.Len(t, rates, 2)
+						assert.Equal(t, entity.CurrencyUSD, rates[0].BaseCurrency)
+						assert.True(t, decimal.RequireFromString("12300.75").Equal(rates[1].SellRate))
+						return nil
+					})
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"jumlah":2`,
+		},
+		{
+			name: "Load Exchange Rates - Invalid Line",
+			requestBody: "base_currency,quote_currency,buy_rate,sell_rate,effective_at\n" +
+				"USD,IDR,16500,16000,2025-05-20T09:00:00+07:00\n",
+			mockSetup:          func(t *testing.T, loadExchangeRatesUsecase *usecasemock.MockLoadExchangeRatesUsecase) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "baris 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := echo.New()
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
+
+			req := httptest.NewRequest(http.MethodPost, "/admin/kurs", strings.NewReader(tt.requestBody))
+			req.Header.Set(echo.HeaderContentType, "text/csv")
+			rec := httptest.NewRecorder()
+
+			mockLoadExchangeRatesUsecase := usecasemock.NewMockLoadExchangeRatesUsecase(ctrl)
+			tt.mockSetup(t, mockLoadExchangeRatesUsecase)
+
+			handler := handler.NewAdminHandler(nil, nil, mockLoadExchangeRatesUsecase, nil, nil, nil)
+
+			c := e.NewContext(req, rec)
+			if err := handler.LoadExchangeRates(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestSetWithdrawalLimit(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        interface{}
+		mockSetup          func(*testing.T, *usecasemock.MockSetWithdrawalLimitUsecase)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:        "Set Withdrawal Limit - Success",
+			requestBody: map[string]interface{}{"per_transaksi": 5000000, "harian": 10000000, "frekuensi_harian": 5},
+			mockSetup: func(t *testing.T, setWithdrawalLimitUsecase *usecasemock.MockSetWithdrawalLimitUsecase) {
+				setWithdrawalLimitUsecase.EXPECT().
+					SetWithdrawalLimit(gomock.Any(), &entity.SetWithdrawalLimitParams{
+						AccountNumber:     "1234567897",
+						MaxPerTransaction: decimal.NewFromInt(5000000),
+						MaxDaily:          decimal.NewFromInt(10000000),
+						MaxDailyCount:     5,
+					}).
+					Return(&entity.WithdrawalLimit{
+						AccountID:         1,
+						MaxPerTransaction: decimal.NewFromInt(5000000),
+						MaxDaily:          decimal.NewFromInt(10000000),
+						MaxDailyCount:     5,
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"harian":"10000000","bulanan":"0","frekuensi_harian":5`,
+		},
+		{
+			name:        "Set Withdrawal Limit - Negative Limit",
+			requestBody: map[string]interface{}{"harian": -1},
+			mockSetup: func(t *testing.T, setWithdrawalLimitUsecase *usecasemock.MockSetWithdrawalLimitUsecase) {
+				// No need to mock since it's an error test case
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"field":"harian"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := echo.New()
+			e.Validator = server.NewCommonValidator(util.GetValidator())
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPut, "/admin/rekening/1234567897/limit", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			mockSetWithdrawalLimitUsecase := usecasemock.NewMockSetWithdrawalLimitUsecase(ctrl)
+			tt.mockSetup(t, mockSetWithdrawalLimitUsecase)
+
+			handler := handler.NewAdminHandler(nil, nil, nil, mockSetWithdrawalLimitUsecase, nil, nil)
+
+			c := e.NewContext(req, rec)
+			c.SetParamNames("account_number")
+			c.SetParamValues("1234567897")
+
+			if err := handler.SetWithdrawalLimit(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestReverseTransaction(t *testing.T) {
+	tests := []struct {
+		name               string
+		operator           string
+		requestBody        interface{}
+		mockSetup          func(*testing.T, *usecasemock.MockReverseTransactionUsecase)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:        "Reverse Transaction - Success",
+			operator:    "teller01",
+			requestBody: map[string]interface{}{"alasan": "Salah input setoran"},
+			mockSetup: func(t *testing.T, reverseTransactionUsecase *usecasemock.MockReverseTransactionUsecase) {
+				reverseTransactionUsecase.EXPECT().
+					ReverseTransaction(gomock.Any(), &entity.ReverseTransactionParams{
+						TransactionID: 42,
+						Operator:      "teller01",
+						Reason:        "Salah input setoran",
+					}).
+					Return(&entity.Reversal{
+						Original: &entity.Transaction{ID: 42, Type: entity.TransactionTypeCredit, Amount: decimal.NewFromInt(50000)},
+						Reversal: &entity.Transaction{
+							ID:                  43,
+							Type:                entity.TransactionTypeReversalDebit,
+							Amount:              decimal.NewFromInt(50000),
+							InitialBalance:      decimal.NewFromInt(150000),
+							FinalBalance:        decimal.NewFromInt(100000),
+							Currency:            entity.CurrencyIDR,
+							LinkedTransactionID: 42,
+						},
+						Record: &entity.TransactionReversal{TransactionID: 42, ReversalTransactionID: 43, Operator: "teller01", Reason: "Salah input setoran"},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusCreated,
+			expectedBody:       `"jenis":"koreksi_debit"`,
+		},
+		{
+			name:        "Reverse Transaction - Operator Of The Body Ignored",
+			operator:    "teller01",
+			requestBody: map[string]interface{}{"petugas": "supervisor01", "alasan": "Salah input setoran"},
+			mockSetup: func(t *testing.T, reverseTransactionUsecase *usecasemock.MockReverseTransactionUsecase) {
+				reverseTransactionUsecase.EXPECT().
+					ReverseTransaction(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, params *entity.ReverseTransactionParams) (*entity.Reversal, error) {
+						assert.Equal(t, "teller01", params.Operator)
+						return nil, entity.ErrTransactionAlreadyReversed
+					})
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       entity.ErrTransactionAlreadyReversed.Code,
+		},
+		{
+			name:               "Reverse Transaction - Missing Operator",
+			requestBody:        map[string]interface{}{"petugas": "teller01", "alasan": "Salah input setoran"},
+			mockSetup:          func(t *testing.T, reverseTransactionUsecase *usecasemock.MockReverseTransactionUsecase) {},
+			expectedStatusCode: http.StatusUnauthorized,
+			expectedBody:       entity.ErrUnauthenticated.Code,
+		},
+		{
+			name:               "Reverse Transaction - Missing Reason",
+			operator:           "teller01",
+			requestBody:        map[string]interface{}{},
+			mockSetup:          func(t *testing.T, reverseTransactionUsecase *usecasemock.MockReverseTransactionUsecase) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "alasan",
+		},
+		{
+			name:        "Reverse Transaction - Already Reversed",
+			operator:    "teller01",
+			requestBody: map[string]interface{}{"alasan": "Salah input setoran"},
+			mockSetup: func(t *testing.T, reverseTransactionUsecase *usecasemock.MockReverseTransactionUsecase) {
+				reverseTransactionUsecase.EXPECT().
+					ReverseTransaction(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrTransactionAlreadyReversed)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       entity.ErrTransactionAlreadyReversed.Message,
+		},
+		{
+			name:        "Reverse Transaction - Force Not Allowed",
+			operator:    "teller01",
+			requestBody: map[string]interface{}{"alasan": "Salah input setoran", "paksa": true},
+			mockSetup: func(t *testing.T, reverseTransactionUsecase *usecasemock.MockReverseTransactionUsecase) {
+				reverseTransactionUsecase.EXPECT().
+					ReverseTransaction(gomock.Any(), &entity.ReverseTransactionParams{
+						TransactionID: 42,
+						Operator:      "teller01",
+						Reason:        "Salah input setoran",
+						Force:         true,
+					}).
+					Return(nil, entity.ErrReversalForceNotAllowed)
+			},
+			expectedStatusCode: http.StatusUnprocessableEntity,
+			expectedBody:       entity.ErrReversalForceNotAllowed.Code,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := echo.New()
+			e.Validator = server.NewCommonValidator(util.GetValidator())
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/admin/transaksi/42/koreksi", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.operator != "" {
+				req.Header.Set(middleware.HeaderOperator, tt.operator)
+			}
+			rec := httptest.NewRecorder()
+
+			mockReverseTransactionUsecase := usecasemock.NewMockReverseTransactionUsecase(ctrl)
+			tt.mockSetup(t, mockReverseTransactionUsecase)
+
+			handler := handler.NewAdminHandler(nil, nil, nil, nil, nil, mockReverseTransactionUsecase)
+
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("42")
+
+			if err := middleware.RequireOperator()(handler.ReverseTransaction)(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
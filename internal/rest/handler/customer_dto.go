@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// accountTypeNames maps the account types customers can open to their names in the API
+var accountTypeNames = map[entity.AccountType]string{
+	entity.AccountTypeSaving:      "TABUNGAN",
+	entity.AccountTypeTimeDeposit: "DEPOSITO",
+}
+
+// identityTypeNames maps the identity types to their names in the API
+var identityTypeNames = map[entity.CustomerIdentityType]string{
+	entity.IdentityTypeNIK:      "NIK",
+	entity.IdentityTypePassport: "PASPOR",
+	entity.IdentityTypeKITAS:    "KITAS",
+	entity.IdentityTypeNPWP:     "NPWP",
+}
+
+// getIdentityType converts the identity type name into the identity type, NIK when it's empty
+// The name is already validated by the oneof rule
+func getIdentityType(name string) entity.CustomerIdentityType {
+	for identityType, identityTypeName := range identityTypeNames {
+		if identityTypeName == name {
+			return identityType
+		}
+	}
+
+	return entity.IdentityTypeNIK
+}
+
+// OpenAccountRequest is the request body for opening an additional account of a registered customer
+// The customer is identified by id_nasabah or, when it's not given, by nik
+type OpenAccountRequest struct {
+	CustomerID     uint   `json:"id_nasabah" validate:"required_without=IdentityNumber"`
+	IdentityNumber string `json:"nik" validate:"required_without=CustomerID,omitempty,nik"`
+	AccountType    string `json:"jenis_rekening" validate:"omitempty,oneof=TABUNGAN"`
+	Currency       string `json:"mata_uang" validate:"omitempty,iso4217"`
+	IdempotencyKey string `json:"-" header:"Idempotency-Key" validate:"omitempty,max=64"`
+}
+
+// GetAccountType converts the account type name into the account type, a saving account when not specified
+func (o OpenAccountRequest) GetAccountType() entity.AccountType {
+	for accountType, name := range accountTypeNames {
+		if name == o.AccountType {
+			return accountType
+		}
+	}
+
+	return entity.AccountTypeSaving
+}
+
+// GetCurrency returns the currency of the account, IDR when not specified
+func (o OpenAccountRequest) GetCurrency() entity.Currency {
+	return getCurrency(o.Currency)
+}
+
+// OpenAccountResponse is the response body for opening an additional account
+type OpenAccountResponse struct {
+	CustomerID    uint            `json:"id_nasabah"`
+	AccountNumber string          `json:"no_rekening"`
+	AccountType   string          `json:"jenis_rekening"`
+	Currency      entity.Currency `json:"mata_uang"`
+}
+
+// ListCustomerAccountsRequest is the request for listing the accounts of a customer
+type ListCustomerAccountsRequest struct {
+	CustomerID uint `param:"id" validate:"required"`
+}
+
+// CustomerAccountResponse represents a single account of a customer in the response body
+type CustomerAccountResponse struct {
+	AccountNumber string          `json:"no_rekening"`
+	AccountType   string          `json:"jenis_rekening"`
+	Status        string          `json:"status"`
+	Balance       decimal.Decimal `json:"saldo"`
+	Currency      entity.Currency `json:"mata_uang"`
+}
+
+// ListCustomerAccountsResponse is the response body for listing the accounts of a customer
+type ListCustomerAccountsResponse struct {
+	CustomerID uint                      `json:"id_nasabah"`
+	Accounts   []CustomerAccountResponse `json:"rekening"`
+}
+
+// NewListCustomerAccountsResponse converts the accounts of a customer into the response body
+func NewListCustomerAccountsResponse(customerID uint, accounts []*entity.Account) *ListCustomerAccountsResponse {
+	response := &ListCustomerAccountsResponse{
+		CustomerID: customerID,
+		Accounts:   make([]CustomerAccountResponse, 0, len(accounts)),
+	}
+
+	for _, account := range accounts {
+		response.Accounts = append(response.Accounts, CustomerAccountResponse{
+			AccountNumber: account.AccountNumber,
+			AccountType:   accountTypeNames[account.AccountType],
+			Status:        accountStatusNames[account.Status],
+			Balance:       account.Balance,
+			Currency:      account.Currency,
+		})
+	}
+
+	return response
+}
+
+// GetCustomerRequest is the request for getting the profile of a customer
+type GetCustomerRequest struct {
+	CustomerID uint `param:"id" validate:"required"`
+}
+
+// customerTierNames maps the customer tiers to their names in the API
+var customerTierNames = map[entity.CustomerTier]string{
+	entity.CustomerTierRegular:  "REGULER",
+	entity.CustomerTierPriority: "PRIORITAS",
+}
+
+// CustomerResponse represents a customer in the response body
+type CustomerResponse struct {
+	CustomerID  uint      `json:"id_nasabah"`
+	Fullname    string    `json:"nama"`
+	PhoneNumber string    `json:"no_hp"`
+	Tier        string    `json:"tier"`
+	CreatedAt   time.Time `json:"dibuat_pada"`
+	UpdatedAt   time.Time `json:"diperbarui_pada"`
+}
+
+// NewCustomerResponse converts a customer entity into its response body
+func NewCustomerResponse(customer *entity.Customer) CustomerResponse {
+	return CustomerResponse{
+		CustomerID:  customer.ID,
+		Fullname:    customer.Fullname,
+		PhoneNumber: customer.PhoneNumber,
+		Tier:        customerTierNames[customer.Tier],
+		CreatedAt:   customer.CreatedAt,
+		UpdatedAt:   customer.UpdatedAt,
+	}
+}
+
+// CustomerIdentityResponse represents an identity document of a customer in the response body
+type CustomerIdentityResponse struct {
+	IdentityType   string `json:"jenis"`
+	IdentityNumber string `json:"nomor"`
+}
+
+// GetCustomerResponse is the response body for getting the profile of a customer
+type GetCustomerResponse struct {
+	CustomerResponse
+	Identities []CustomerIdentityResponse `json:"identitas"`
+	Accounts   []CustomerAccountResponse  `json:"rekening"`
+}
+
+// NewGetCustomerResponse converts the profile of a customer into the response body
+func NewGetCustomerResponse(profile *entity.CustomerProfile) *GetCustomerResponse {
+	response := &GetCustomerResponse{
+		CustomerResponse: NewCustomerResponse(profile.Customer),
+		Identities:       make([]CustomerIdentityResponse, 0, len(profile.Identities)),
+		Accounts:         NewListCustomerAccountsResponse(profile.Customer.ID, profile.Accounts).Accounts,
+	}
+
+	for _, identity := range profile.Identities {
+		response.Identities = append(response.Identities, CustomerIdentityResponse{
+			IdentityType:   identityTypeNames[identity.IdentityType],
+			IdentityNumber: identity.IdentityNumber,
+		})
+	}
+
+	return response
+}
+
+// UpdateCustomerRequest is the request body for changing the profile of a customer
+// At least one of nama and no_hp must be given, an empty field is left unchanged
+type UpdateCustomerRequest struct {
+	CustomerID  uint   `param:"id" validate:"required"`
+	Fullname    string `json:"nama" validate:"required_without=PhoneNumber,omitempty,fullname"`
+	PhoneNumber string `json:"no_hp" validate:"omitempty,phone_number"`
+}
+
+// SearchCustomersRequest is the request for searching customers, the criteria are combined
+type SearchCustomersRequest struct {
+	NamePrefix     string `query:"nama" validate:"omitempty,min=3,max=100"`
+	PhoneNumber    string `query:"no_hp" validate:"omitempty,phone_number"`
+	IdentityNumber string `query:"nik" validate:"omitempty,nik"`
+	Limit          int    `query:"limit" validate:"omitempty,gt=0,lte=100"`
+}
+
+// SearchCustomersResponse is the response body for searching customers
+type SearchCustomersResponse struct {
+	Customers []CustomerResponse `json:"nasabah"`
+}
+
+// AddCustomerIdentityRequest is the request body for attaching an identity document to a registered customer
+type AddCustomerIdentityRequest struct {
+	CustomerID     uint   `param:"id" validate:"required"`
+	IdentityType   string `json:"jenis_identitas" validate:"required,oneof=NIK PASPOR KITAS NPWP"`
+	IdentityNumber string `json:"no_identitas" validate:"required,identity_number=IdentityType"`
+}
@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+type customerHandler struct {
+	openAccountUsecase          OpenAccountUsecase
+	listCustomerAccountsUsecase ListCustomerAccountsUsecase
+	getCustomerUsecase          GetCustomerUsecase
+	updateCustomerUsecase       UpdateCustomerUsecase
+	searchCustomersUsecase      SearchCustomersUsecase
+	addCustomerIdentityUsecase  AddCustomerIdentityUsecase
+}
+
+func NewCustomerHandler(
+	openAccountUsecase OpenAccountUsecase,
+	listCustomerAccountsUsecase ListCustomerAccountsUsecase,
+	getCustomerUsecase GetCustomerUsecase,
+	updateCustomerUsecase UpdateCustomerUsecase,
+	searchCustomersUsecase SearchCustomersUsecase,
+	addCustomerIdentityUsecase AddCustomerIdentityUsecase,
+) *customerHandler {
+	return &customerHandler{
+		openAccountUsecase:          openAccountUsecase,
+		listCustomerAccountsUsecase: listCustomerAccountsUsecase,
+		getCustomerUsecase:          getCustomerUsecase,
+		updateCustomerUsecase:       updateCustomerUsecase,
+		searchCustomersUsecase:      searchCustomersUsecase,
+		addCustomerIdentityUsecase:  addCustomerIdentityUsecase,
+	}
+}
+
+func (h customerHandler) OpenAccount(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+		req = new(OpenAccountRequest)
+	)
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	req.IdempotencyKey = c.Request().Header.Get(HeaderIdempotencyKey)
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	params := &entity.OpenAccountParams{
+		CustomerID:     req.CustomerID,
+		IdentityNumber: req.IdentityNumber,
+		AccountType:    req.GetAccountType(),
+		Currency:       req.GetCurrency(),
+		IdempotencyKey: req.IdempotencyKey,
+	}
+
+	account, err := h.openAccountUsecase.OpenAccount(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &OpenAccountResponse{
+		CustomerID:    account.CustomerID,
+		AccountNumber: account.AccountNumber,
+		AccountType:   accountTypeNames[account.AccountType],
+		Currency:      account.Currency,
+	})
+}
+
+func (h customerHandler) ListCustomerAccounts(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+		req = new(ListCustomerAccountsRequest)
+	)
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	accounts, err := h.listCustomerAccountsUsecase.ListCustomerAccounts(ctx, req.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, NewListCustomerAccountsResponse(req.CustomerID, accounts))
+}
+
+func (h customerHandler) GetCustomer(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+		req = new(GetCustomerRequest)
+	)
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	profile, err := h.getCustomerUsecase.GetCustomer(ctx, req.CustomerID)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, NewGetCustomerResponse(profile))
+}
+
+func (h customerHandler) UpdateCustomer(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+		req = new(UpdateCustomerRequest)
+	)
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	params := &entity.UpdateCustomerParams{
+		CustomerID:  req.CustomerID,
+		Fullname:    req.Fullname,
+		PhoneNumber: req.PhoneNumber,
+	}
+
+	customer, err := h.updateCustomerUsecase.UpdateCustomer(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, NewCustomerResponse(customer))
+}
+
+func (h customerHandler) SearchCustomers(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+		req = new(SearchCustomersRequest)
+	)
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	params := &entity.SearchCustomersParams{
+		NamePrefix:     req.NamePrefix,
+		PhoneNumber:    req.PhoneNumber,
+		IdentityNumber: req.IdentityNumber,
+		Limit:          req.Limit,
+	}
+
+	customers, err := h.searchCustomersUsecase.SearchCustomers(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	response := &SearchCustomersResponse{
+		Customers: make([]CustomerResponse, 0, len(customers)),
+	}
+
+	for _, customer := range customers {
+		response.Customers = append(response.Customers, NewCustomerResponse(customer))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func (h customerHandler) AddCustomerIdentity(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+		req = new(AddCustomerIdentityRequest)
+	)
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	params := &entity.AddCustomerIdentityParams{
+		CustomerID:     req.CustomerID,
+		IdentityType:   getIdentityType(req.IdentityType),
+		IdentityNumber: req.IdentityNumber,
+	}
+
+	customerIdentity, err := h.addCustomerIdentityUsecase.AddCustomerIdentity(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &CustomerIdentityResponse{
+		IdentityType:   identityTypeNames[customerIdentity.IdentityType],
+		IdentityNumber: customerIdentity.IdentityNumber,
+	})
+}
@@ -0,0 +1,432 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/internal/rest/handler"
+	usecasemock "imansohibul.my.id/account-domain-service/internal/rest/handler/mock"
+	"imansohibul.my.id/account-domain-service/internal/rest/server"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestOpenAccount(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        interface{}
+		mockSetup          func(*testing.T, *usecasemock.MockOpenAccountUsecase)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:        "Open Account - By NIK",
+			requestBody: map[string]string{"nik": "3201231505900001", "mata_uang": "USD"},
+			mockSetup: func(t *testing.T, openAccountUsecase *usecasemock.MockOpenAccountUsecase) {
+				openAccountUsecase.EXPECT().
+					OpenAccount(gomock.Any(), &entity.OpenAccountParams{
+						IdentityNumber: "3201231505900001",
+						AccountType:    entity.AccountTypeSaving,
+						Currency:       entity.CurrencyUSD,
+					}).
+					Return(&entity.Account{CustomerID: 7, AccountNumber: "1234567897", AccountType: entity.AccountTypeSaving, Currency: entity.CurrencyUSD}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"id_nasabah":7,"no_rekening":"1234567897","jenis_rekening":"TABUNGAN","mata_uang":"USD"}`,
+		},
+		{
+			name:        "Open Account - By Customer ID",
+			requestBody: map[string]interface{}{"id_nasabah": 7, "jenis_rekening": "TABUNGAN"},
+			mockSetup: func(t *testing.T, openAccountUsecase *usecasemock.MockOpenAccountUsecase) {
+				openAccountUsecase.EXPECT().
+					OpenAccount(gomock.Any(), &entity.OpenAccountParams{
+						CustomerID:  7,
+						AccountType: entity.AccountTypeSaving,
+						Currency:    entity.CurrencyIDR,
+					}).
+					Return(&entity.Account{CustomerID: 7, AccountNumber: "9876543217", AccountType: entity.AccountTypeSaving, Currency: entity.CurrencyIDR}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"no_rekening":"9876543217"`,
+		},
+		{
+			name:               "Open Account - Customer Not Identified",
+			requestBody:        map[string]string{"mata_uang": "IDR"},
+			mockSetup:          func(t *testing.T, openAccountUsecase *usecasemock.MockOpenAccountUsecase) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"field":"id_nasabah","rule":"required_without"`,
+		},
+		{
+			name:        "Open Account - Customer Not Found",
+			requestBody: map[string]string{"nik": "3201231505900001"},
+			mockSetup: func(t *testing.T, openAccountUsecase *usecasemock.MockOpenAccountUsecase) {
+				openAccountUsecase.EXPECT().
+					OpenAccount(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrCustomerNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       entity.ErrCustomerNotFound.Message,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := echo.New()
+			e.Validator = server.NewCommonValidator(util.GetValidator())
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/buka-rekening", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			mockOpenAccountUsecase := usecasemock.NewMockOpenAccountUsecase(ctrl)
+			tt.mockSetup(t, mockOpenAccountUsecase)
+
+			handler := handler.NewCustomerHandler(mockOpenAccountUsecase, nil, nil, nil, nil, nil)
+
+			c := e.NewContext(req, rec)
+			if err := handler.OpenAccount(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestListCustomerAccounts(t *testing.T) {
+	tests := []struct {
+		name               string
+		customerID         string
+		mockSetup          func(*testing.T, *usecasemock.MockListCustomerAccountsUsecase)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:       "List Customer Accounts - Success",
+			customerID: "7",
+			mockSetup: func(t *testing.T, listCustomerAccountsUsecase *usecasemock.MockListCustomerAccountsUsecase) {
+				listCustomerAccountsUsecase.EXPECT().
+					ListCustomerAccounts(gomock.Any(), uint(7)).
+					Return([]*entity.Account{
+						{AccountNumber: "1234567897", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, Balance: decimal.NewFromInt(50000), Currency: entity.CurrencyIDR},
+						{AccountNumber: "9876543217", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusBlocked, Balance: decimal.RequireFromString("10.5"), Currency: entity.CurrencyUSD},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody: `{"id_nasabah":7,"rekening":[` +
+				`{"no_rekening":"1234567897","jenis_rekening":"TABUNGAN","status":"AKTIF","saldo":"50000","mata_uang":"IDR"},` +
+				`{"no_rekening":"9876543217","jenis_rekening":"TABUNGAN","status":"BLOKIR","saldo":"10.5","mata_uang":"USD"}]}`,
+		},
+		{
+			name:       "List Customer Accounts - Customer Not Found",
+			customerID: "8",
+			mockSetup: func(t *testing.T, listCustomerAccountsUsecase *usecasemock.MockListCustomerAccountsUsecase) {
+				listCustomerAccountsUsecase.EXPECT().
+					ListCustomerAccounts(gomock.Any(), uint(8)).
+					Return(nil, entity.ErrCustomerNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       entity.ErrCustomerNotFound.Message,
+		},
+		{
+			name:               "List Customer Accounts - Invalid ID",
+			customerID:         "abc",
+			mockSetup:          func(t *testing.T, listCustomerAccountsUsecase *usecasemock.MockListCustomerAccountsUsecase) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       entity.ErrInvalidRequest.Message,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := echo.New()
+			e.Validator = server.NewCommonValidator(util.GetValidator())
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/nasabah/"+tt.customerID+"/rekening", nil)
+			rec := httptest.NewRecorder()
+
+			mockListCustomerAccountsUsecase := usecasemock.NewMockListCustomerAccountsUsecase(ctrl)
+			tt.mockSetup(t, mockListCustomerAccountsUsecase)
+
+			handler := handler.NewCustomerHandler(nil, mockListCustomerAccountsUsecase, nil, nil, nil, nil)
+
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues(tt.customerID)
+
+			if err := handler.ListCustomerAccounts(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestGetCustomer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	e := echo.New()
+	e.Validator = server.NewCommonValidator(util.GetValidator())
+
+	req := httptest.NewRequest(http.MethodGet, "/nasabah/7", nil)
+	rec := httptest.NewRecorder()
+
+	mockGetCustomerUsecase := usecasemock.NewMockGetCustomerUsecase(ctrl)
+	mockGetCustomerUsecase.EXPECT().
+		GetCustomer(gomock.Any(), uint(7)).
+		Return(&entity.CustomerProfile{
+			Customer:   &entity.Customer{ID: 7, Fullname: "Budi Santoso", PhoneNumber: "+6281234567890"},
+			Identities: []*entity.CustomerIdentity{{IdentityType: entity.IdentityTypeNIK, IdentityNumber: "3201231505900001"}},
+			Accounts:   []*entity.Account{{AccountNumber: "1234567897", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, Balance: decimal.NewFromInt(50000), Currency: entity.CurrencyIDR}},
+		}, nil)
+
+	handler := handler.NewCustomerHandler(nil, nil, mockGetCustomerUsecase, nil, nil, nil)
+
+	c := e.NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("7")
+
+	err := handler.GetCustomer(c)
+	if err != nil {
+		t.Errorf("Error: %v", err)
+	}
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"nama":"Budi Santoso"`)
+	assert.Contains(t, rec.Body.String(), `"identitas":[{"jenis":"NIK","nomor":"3201231505900001"}]`)
+	assert.Contains(t, rec.Body.String(), `"rekening":[{"no_rekening":"1234567897"`)
+}
+
+func TestUpdateCustomer(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        interface{}
+		mockSetup          func(*testing.T, *usecasemock.MockUpdateCustomerUsecase)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:        "Update Customer - Success",
+			requestBody: map[string]string{"nama": "Budi Santoso", "no_hp": "+6281234567891"},
+			mockSetup: func(t *testing.T, updateCustomerUsecase *usecasemock.MockUpdateCustomerUsecase) {
+				updateCustomerUsecase.EXPECT().
+					UpdateCustomer(gomock.Any(), &entity.UpdateCustomerParams{
+						CustomerID:  7,
+						Fullname:    "Budi Santoso",
+						PhoneNumber: "+6281234567891",
+					}).
+					Return(&entity.Customer{ID: 7, Fullname: "Budi Santoso", PhoneNumber: "+6281234567891"}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"no_hp":"+6281234567891"`,
+		},
+		{
+			name:        "Update Customer - Phone Number Already Exists",
+			requestBody: map[string]string{"no_hp": "+6281234567891"},
+			mockSetup: func(t *testing.T, updateCustomerUsecase *usecasemock.MockUpdateCustomerUsecase) {
+				updateCustomerUsecase.EXPECT().
+					UpdateCustomer(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrPhoneNumberAlreadyExists)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       entity.ErrPhoneNumberAlreadyExists.Message,
+		},
+		{
+			name:               "Update Customer - Nothing To Update",
+			requestBody:        map[string]string{},
+			mockSetup:          func(t *testing.T, updateCustomerUsecase *usecasemock.MockUpdateCustomerUsecase) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"field":"nama","rule":"required_without"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := echo.New()
+			e.Validator = server.NewCommonValidator(util.GetValidator())
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPut, "/nasabah/7", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			mockUpdateCustomerUsecase := usecasemock.NewMockUpdateCustomerUsecase(ctrl)
+			tt.mockSetup(t, mockUpdateCustomerUsecase)
+
+			handler := handler.NewCustomerHandler(nil, nil, nil, mockUpdateCustomerUsecase, nil, nil)
+
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("7")
+
+			if err := handler.UpdateCustomer(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestSearchCustomers(t *testing.T) {
+	tests := []struct {
+		name               string
+		queryParams        string
+		mockSetup          func(*testing.T, *usecasemock.MockSearchCustomersUsecase)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:        "Search Customers - By Name Prefix",
+			queryParams: "?nama=bud&limit=5",
+			mockSetup: func(t *testing.T, searchCustomersUsecase *usecasemock.MockSearchCustomersUsecase) {
+				searchCustomersUsecase.EXPECT().
+					SearchCustomers(gomock.Any(), &entity.SearchCustomersParams{NamePrefix: "bud", Limit: 5}).
+					Return([]*entity.Customer{{ID: 7, Fullname: "Budi Santoso", PhoneNumber: "+6281234567890"}}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"nasabah":[{"id_nasabah":7,"nama":"Budi Santoso","no_hp":"+6281234567890"`,
+		},
+		{
+			name:        "Search Customers - No Match",
+			queryParams: "?nik=3201231505900001",
+			mockSetup: func(t *testing.T, searchCustomersUsecase *usecasemock.MockSearchCustomersUsecase) {
+				searchCustomersUsecase.EXPECT().
+					SearchCustomers(gomock.Any(), &entity.SearchCustomersParams{IdentityNumber: "3201231505900001"}).
+					Return([]*entity.Customer{}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"nasabah":[]}`,
+		},
+		{
+			name:               "Search Customers - Name Prefix Too Short",
+			queryParams:        "?nama=bu",
+			mockSetup:          func(t *testing.T, searchCustomersUsecase *usecasemock.MockSearchCustomersUsecase) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"field":"nama","rule":"min"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := echo.New()
+			e.Validator = server.NewCommonValidator(util.GetValidator())
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/nasabah"+tt.queryParams, nil)
+			rec := httptest.NewRecorder()
+
+			mockSearchCustomersUsecase := usecasemock.NewMockSearchCustomersUsecase(ctrl)
+			tt.mockSetup(t, mockSearchCustomersUsecase)
+
+			handler := handler.NewCustomerHandler(nil, nil, nil, nil, mockSearchCustomersUsecase, nil)
+
+			c := e.NewContext(req, rec)
+			if err := handler.SearchCustomers(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestAddCustomerIdentity(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        interface{}
+		mockSetup          func(*testing.T, *usecasemock.MockAddCustomerIdentityUsecase)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:        "Add Customer Identity - Success",
+			requestBody: map[string]string{"jenis_identitas": "NPWP", "no_identitas": "012345678901234"},
+			mockSetup: func(t *testing.T, addCustomerIdentityUsecase *usecasemock.MockAddCustomerIdentityUsecase) {
+				addCustomerIdentityUsecase.EXPECT().
+					AddCustomerIdentity(gomock.Any(), &entity.AddCustomerIdentityParams{
+						CustomerID:     7,
+						IdentityType:   entity.IdentityTypeNPWP,
+						IdentityNumber: "012345678901234",
+					}).
+					Return(&entity.CustomerIdentity{CustomerID: 7, IdentityType: entity.IdentityTypeNPWP, IdentityNumber: "012345678901234"}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"jenis":"NPWP","nomor":"012345678901234"}`,
+		},
+		{
+			name:               "Add Customer Identity - Invalid KITAS",
+			requestBody:        map[string]string{"jenis_identitas": "KITAS", "no_identitas": "2C1-AB-123"},
+			mockSetup:          func(t *testing.T, addCustomerIdentityUsecase *usecasemock.MockAddCustomerIdentityUsecase) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"field":"no_identitas","rule":"identity_number"`,
+		},
+		{
+			name:               "Add Customer Identity - Unsupported Identity Type",
+			requestBody:        map[string]string{"jenis_identitas": "SIM", "no_identitas": "1234567897"},
+			mockSetup:          func(t *testing.T, addCustomerIdentityUsecase *usecasemock.MockAddCustomerIdentityUsecase) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"field":"jenis_identitas","rule":"oneof"`,
+		},
+		{
+			name:        "Add Customer Identity - Already Exists",
+			requestBody: map[string]string{"jenis_identitas": "PASPOR", "no_identitas": "A1234567"},
+			mockSetup: func(t *testing.T, addCustomerIdentityUsecase *usecasemock.MockAddCustomerIdentityUsecase) {
+				addCustomerIdentityUsecase.EXPECT().
+					AddCustomerIdentity(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrCustomerIdentityAlreadyExists)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       entity.ErrCustomerIdentityAlreadyExists.Message,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := echo.New()
+			e.Validator = server.NewCommonValidator(util.GetValidator())
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/nasabah/7/identitas", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			mockAddCustomerIdentityUsecase := usecasemock.NewMockAddCustomerIdentityUsecase(ctrl)
+			tt.mockSetup(t, mockAddCustomerIdentityUsecase)
+
+			handler := handler.NewCustomerHandler(nil, nil, nil, nil, nil, mockAddCustomerIdentityUsecase)
+
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("7")
+
+			if err := handler.AddCustomerIdentity(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
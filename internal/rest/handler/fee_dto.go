@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// channelNames maps the transaction channels to their names in the API
+var channelNames = map[entity.Channel]string{
+	entity.ChannelTeller:     "TELLER",
+	entity.ChannelATM:        "ATM",
+	entity.ChannelMobile:     "MOBILE",
+	entity.ChannelInternet:   "INTERNET",
+	entity.ChannelPartnerAPI: "PARTNER",
+}
+
+// feeOperationNames maps the operations with a fee to their names in the API
+var feeOperationNames = map[entity.FeeOperation]string{
+	entity.FeeOperationWithdraw: "TARIK",
+	entity.FeeOperationTransfer: "TRANSFER",
+}
+
+// QuoteFeeRequest is the request for showing the fee of a withdrawal or a transfer before it's confirmed
+type QuoteFeeRequest struct {
+	AccountNumber string          `query:"no_rekening" validate:"required,account_number"`
+	Operation     string          `query:"transaksi" validate:"required,oneof=TARIK TRANSFER"`
+	Amount        decimal.Decimal `query:"nominal" validate:"required,gt=0"`
+	Currency      string          `query:"mata_uang" validate:"omitempty,iso4217"`
+	Channel       string          `query:"kanal" validate:"omitempty,oneof=TELLER ATM MOBILE INTERNET PARTNER"`
+}
+
+// GetAmount returns the amount of the operation
+func (q QuoteFeeRequest) GetAmount() decimal.Decimal {
+	return q.Amount
+}
+
+// GetCurrency returns the currency of the amount, IDR when not specified
+func (q QuoteFeeRequest) GetCurrency() entity.Currency {
+	return getCurrency(q.Currency)
+}
+
+// ToParams converts the request into the parameters of the usecase
+func (q QuoteFeeRequest) ToParams() *entity.FeeQuoteParams {
+	params := &entity.FeeQuoteParams{
+		AccountNumber: q.AccountNumber,
+		Channel:       getChannel(q.Channel),
+		Amount:        q.GetAmount(),
+		Currency:      q.GetCurrency(),
+	}
+
+	for operation, name := range feeOperationNames {
+		if name == q.Operation {
+			params.Operation = operation
+		}
+	}
+
+	return params
+}
+
+// QuoteFeeResponse is the response body for showing the fee of a withdrawal or a transfer
+type QuoteFeeResponse struct {
+	Amount   decimal.Decimal `json:"nominal"`
+	Fee      decimal.Decimal `json:"biaya"`
+	Total    decimal.Decimal `json:"total"`
+	Currency entity.Currency `json:"mata_uang"`
+}
+
+// getChannel converts the channel name of a request, the default channel is used when it's empty
+func getChannel(name string) entity.Channel {
+	for channel, channelName := range channelNames {
+		if channelName == name {
+			return channel
+		}
+	}
+
+	return entity.DefaultChannel
+}
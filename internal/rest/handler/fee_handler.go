@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+type feeHandler struct {
+	quoteFeeUsecase QuoteFeeUsecase
+}
+
+func NewFeeHandler(
+	quoteFeeUsecase QuoteFeeUsecase,
+) *feeHandler {
+	return &feeHandler{
+		quoteFeeUsecase: quoteFeeUsecase,
+	}
+}
+
+func (f feeHandler) QuoteFee(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+		req = new(QuoteFeeRequest)
+	)
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	quote, err := f.quoteFeeUsecase.QuoteFee(ctx, req.ToParams())
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &QuoteFeeResponse{
+		Amount:   quote.Amount,
+		Fee:      quote.Fee,
+		Total:    quote.Total,
+		Currency: quote.Currency,
+	})
+}
@@ -0,0 +1,95 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/internal/rest/handler"
+	usecasemock "imansohibul.my.id/account-domain-service/internal/rest/handler/mock"
+	"imansohibul.my.id/account-domain-service/internal/rest/server"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestQuoteFee(t *testing.T) {
+	tests := []struct {
+		name               string
+		query              string
+		mockSetup          func(*testing.T, *usecasemock.MockQuoteFeeUsecase)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:  "Quote Fee - Success",
+			query: "?no_rekening=1234567897&transaksi=TARIK&nominal=600000&kanal=ATM",
+			mockSetup: func(t *testing.T, quoteFeeUsecase *usecasemock.MockQuoteFeeUsecase) {
+				quoteFeeUsecase.EXPECT().
+					QuoteFee(gomock.Any(), &entity.FeeQuoteParams{
+						AccountNumber: "1234567897",
+						Operation:     entity.FeeOperationWithdraw,
+						Channel:       entity.ChannelATM,
+						Amount:        decimal.NewFromInt(600000),
+						Currency:      entity.CurrencyIDR,
+					}).
+					Return(&entity.FeeQuote{
+						Amount:   decimal.NewFromInt(600000),
+						Fee:      decimal.NewFromInt(3100),
+						Total:    decimal.NewFromInt(603100),
+						Currency: entity.CurrencyIDR,
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"biaya":"3100","total":"603100"`,
+		},
+		{
+			name:  "Quote Fee - Account Not Found",
+			query: "?no_rekening=1234567897&transaksi=TRANSFER&nominal=50000",
+			mockSetup: func(t *testing.T, quoteFeeUsecase *usecasemock.MockQuoteFeeUsecase) {
+				quoteFeeUsecase.EXPECT().
+					QuoteFee(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrAccountNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       entity.ErrAccountNotFound.Message,
+		},
+		{
+			name:  "Quote Fee - Unknown Operation",
+			query: "?no_rekening=1234567897&transaksi=TABUNG&nominal=50000",
+			mockSetup: func(t *testing.T, quoteFeeUsecase *usecasemock.MockQuoteFeeUsecase) {
+				// No need to mock since it's an error test case
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "transaksi",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := echo.New()
+			e.Validator = server.NewCommonValidator(util.GetValidator())
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/biaya"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			mockQuoteFeeUsecase := usecasemock.NewMockQuoteFeeUsecase(ctrl)
+			tt.mockSetup(t, mockQuoteFeeUsecase)
+
+			handler := handler.NewFeeHandler(mockQuoteFeeUsecase)
+
+			c := e.NewContext(req, rec)
+			if err := handler.QuoteFee(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
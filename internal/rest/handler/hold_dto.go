@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// holdStatusNames maps the hold statuses to their names in the API
+var holdStatusNames = map[entity.HoldStatus]string{
+	entity.HoldStatusActive:   "AKTIF",
+	entity.HoldStatusCaptured: "CAPTURED",
+	entity.HoldStatusReleased: "DILEPAS",
+	entity.HoldStatusExpired:  "KEDALUWARSA",
+}
+
+// PlaceHoldRequest is the request body for reserving funds of an account
+type PlaceHoldRequest struct {
+	AccountNumber   string          `json:"no_rekening" validate:"required,account_number"`
+	Amount          decimal.Decimal `json:"nominal" validate:"required,gt=0"`
+	Currency        string          `json:"mata_uang" validate:"omitempty,iso4217"`
+	DurationMinutes int             `json:"masa_berlaku_menit" validate:"omitempty,gt=0,lte=43200"`
+	Reference       string          `json:"referensi" validate:"omitempty,max=64"`
+	IdempotencyKey  string          `json:"-" header:"Idempotency-Key" validate:"omitempty,max=64"`
+}
+
+// GetCurrency returns the currency of the amount, IDR when not specified
+func (p PlaceHoldRequest) GetCurrency() entity.Currency {
+	return getCurrency(p.Currency)
+}
+
+// GetDuration returns how long the funds are reserved, zero (the default duration) when not specified
+func (p PlaceHoldRequest) GetDuration() time.Duration {
+	return time.Duration(p.DurationMinutes) * time.Minute
+}
+
+// CaptureHoldRequest is the request body for debiting the funds reserved by a hold
+// The full amount of the hold is captured when nominal is not specified
+type CaptureHoldRequest struct {
+	HoldID         uint            `param:"id" validate:"required"`
+	Amount         decimal.Decimal `json:"nominal" validate:"omitempty,gt=0"`
+	IdempotencyKey string          `json:"-" header:"Idempotency-Key" validate:"omitempty,max=64"`
+}
+
+// ReleaseHoldRequest is the request for cancelling a hold
+type ReleaseHoldRequest struct {
+	HoldID         uint   `param:"id" validate:"required"`
+	IdempotencyKey string `json:"-" header:"Idempotency-Key" validate:"omitempty,max=64"`
+}
+
+// HoldResponse is the response body of a hold
+type HoldResponse struct {
+	HoldID         uint            `json:"id_hold"`
+	Amount         decimal.Decimal `json:"nominal"`
+	CapturedAmount decimal.Decimal `json:"nominal_capture"`
+	Currency       entity.Currency `json:"mata_uang"`
+	Status         string          `json:"status"`
+	Reference      string          `json:"referensi"`
+	ExpiresAt      time.Time       `json:"berlaku_sampai"`
+}
+
+// NewHoldResponse converts a hold entity into its response body
+func NewHoldResponse(hold *entity.Hold) *HoldResponse {
+	return &HoldResponse{
+		HoldID:         hold.ID,
+		Amount:         hold.Amount,
+		CapturedAmount: hold.CapturedAmount,
+		Currency:       hold.Currency,
+		Status:         holdStatusNames[hold.Status],
+		Reference:      hold.Reference,
+		ExpiresAt:      hold.ExpiresAt,
+	}
+}
+
+// CaptureHoldResponse is the response body for capturing a hold
+type CaptureHoldResponse struct {
+	*HoldResponse
+	AccountBalance   decimal.Decimal `json:"saldo"`
+	AvailableBalance decimal.Decimal `json:"saldo_tersedia"`
+}
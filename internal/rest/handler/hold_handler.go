@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+type holdHandler struct {
+	placeHoldUsecase   PlaceHoldUsecase
+	captureHoldUsecase CaptureHoldUsecase
+	releaseHoldUsecase ReleaseHoldUsecase
+}
+
+func NewHoldHandler(
+	placeHoldUsecase PlaceHoldUsecase,
+	captureHoldUsecase CaptureHoldUsecase,
+	releaseHoldUsecase ReleaseHoldUsecase,
+) *holdHandler {
+	return &holdHandler{
+		placeHoldUsecase:   placeHoldUsecase,
+		captureHoldUsecase: captureHoldUsecase,
+		releaseHoldUsecase: releaseHoldUsecase,
+	}
+}
+
+func (h holdHandler) PlaceHold(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+		req = new(PlaceHoldRequest)
+	)
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	req.IdempotencyKey = c.Request().Header.Get(HeaderIdempotencyKey)
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	hold, err := h.placeHoldUsecase.PlaceHold(ctx, &entity.PlaceHoldParams{
+		AccountNumber:  req.AccountNumber,
+		Amount:         req.Amount,
+		Currency:       req.GetCurrency(),
+		Duration:       req.GetDuration(),
+		Reference:      req.Reference,
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, NewHoldResponse(hold))
+}
+
+func (h holdHandler) CaptureHold(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+		req = new(CaptureHoldRequest)
+	)
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	req.IdempotencyKey = c.Request().Header.Get(HeaderIdempotencyKey)
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	capture, err := h.captureHoldUsecase.CaptureHold(ctx, &entity.CaptureHoldParams{
+		HoldID:         req.HoldID,
+		Amount:         req.Amount,
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, &CaptureHoldResponse{
+		HoldResponse:     NewHoldResponse(capture.Hold),
+		AccountBalance:   capture.Account.Balance,
+		AvailableBalance: capture.Account.AvailableBalance(),
+	})
+}
+
+func (h holdHandler) ReleaseHold(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+		req = new(ReleaseHoldRequest)
+	)
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	req.IdempotencyKey = c.Request().Header.Get(HeaderIdempotencyKey)
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	hold, err := h.releaseHoldUsecase.ReleaseHold(ctx, &entity.ReleaseHoldParams{
+		HoldID:         req.HoldID,
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, NewHoldResponse(hold))
+}
@@ -0,0 +1,182 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/internal/rest/handler"
+	usecasemock "imansohibul.my.id/account-domain-service/internal/rest/handler/mock"
+	"imansohibul.my.id/account-domain-service/internal/rest/server"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestPlaceHold(t *testing.T) {
+	expiresAt := time.Date(2025, time.June, 10, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name               string
+		requestBody        interface{}
+		mockSetup          func(*testing.T, *usecasemock.MockPlaceHoldUsecase)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:        "Place Hold - Success",
+			requestBody: &handler.PlaceHoldRequest{AccountNumber: "1234567897", Amount: decimal.NewFromInt(250000), DurationMinutes: 60, Reference: "INV-001"},
+			mockSetup: func(t *testing.T, placeHoldUsecase *usecasemock.MockPlaceHoldUsecase) {
+				placeHoldUsecase.EXPECT().
+					PlaceHold(gomock.Any(), &entity.PlaceHoldParams{
+						AccountNumber: "1234567897",
+						Amount:        decimal.NewFromInt(250000),
+						Currency:      entity.CurrencyIDR,
+						Duration:      time.Hour,
+						Reference:     "INV-001",
+					}).
+					Return(&entity.Hold{
+						ID:        7,
+						Amount:    decimal.NewFromInt(250000),
+						Currency:  entity.CurrencyIDR,
+						Status:    entity.HoldStatusActive,
+						Reference: "INV-001",
+						ExpiresAt: expiresAt,
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"id_hold":7,"nominal":"250000","nominal_capture":"0","mata_uang":"IDR","status":"AKTIF"`,
+		},
+		{
+			name:        "Place Hold - Insufficient Available Balance",
+			requestBody: &handler.PlaceHoldRequest{AccountNumber: "1234567897", Amount: decimal.NewFromInt(250000)},
+			mockSetup: func(t *testing.T, placeHoldUsecase *usecasemock.MockPlaceHoldUsecase) {
+				placeHoldUsecase.EXPECT().
+					PlaceHold(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrInsufficientBalance)
+			},
+			expectedStatusCode: http.StatusUnprocessableEntity,
+			expectedBody:       `"code":"ACCOUNT_INSUFFICIENT_BALANCE"`,
+		},
+		{
+			name:        "Place Hold - Duration Too Long",
+			requestBody: &handler.PlaceHoldRequest{AccountNumber: "1234567897", Amount: decimal.NewFromInt(250000), DurationMinutes: 43201},
+			mockSetup: func(t *testing.T, placeHoldUsecase *usecasemock.MockPlaceHoldUsecase) {
+				// No need to mock since it's an error test case
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"field":"masa_berlaku_menit"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := echo.New()
+			e.Validator = server.NewCommonValidator(util.GetValidator())
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/hold", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			mockPlaceHoldUsecase := usecasemock.NewMockPlaceHoldUsecase(ctrl)
+			tt.mockSetup(t, mockPlaceHoldUsecase)
+
+			handler := handler.NewHoldHandler(mockPlaceHoldUsecase, nil, nil)
+
+			c := e.NewContext(req, rec)
+			if err := handler.PlaceHold(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestCaptureHold(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        interface{}
+		idempotencyKey     string
+		mockSetup          func(*testing.T, *usecasemock.MockCaptureHoldUsecase)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:           "Capture Hold - Partial",
+			requestBody:    map[string]interface{}{"nominal": 100000},
+			idempotencyKey: "capture-7",
+			mockSetup: func(t *testing.T, captureHoldUsecase *usecasemock.MockCaptureHoldUsecase) {
+				captureHoldUsecase.EXPECT().
+					CaptureHold(gomock.Any(), &entity.CaptureHoldParams{HoldID: 7, Amount: decimal.NewFromInt(100000), IdempotencyKey: "capture-7"}).
+					Return(&entity.HoldCapture{
+						Hold: &entity.Hold{
+							ID:             7,
+							Amount:         decimal.NewFromInt(250000),
+							CapturedAmount: decimal.NewFromInt(100000),
+							Currency:       entity.CurrencyIDR,
+							Status:         entity.HoldStatusCaptured,
+						},
+						Debit:   &entity.Transaction{ID: 42},
+						Account: &entity.Account{Balance: decimal.NewFromInt(900000), HeldAmount: decimal.NewFromInt(50000)},
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"saldo":"900000","saldo_tersedia":"850000"`,
+		},
+		{
+			name:        "Capture Hold - Already Captured",
+			requestBody: map[string]interface{}{},
+			mockSetup: func(t *testing.T, captureHoldUsecase *usecasemock.MockCaptureHoldUsecase) {
+				captureHoldUsecase.EXPECT().
+					CaptureHold(gomock.Any(), &entity.CaptureHoldParams{HoldID: 7}).
+					Return(nil, entity.ErrHoldNotActive)
+			},
+			expectedStatusCode: http.StatusConflict,
+			expectedBody:       `"code":"HOLD_NOT_ACTIVE"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := echo.New()
+			e.Validator = server.NewCommonValidator(util.GetValidator())
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/hold/7/capture", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if tt.idempotencyKey != "" {
+				req.Header.Set(handler.HeaderIdempotencyKey, tt.idempotencyKey)
+			}
+			rec := httptest.NewRecorder()
+
+			mockCaptureHoldUsecase := usecasemock.NewMockCaptureHoldUsecase(ctrl)
+			tt.mockSetup(t, mockCaptureHoldUsecase)
+
+			handler := handler.NewHoldHandler(nil, mockCaptureHoldUsecase, nil)
+
+			c := e.NewContext(req, rec)
+			c.SetParamNames("id")
+			c.SetParamValues("7")
+
+			if err := handler.CaptureHold(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
@@ -9,7 +9,6 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
-	decimal "github.com/shopspring/decimal"
 	entity "imansohibul.my.id/account-domain-service/entity"
 )
 
@@ -75,10 +74,10 @@ func (m *MockGetBalanceUsecase) EXPECT() *MockGetBalanceUsecaseMockRecorder {
 }
 
 // GetBalance mocks base method.
-func (m *MockGetBalanceUsecase) GetBalance(ctx context.Context, accountNumber string) (decimal.Decimal, error) {
+func (m *MockGetBalanceUsecase) GetBalance(ctx context.Context, accountNumber string) (*entity.Balance, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetBalance", ctx, accountNumber)
-	ret0, _ := ret[0].(decimal.Decimal)
+	ret0, _ := ret[0].(*entity.Balance)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -113,18 +112,18 @@ func (m *MockDepositUsecase) EXPECT() *MockDepositUsecaseMockRecorder {
 }
 
 // Deposit mocks base method.
-func (m *MockDepositUsecase) Deposit(ctx context.Context, accountNumber string, amount decimal.Decimal) (*entity.Transaction, error) {
+func (m *MockDepositUsecase) Deposit(ctx context.Context, params *entity.DepositParams) (*entity.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Deposit", ctx, accountNumber, amount)
+	ret := m.ctrl.Call(m, "Deposit", ctx, params)
 	ret0, _ := ret[0].(*entity.Transaction)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Deposit indicates an expected call of Deposit.
-func (mr *MockDepositUsecaseMockRecorder) Deposit(ctx, accountNumber, amount interface{}) *gomock.Call {
+func (mr *MockDepositUsecaseMockRecorder) Deposit(ctx, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deposit", reflect.TypeOf((*MockDepositUsecase)(nil).Deposit), ctx, accountNumber, amount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deposit", reflect.TypeOf((*MockDepositUsecase)(nil).Deposit), ctx, params)
 }
 
 // MockWithdrawUsecase is a mock of WithdrawUsecase interface.
@@ -151,16 +150,774 @@ func (m *MockWithdrawUsecase) EXPECT() *MockWithdrawUsecaseMockRecorder {
 }
 
 // Withdraw mocks base method.
-func (m *MockWithdrawUsecase) Withdraw(ctx context.Context, accountNumber string, amount decimal.Decimal) (*entity.Transaction, error) {
+func (m *MockWithdrawUsecase) Withdraw(ctx context.Context, params *entity.WithdrawParams) (*entity.Withdrawal, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Withdraw", ctx, accountNumber, amount)
-	ret0, _ := ret[0].(*entity.Transaction)
+	ret := m.ctrl.Call(m, "Withdraw", ctx, params)
+	ret0, _ := ret[0].(*entity.Withdrawal)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Withdraw indicates an expected call of Withdraw.
-func (mr *MockWithdrawUsecaseMockRecorder) Withdraw(ctx, accountNumber, amount interface{}) *gomock.Call {
+func (mr *MockWithdrawUsecaseMockRecorder) Withdraw(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Withdraw", reflect.TypeOf((*MockWithdrawUsecase)(nil).Withdraw), ctx, params)
+}
+
+// MockTransferUsecase is a mock of TransferUsecase interface.
+type MockTransferUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferUsecaseMockRecorder
+}
+
+// MockTransferUsecaseMockRecorder is the mock recorder for MockTransferUsecase.
+type MockTransferUsecaseMockRecorder struct {
+	mock *MockTransferUsecase
+}
+
+// NewMockTransferUsecase creates a new mock instance.
+func NewMockTransferUsecase(ctrl *gomock.Controller) *MockTransferUsecase {
+	mock := &MockTransferUsecase{ctrl: ctrl}
+	mock.recorder = &MockTransferUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferUsecase) EXPECT() *MockTransferUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Transfer mocks base method.
+func (m *MockTransferUsecase) Transfer(ctx context.Context, params *entity.TransferParams) (*entity.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer", ctx, params)
+	ret0, _ := ret[0].(*entity.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockTransferUsecaseMockRecorder) Transfer(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockTransferUsecase)(nil).Transfer), ctx, params)
+}
+
+// MockListTransactionsUsecase is a mock of ListTransactionsUsecase interface.
+type MockListTransactionsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListTransactionsUsecaseMockRecorder
+}
+
+// MockListTransactionsUsecaseMockRecorder is the mock recorder for MockListTransactionsUsecase.
+type MockListTransactionsUsecaseMockRecorder struct {
+	mock *MockListTransactionsUsecase
+}
+
+// NewMockListTransactionsUsecase creates a new mock instance.
+func NewMockListTransactionsUsecase(ctrl *gomock.Controller) *MockListTransactionsUsecase {
+	mock := &MockListTransactionsUsecase{ctrl: ctrl}
+	mock.recorder = &MockListTransactionsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListTransactionsUsecase) EXPECT() *MockListTransactionsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ListTransactions mocks base method.
+func (m *MockListTransactionsUsecase) ListTransactions(ctx context.Context, params *entity.ListTransactionsParams) (*entity.TransactionPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransactions", ctx, params)
+	ret0, _ := ret[0].(*entity.TransactionPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransactions indicates an expected call of ListTransactions.
+func (mr *MockListTransactionsUsecaseMockRecorder) ListTransactions(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransactions", reflect.TypeOf((*MockListTransactionsUsecase)(nil).ListTransactions), ctx, params)
+}
+
+// MockGenerateStatementUsecase is a mock of GenerateStatementUsecase interface.
+type MockGenerateStatementUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGenerateStatementUsecaseMockRecorder
+}
+
+// MockGenerateStatementUsecaseMockRecorder is the mock recorder for MockGenerateStatementUsecase.
+type MockGenerateStatementUsecaseMockRecorder struct {
+	mock *MockGenerateStatementUsecase
+}
+
+// NewMockGenerateStatementUsecase creates a new mock instance.
+func NewMockGenerateStatementUsecase(ctrl *gomock.Controller) *MockGenerateStatementUsecase {
+	mock := &MockGenerateStatementUsecase{ctrl: ctrl}
+	mock.recorder = &MockGenerateStatementUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGenerateStatementUsecase) EXPECT() *MockGenerateStatementUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GenerateStatement mocks base method.
+func (m *MockGenerateStatementUsecase) GenerateStatement(ctx context.Context, params *entity.GenerateStatementParams) (*entity.StatementDocument, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateStatement", ctx, params)
+	ret0, _ := ret[0].(*entity.StatementDocument)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateStatement indicates an expected call of GenerateStatement.
+func (mr *MockGenerateStatementUsecaseMockRecorder) GenerateStatement(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateStatement", reflect.TypeOf((*MockGenerateStatementUsecase)(nil).GenerateStatement), ctx, params)
+}
+
+// MockUpdateAccountStatusUsecase is a mock of UpdateAccountStatusUsecase interface.
+type MockUpdateAccountStatusUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateAccountStatusUsecaseMockRecorder
+}
+
+// MockUpdateAccountStatusUsecaseMockRecorder is the mock recorder for MockUpdateAccountStatusUsecase.
+type MockUpdateAccountStatusUsecaseMockRecorder struct {
+	mock *MockUpdateAccountStatusUsecase
+}
+
+// NewMockUpdateAccountStatusUsecase creates a new mock instance.
+func NewMockUpdateAccountStatusUsecase(ctrl *gomock.Controller) *MockUpdateAccountStatusUsecase {
+	mock := &MockUpdateAccountStatusUsecase{ctrl: ctrl}
+	mock.recorder = &MockUpdateAccountStatusUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateAccountStatusUsecase) EXPECT() *MockUpdateAccountStatusUsecaseMockRecorder {
+	return m.recorder
+}
+
+// UpdateAccountStatus mocks base method.
+func (m *MockUpdateAccountStatusUsecase) UpdateAccountStatus(ctx context.Context, params *entity.UpdateAccountStatusParams) (*entity.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccountStatus", ctx, params)
+	ret0, _ := ret[0].(*entity.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccountStatus indicates an expected call of UpdateAccountStatus.
+func (mr *MockUpdateAccountStatusUsecaseMockRecorder) UpdateAccountStatus(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountStatus", reflect.TypeOf((*MockUpdateAccountStatusUsecase)(nil).UpdateAccountStatus), ctx, params)
+}
+
+// MockGetTrialBalanceUsecase is a mock of GetTrialBalanceUsecase interface.
+type MockGetTrialBalanceUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetTrialBalanceUsecaseMockRecorder
+}
+
+// MockGetTrialBalanceUsecaseMockRecorder is the mock recorder for MockGetTrialBalanceUsecase.
+type MockGetTrialBalanceUsecaseMockRecorder struct {
+	mock *MockGetTrialBalanceUsecase
+}
+
+// NewMockGetTrialBalanceUsecase creates a new mock instance.
+func NewMockGetTrialBalanceUsecase(ctrl *gomock.Controller) *MockGetTrialBalanceUsecase {
+	mock := &MockGetTrialBalanceUsecase{ctrl: ctrl}
+	mock.recorder = &MockGetTrialBalanceUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetTrialBalanceUsecase) EXPECT() *MockGetTrialBalanceUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetTrialBalance mocks base method.
+func (m *MockGetTrialBalanceUsecase) GetTrialBalance(ctx context.Context) (*entity.TrialBalance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrialBalance", ctx)
+	ret0, _ := ret[0].(*entity.TrialBalance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrialBalance indicates an expected call of GetTrialBalance.
+func (mr *MockGetTrialBalanceUsecaseMockRecorder) GetTrialBalance(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrialBalance", reflect.TypeOf((*MockGetTrialBalanceUsecase)(nil).GetTrialBalance), ctx)
+}
+
+// MockLoadExchangeRatesUsecase is a mock of LoadExchangeRatesUsecase interface.
+type MockLoadExchangeRatesUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoadExchangeRatesUsecaseMockRecorder
+}
+
+// MockLoadExchangeRatesUsecaseMockRecorder is the mock recorder for MockLoadExchangeRatesUsecase.
+type MockLoadExchangeRatesUsecaseMockRecorder struct {
+	mock *MockLoadExchangeRatesUsecase
+}
+
+// NewMockLoadExchangeRatesUsecase creates a new mock instance.
+func NewMockLoadExchangeRatesUsecase(ctrl *gomock.Controller) *MockLoadExchangeRatesUsecase {
+	mock := &MockLoadExchangeRatesUsecase{ctrl: ctrl}
+	mock.recorder = &MockLoadExchangeRatesUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoadExchangeRatesUsecase) EXPECT() *MockLoadExchangeRatesUsecaseMockRecorder {
+	return m.recorder
+}
+
+// LoadExchangeRates mocks base method.
+func (m *MockLoadExchangeRatesUsecase) LoadExchangeRates(ctx context.Context, exchangeRates []*entity.ExchangeRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoadExchangeRates", ctx, exchangeRates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LoadExchangeRates indicates an expected call of LoadExchangeRates.
+func (mr *MockLoadExchangeRatesUsecaseMockRecorder) LoadExchangeRates(ctx, exchangeRates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadExchangeRates", reflect.TypeOf((*MockLoadExchangeRatesUsecase)(nil).LoadExchangeRates), ctx, exchangeRates)
+}
+
+// MockSetWithdrawalLimitUsecase is a mock of SetWithdrawalLimitUsecase interface.
+type MockSetWithdrawalLimitUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSetWithdrawalLimitUsecaseMockRecorder
+}
+
+// MockSetWithdrawalLimitUsecaseMockRecorder is the mock recorder for MockSetWithdrawalLimitUsecase.
+type MockSetWithdrawalLimitUsecaseMockRecorder struct {
+	mock *MockSetWithdrawalLimitUsecase
+}
+
+// NewMockSetWithdrawalLimitUsecase creates a new mock instance.
+func NewMockSetWithdrawalLimitUsecase(ctrl *gomock.Controller) *MockSetWithdrawalLimitUsecase {
+	mock := &MockSetWithdrawalLimitUsecase{ctrl: ctrl}
+	mock.recorder = &MockSetWithdrawalLimitUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSetWithdrawalLimitUsecase) EXPECT() *MockSetWithdrawalLimitUsecaseMockRecorder {
+	return m.recorder
+}
+
+// SetWithdrawalLimit mocks base method.
+func (m *MockSetWithdrawalLimitUsecase) SetWithdrawalLimit(ctx context.Context, params *entity.SetWithdrawalLimitParams) (*entity.WithdrawalLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWithdrawalLimit", ctx, params)
+	ret0, _ := ret[0].(*entity.WithdrawalLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWithdrawalLimit indicates an expected call of SetWithdrawalLimit.
+func (mr *MockSetWithdrawalLimitUsecaseMockRecorder) SetWithdrawalLimit(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWithdrawalLimit", reflect.TypeOf((*MockSetWithdrawalLimitUsecase)(nil).SetWithdrawalLimit), ctx, params)
+}
+
+// MockRemoveWithdrawalLimitUsecase is a mock of RemoveWithdrawalLimitUsecase interface.
+type MockRemoveWithdrawalLimitUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemoveWithdrawalLimitUsecaseMockRecorder
+}
+
+// MockRemoveWithdrawalLimitUsecaseMockRecorder is the mock recorder for MockRemoveWithdrawalLimitUsecase.
+type MockRemoveWithdrawalLimitUsecaseMockRecorder struct {
+	mock *MockRemoveWithdrawalLimitUsecase
+}
+
+// NewMockRemoveWithdrawalLimitUsecase creates a new mock instance.
+func NewMockRemoveWithdrawalLimitUsecase(ctrl *gomock.Controller) *MockRemoveWithdrawalLimitUsecase {
+	mock := &MockRemoveWithdrawalLimitUsecase{ctrl: ctrl}
+	mock.recorder = &MockRemoveWithdrawalLimitUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemoveWithdrawalLimitUsecase) EXPECT() *MockRemoveWithdrawalLimitUsecaseMockRecorder {
+	return m.recorder
+}
+
+// RemoveWithdrawalLimit mocks base method.
+func (m *MockRemoveWithdrawalLimitUsecase) RemoveWithdrawalLimit(ctx context.Context, accountNumber string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveWithdrawalLimit", ctx, accountNumber)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveWithdrawalLimit indicates an expected call of RemoveWithdrawalLimit.
+func (mr *MockRemoveWithdrawalLimitUsecaseMockRecorder) RemoveWithdrawalLimit(ctx, accountNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWithdrawalLimit", reflect.TypeOf((*MockRemoveWithdrawalLimitUsecase)(nil).RemoveWithdrawalLimit), ctx, accountNumber)
+}
+
+// MockOpenAccountUsecase is a mock of OpenAccountUsecase interface.
+type MockOpenAccountUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockOpenAccountUsecaseMockRecorder
+}
+
+// MockOpenAccountUsecaseMockRecorder is the mock recorder for MockOpenAccountUsecase.
+type MockOpenAccountUsecaseMockRecorder struct {
+	mock *MockOpenAccountUsecase
+}
+
+// NewMockOpenAccountUsecase creates a new mock instance.
+func NewMockOpenAccountUsecase(ctrl *gomock.Controller) *MockOpenAccountUsecase {
+	mock := &MockOpenAccountUsecase{ctrl: ctrl}
+	mock.recorder = &MockOpenAccountUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOpenAccountUsecase) EXPECT() *MockOpenAccountUsecaseMockRecorder {
+	return m.recorder
+}
+
+// OpenAccount mocks base method.
+func (m *MockOpenAccountUsecase) OpenAccount(ctx context.Context, params *entity.OpenAccountParams) (*entity.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenAccount", ctx, params)
+	ret0, _ := ret[0].(*entity.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenAccount indicates an expected call of OpenAccount.
+func (mr *MockOpenAccountUsecaseMockRecorder) OpenAccount(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenAccount", reflect.TypeOf((*MockOpenAccountUsecase)(nil).OpenAccount), ctx, params)
+}
+
+// MockListCustomerAccountsUsecase is a mock of ListCustomerAccountsUsecase interface.
+type MockListCustomerAccountsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockListCustomerAccountsUsecaseMockRecorder
+}
+
+// MockListCustomerAccountsUsecaseMockRecorder is the mock recorder for MockListCustomerAccountsUsecase.
+type MockListCustomerAccountsUsecaseMockRecorder struct {
+	mock *MockListCustomerAccountsUsecase
+}
+
+// NewMockListCustomerAccountsUsecase creates a new mock instance.
+func NewMockListCustomerAccountsUsecase(ctrl *gomock.Controller) *MockListCustomerAccountsUsecase {
+	mock := &MockListCustomerAccountsUsecase{ctrl: ctrl}
+	mock.recorder = &MockListCustomerAccountsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListCustomerAccountsUsecase) EXPECT() *MockListCustomerAccountsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ListCustomerAccounts mocks base method.
+func (m *MockListCustomerAccountsUsecase) ListCustomerAccounts(ctx context.Context, customerID uint) ([]*entity.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomerAccounts", ctx, customerID)
+	ret0, _ := ret[0].([]*entity.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomerAccounts indicates an expected call of ListCustomerAccounts.
+func (mr *MockListCustomerAccountsUsecaseMockRecorder) ListCustomerAccounts(ctx, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomerAccounts", reflect.TypeOf((*MockListCustomerAccountsUsecase)(nil).ListCustomerAccounts), ctx, customerID)
+}
+
+// MockGetCustomerUsecase is a mock of GetCustomerUsecase interface.
+type MockGetCustomerUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetCustomerUsecaseMockRecorder
+}
+
+// MockGetCustomerUsecaseMockRecorder is the mock recorder for MockGetCustomerUsecase.
+type MockGetCustomerUsecaseMockRecorder struct {
+	mock *MockGetCustomerUsecase
+}
+
+// NewMockGetCustomerUsecase creates a new mock instance.
+func NewMockGetCustomerUsecase(ctrl *gomock.Controller) *MockGetCustomerUsecase {
+	mock := &MockGetCustomerUsecase{ctrl: ctrl}
+	mock.recorder = &MockGetCustomerUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetCustomerUsecase) EXPECT() *MockGetCustomerUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetCustomer mocks base method.
+func (m *MockGetCustomerUsecase) GetCustomer(ctx context.Context, customerID uint) (*entity.CustomerProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomer", ctx, customerID)
+	ret0, _ := ret[0].(*entity.CustomerProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomer indicates an expected call of GetCustomer.
+func (mr *MockGetCustomerUsecaseMockRecorder) GetCustomer(ctx, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomer", reflect.TypeOf((*MockGetCustomerUsecase)(nil).GetCustomer), ctx, customerID)
+}
+
+// MockUpdateCustomerUsecase is a mock of UpdateCustomerUsecase interface.
+type MockUpdateCustomerUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateCustomerUsecaseMockRecorder
+}
+
+// MockUpdateCustomerUsecaseMockRecorder is the mock recorder for MockUpdateCustomerUsecase.
+type MockUpdateCustomerUsecaseMockRecorder struct {
+	mock *MockUpdateCustomerUsecase
+}
+
+// NewMockUpdateCustomerUsecase creates a new mock instance.
+func NewMockUpdateCustomerUsecase(ctrl *gomock.Controller) *MockUpdateCustomerUsecase {
+	mock := &MockUpdateCustomerUsecase{ctrl: ctrl}
+	mock.recorder = &MockUpdateCustomerUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateCustomerUsecase) EXPECT() *MockUpdateCustomerUsecaseMockRecorder {
+	return m.recorder
+}
+
+// UpdateCustomer mocks base method.
+func (m *MockUpdateCustomerUsecase) UpdateCustomer(ctx context.Context, params *entity.UpdateCustomerParams) (*entity.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomer", ctx, params)
+	ret0, _ := ret[0].(*entity.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomer indicates an expected call of UpdateCustomer.
+func (mr *MockUpdateCustomerUsecaseMockRecorder) UpdateCustomer(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomer", reflect.TypeOf((*MockUpdateCustomerUsecase)(nil).UpdateCustomer), ctx, params)
+}
+
+// MockSearchCustomersUsecase is a mock of SearchCustomersUsecase interface.
+type MockSearchCustomersUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchCustomersUsecaseMockRecorder
+}
+
+// MockSearchCustomersUsecaseMockRecorder is the mock recorder for MockSearchCustomersUsecase.
+type MockSearchCustomersUsecaseMockRecorder struct {
+	mock *MockSearchCustomersUsecase
+}
+
+// NewMockSearchCustomersUsecase creates a new mock instance.
+func NewMockSearchCustomersUsecase(ctrl *gomock.Controller) *MockSearchCustomersUsecase {
+	mock := &MockSearchCustomersUsecase{ctrl: ctrl}
+	mock.recorder = &MockSearchCustomersUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSearchCustomersUsecase) EXPECT() *MockSearchCustomersUsecaseMockRecorder {
+	return m.recorder
+}
+
+// SearchCustomers mocks base method.
+func (m *MockSearchCustomersUsecase) SearchCustomers(ctx context.Context, params *entity.SearchCustomersParams) ([]*entity.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchCustomers", ctx, params)
+	ret0, _ := ret[0].([]*entity.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchCustomers indicates an expected call of SearchCustomers.
+func (mr *MockSearchCustomersUsecaseMockRecorder) SearchCustomers(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchCustomers", reflect.TypeOf((*MockSearchCustomersUsecase)(nil).SearchCustomers), ctx, params)
+}
+
+// MockAddCustomerIdentityUsecase is a mock of AddCustomerIdentityUsecase interface.
+type MockAddCustomerIdentityUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddCustomerIdentityUsecaseMockRecorder
+}
+
+// MockAddCustomerIdentityUsecaseMockRecorder is the mock recorder for MockAddCustomerIdentityUsecase.
+type MockAddCustomerIdentityUsecaseMockRecorder struct {
+	mock *MockAddCustomerIdentityUsecase
+}
+
+// NewMockAddCustomerIdentityUsecase creates a new mock instance.
+func NewMockAddCustomerIdentityUsecase(ctrl *gomock.Controller) *MockAddCustomerIdentityUsecase {
+	mock := &MockAddCustomerIdentityUsecase{ctrl: ctrl}
+	mock.recorder = &MockAddCustomerIdentityUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAddCustomerIdentityUsecase) EXPECT() *MockAddCustomerIdentityUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddCustomerIdentity mocks base method.
+func (m *MockAddCustomerIdentityUsecase) AddCustomerIdentity(ctx context.Context, params *entity.AddCustomerIdentityParams) (*entity.CustomerIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCustomerIdentity", ctx, params)
+	ret0, _ := ret[0].(*entity.CustomerIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCustomerIdentity indicates an expected call of AddCustomerIdentity.
+func (mr *MockAddCustomerIdentityUsecaseMockRecorder) AddCustomerIdentity(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCustomerIdentity", reflect.TypeOf((*MockAddCustomerIdentityUsecase)(nil).AddCustomerIdentity), ctx, params)
+}
+
+// MockOpenTimeDepositUsecase is a mock of OpenTimeDepositUsecase interface.
+type MockOpenTimeDepositUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockOpenTimeDepositUsecaseMockRecorder
+}
+
+// MockOpenTimeDepositUsecaseMockRecorder is the mock recorder for MockOpenTimeDepositUsecase.
+type MockOpenTimeDepositUsecaseMockRecorder struct {
+	mock *MockOpenTimeDepositUsecase
+}
+
+// NewMockOpenTimeDepositUsecase creates a new mock instance.
+func NewMockOpenTimeDepositUsecase(ctrl *gomock.Controller) *MockOpenTimeDepositUsecase {
+	mock := &MockOpenTimeDepositUsecase{ctrl: ctrl}
+	mock.recorder = &MockOpenTimeDepositUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOpenTimeDepositUsecase) EXPECT() *MockOpenTimeDepositUsecaseMockRecorder {
+	return m.recorder
+}
+
+// OpenTimeDeposit mocks base method.
+func (m *MockOpenTimeDepositUsecase) OpenTimeDeposit(ctx context.Context, params *entity.OpenTimeDepositParams) (*entity.TimeDepositAccount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenTimeDeposit", ctx, params)
+	ret0, _ := ret[0].(*entity.TimeDepositAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenTimeDeposit indicates an expected call of OpenTimeDeposit.
+func (mr *MockOpenTimeDepositUsecaseMockRecorder) OpenTimeDeposit(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenTimeDeposit", reflect.TypeOf((*MockOpenTimeDepositUsecase)(nil).OpenTimeDeposit), ctx, params)
+}
+
+// MockQuoteFeeUsecase is a mock of QuoteFeeUsecase interface.
+type MockQuoteFeeUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuoteFeeUsecaseMockRecorder
+}
+
+// MockQuoteFeeUsecaseMockRecorder is the mock recorder for MockQuoteFeeUsecase.
+type MockQuoteFeeUsecaseMockRecorder struct {
+	mock *MockQuoteFeeUsecase
+}
+
+// NewMockQuoteFeeUsecase creates a new mock instance.
+func NewMockQuoteFeeUsecase(ctrl *gomock.Controller) *MockQuoteFeeUsecase {
+	mock := &MockQuoteFeeUsecase{ctrl: ctrl}
+	mock.recorder = &MockQuoteFeeUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuoteFeeUsecase) EXPECT() *MockQuoteFeeUsecaseMockRecorder {
+	return m.recorder
+}
+
+// QuoteFee mocks base method.
+func (m *MockQuoteFeeUsecase) QuoteFee(ctx context.Context, params *entity.FeeQuoteParams) (*entity.FeeQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteFee", ctx, params)
+	ret0, _ := ret[0].(*entity.FeeQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteFee indicates an expected call of QuoteFee.
+func (mr *MockQuoteFeeUsecaseMockRecorder) QuoteFee(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteFee", reflect.TypeOf((*MockQuoteFeeUsecase)(nil).QuoteFee), ctx, params)
+}
+
+// MockPlaceHoldUsecase is a mock of PlaceHoldUsecase interface.
+type MockPlaceHoldUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlaceHoldUsecaseMockRecorder
+}
+
+// MockPlaceHoldUsecaseMockRecorder is the mock recorder for MockPlaceHoldUsecase.
+type MockPlaceHoldUsecaseMockRecorder struct {
+	mock *MockPlaceHoldUsecase
+}
+
+// NewMockPlaceHoldUsecase creates a new mock instance.
+func NewMockPlaceHoldUsecase(ctrl *gomock.Controller) *MockPlaceHoldUsecase {
+	mock := &MockPlaceHoldUsecase{ctrl: ctrl}
+	mock.recorder = &MockPlaceHoldUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlaceHoldUsecase) EXPECT() *MockPlaceHoldUsecaseMockRecorder {
+	return m.recorder
+}
+
+// PlaceHold mocks base method.
+func (m *MockPlaceHoldUsecase) PlaceHold(ctx context.Context, params *entity.PlaceHoldParams) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlaceHold", ctx, params)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PlaceHold indicates an expected call of PlaceHold.
+func (mr *MockPlaceHoldUsecaseMockRecorder) PlaceHold(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlaceHold", reflect.TypeOf((*MockPlaceHoldUsecase)(nil).PlaceHold), ctx, params)
+}
+
+// MockCaptureHoldUsecase is a mock of CaptureHoldUsecase interface.
+type MockCaptureHoldUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCaptureHoldUsecaseMockRecorder
+}
+
+// MockCaptureHoldUsecaseMockRecorder is the mock recorder for MockCaptureHoldUsecase.
+type MockCaptureHoldUsecaseMockRecorder struct {
+	mock *MockCaptureHoldUsecase
+}
+
+// NewMockCaptureHoldUsecase creates a new mock instance.
+func NewMockCaptureHoldUsecase(ctrl *gomock.Controller) *MockCaptureHoldUsecase {
+	mock := &MockCaptureHoldUsecase{ctrl: ctrl}
+	mock.recorder = &MockCaptureHoldUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCaptureHoldUsecase) EXPECT() *MockCaptureHoldUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CaptureHold mocks base method.
+func (m *MockCaptureHoldUsecase) CaptureHold(ctx context.Context, params *entity.CaptureHoldParams) (*entity.HoldCapture, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHold", ctx, params)
+	ret0, _ := ret[0].(*entity.HoldCapture)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureHold indicates an expected call of CaptureHold.
+func (mr *MockCaptureHoldUsecaseMockRecorder) CaptureHold(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHold", reflect.TypeOf((*MockCaptureHoldUsecase)(nil).CaptureHold), ctx, params)
+}
+
+// MockReleaseHoldUsecase is a mock of ReleaseHoldUsecase interface.
+type MockReleaseHoldUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReleaseHoldUsecaseMockRecorder
+}
+
+// MockReleaseHoldUsecaseMockRecorder is the mock recorder for MockReleaseHoldUsecase.
+type MockReleaseHoldUsecaseMockRecorder struct {
+	mock *MockReleaseHoldUsecase
+}
+
+// NewMockReleaseHoldUsecase creates a new mock instance.
+func NewMockReleaseHoldUsecase(ctrl *gomock.Controller) *MockReleaseHoldUsecase {
+	mock := &MockReleaseHoldUsecase{ctrl: ctrl}
+	mock.recorder = &MockReleaseHoldUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReleaseHoldUsecase) EXPECT() *MockReleaseHoldUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ReleaseHold mocks base method.
+func (m *MockReleaseHoldUsecase) ReleaseHold(ctx context.Context, params *entity.ReleaseHoldParams) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseHold", ctx, params)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseHold indicates an expected call of ReleaseHold.
+func (mr *MockReleaseHoldUsecaseMockRecorder) ReleaseHold(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHold", reflect.TypeOf((*MockReleaseHoldUsecase)(nil).ReleaseHold), ctx, params)
+}
+
+// MockReverseTransactionUsecase is a mock of ReverseTransactionUsecase interface.
+type MockReverseTransactionUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReverseTransactionUsecaseMockRecorder
+}
+
+// MockReverseTransactionUsecaseMockRecorder is the mock recorder for MockReverseTransactionUsecase.
+type MockReverseTransactionUsecaseMockRecorder struct {
+	mock *MockReverseTransactionUsecase
+}
+
+// NewMockReverseTransactionUsecase creates a new mock instance.
+func NewMockReverseTransactionUsecase(ctrl *gomock.Controller) *MockReverseTransactionUsecase {
+	mock := &MockReverseTransactionUsecase{ctrl: ctrl}
+	mock.recorder = &MockReverseTransactionUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReverseTransactionUsecase) EXPECT() *MockReverseTransactionUsecaseMockRecorder {
+	return m.recorder
+}
+
+// ReverseTransaction mocks base method.
+func (m *MockReverseTransactionUsecase) ReverseTransaction(ctx context.Context, params *entity.ReverseTransactionParams) (*entity.Reversal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReverseTransaction", ctx, params)
+	ret0, _ := ret[0].(*entity.Reversal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReverseTransaction indicates an expected call of ReverseTransaction.
+func (mr *MockReverseTransactionUsecaseMockRecorder) ReverseTransaction(ctx, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Withdraw", reflect.TypeOf((*MockWithdrawUsecase)(nil).Withdraw), ctx, accountNumber, amount)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReverseTransaction", reflect.TypeOf((*MockReverseTransactionUsecase)(nil).ReverseTransaction), ctx, params)
 }
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"time"
+
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// GetStatementRequest is the request for downloading the statement (rekening koran) of an account for a period
+type GetStatementRequest struct {
+	AccountNumber string `param:"account_number" validate:"required,account_number"`
+	From          string `query:"from" validate:"required,datetime=2006-01-02"`
+	To            string `query:"to" validate:"required,datetime=2006-01-02"`
+	Format        string `query:"format" validate:"omitempty,oneof=csv pdf"`
+}
+
+// ToParams converts the request into the parameters of the use case
+// The end date is inclusive, so the whole day of the end date is included
+func (g GetStatementRequest) ToParams() *entity.GenerateStatementParams {
+	params := &entity.GenerateStatementParams{
+		AccountNumber: g.AccountNumber,
+		Format:        entity.StatementFormat(g.Format),
+	}
+
+	if params.Format == "" {
+		params.Format = entity.DefaultStatementFormat
+	}
+
+	// The dates are already validated, so parsing errors can be ignored
+	params.From, _ = time.Parse(DateLayout, g.From)
+	to, _ := time.Parse(DateLayout, g.To)
+	params.To = to.AddDate(0, 0, 1)
+
+	return params
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// maturityInstructionNames maps the maturity instructions to their names in the API
+var maturityInstructionNames = map[entity.MaturityInstruction]string{
+	entity.MaturityInstructionPayOut:                       "TANPA_ARO",
+	entity.MaturityInstructionRolloverPrincipal:            "ARO_POKOK",
+	entity.MaturityInstructionRolloverPrincipalAndInterest: "ARO_POKOK_BUNGA",
+}
+
+// OpenTimeDepositRequest is the request body for opening a time deposit with the funds of a saving account
+type OpenTimeDepositRequest struct {
+	FundingAccountNumber string          `json:"no_rekening_sumber" validate:"required,account_number"`
+	Principal            decimal.Decimal `json:"nominal" validate:"required,gt=0"`
+	Currency             string          `json:"mata_uang" validate:"omitempty,iso4217"`
+	TermMonths           int             `json:"jangka_waktu" validate:"required,oneof=1 3 6 12 24"`
+	MaturityInstruction  string          `json:"instruksi_jatuh_tempo" validate:"omitempty,oneof=TANPA_ARO ARO_POKOK ARO_POKOK_BUNGA"`
+	IdempotencyKey       string          `json:"-" header:"Idempotency-Key" validate:"omitempty,max=64"`
+}
+
+// GetCurrency returns the currency of the principal, IDR when not specified
+func (o OpenTimeDepositRequest) GetCurrency() entity.Currency {
+	return getCurrency(o.Currency)
+}
+
+// GetMaturityInstruction converts the instruction name into the maturity instruction,
+// the deposit is paid out at maturity when not specified
+func (o OpenTimeDepositRequest) GetMaturityInstruction() entity.MaturityInstruction {
+	for instruction, name := range maturityInstructionNames {
+		if name == o.MaturityInstruction {
+			return instruction
+		}
+	}
+
+	return entity.MaturityInstructionPayOut
+}
+
+// OpenTimeDepositResponse is the response body for opening a time deposit
+type OpenTimeDepositResponse struct {
+	AccountNumber        string          `json:"no_rekening"`
+	FundingAccountNumber string          `json:"no_rekening_sumber"`
+	Principal            decimal.Decimal `json:"nominal"`
+	Currency             entity.Currency `json:"mata_uang"`
+	TermMonths           int             `json:"jangka_waktu"`
+	InterestRate         decimal.Decimal `json:"suku_bunga"`
+	MaturityInstruction  string          `json:"instruksi_jatuh_tempo"`
+	StartDate            string          `json:"tanggal_mulai"`
+	MaturityDate         string          `json:"tanggal_jatuh_tempo"`
+	FundingBalance       decimal.Decimal `json:"saldo_rekening_sumber"`
+}
+
+// NewOpenTimeDepositResponse converts an opened time deposit into the response body
+func NewOpenTimeDepositResponse(timeDepositAccount *entity.TimeDepositAccount) *OpenTimeDepositResponse {
+	timeDeposit := timeDepositAccount.TimeDeposit
+
+	return &OpenTimeDepositResponse{
+		AccountNumber:        timeDepositAccount.Account.AccountNumber,
+		FundingAccountNumber: timeDepositAccount.FundingAccount.AccountNumber,
+		Principal:            timeDeposit.Principal,
+		Currency:             timeDepositAccount.Account.Currency,
+		TermMonths:           timeDeposit.TermMonths,
+		InterestRate:         timeDeposit.InterestRate,
+		MaturityInstruction:  maturityInstructionNames[timeDeposit.MaturityInstruction],
+		StartDate:            formatDate(timeDeposit.StartDate),
+		MaturityDate:         formatDate(timeDeposit.MaturityDate),
+		FundingBalance:       timeDepositAccount.FundingAccount.Balance,
+	}
+}
+
+// formatDate formats a date without its time e.g. 2025-05-31
+func formatDate(date time.Time) string {
+	return date.Format(DateLayout)
+}
@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+type timeDepositHandler struct {
+	openTimeDepositUsecase OpenTimeDepositUsecase
+}
+
+func NewTimeDepositHandler(
+	openTimeDepositUsecase OpenTimeDepositUsecase,
+) *timeDepositHandler {
+	return &timeDepositHandler{
+		openTimeDepositUsecase: openTimeDepositUsecase,
+	}
+}
+
+func (h timeDepositHandler) OpenTimeDeposit(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+		req = new(OpenTimeDepositRequest)
+	)
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	req.IdempotencyKey = c.Request().Header.Get(HeaderIdempotencyKey)
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	params := &entity.OpenTimeDepositParams{
+		FundingAccountNumber: req.FundingAccountNumber,
+		Principal:            req.Principal,
+		Currency:             req.GetCurrency(),
+		TermMonths:           req.TermMonths,
+		MaturityInstruction:  req.GetMaturityInstruction(),
+		IdempotencyKey:       req.IdempotencyKey,
+	}
+
+	timeDepositAccount, err := h.openTimeDepositUsecase.OpenTimeDeposit(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	return c.JSON(http.StatusOK, NewOpenTimeDepositResponse(timeDepositAccount))
+}
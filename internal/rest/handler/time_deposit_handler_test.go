@@ -0,0 +1,130 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/internal/rest/handler"
+	usecasemock "imansohibul.my.id/account-domain-service/internal/rest/handler/mock"
+	"imansohibul.my.id/account-domain-service/internal/rest/server"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestOpenTimeDeposit(t *testing.T) {
+	tests := []struct {
+		name               string
+		requestBody        interface{}
+		mockSetup          func(*testing.T, *usecasemock.MockOpenTimeDepositUsecase)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name: "Open Time Deposit - Success",
+			requestBody: map[string]interface{}{
+				"no_rekening_sumber":    "1234567897",
+				"nominal":               10000000,
+				"jangka_waktu":          3,
+				"suku_bunga":            20, // ignored, the rate is resolved by the service
+				"instruksi_jatuh_tempo": "ARO_POKOK",
+			},
+			mockSetup: func(t *testing.T, openTimeDepositUsecase *usecasemock.MockOpenTimeDepositUsecase) {
+				openTimeDepositUsecase.EXPECT().
+					OpenTimeDeposit(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, params *entity.OpenTimeDepositParams) (*entity.TimeDepositAccount, error) {
+						assert.Equal(t, "1234567897", params.FundingAccountNumber)
+						assert.True(t, decimal.NewFromInt(10000000).Equal(params.Principal))
+						assert.Equal(t, entity.CurrencyIDR, params.Currency)
+						assert.Equal(t, 3, params.TermMonths)
+						assert.Equal(t, entity.MaturityInstructionRolloverPrincipal, params.MaturityInstruction)
+
+						return &entity.TimeDepositAccount{
+							Account:        &entity.Account{AccountNumber: "9876543217", AccountType: entity.AccountTypeTimeDeposit, Currency: entity.CurrencyIDR},
+							FundingAccount: &entity.Account{AccountNumber: "1234567897", Balance: decimal.NewFromInt(5000000), Currency: entity.CurrencyIDR},
+							TimeDeposit: &entity.TimeDeposit{
+								Principal:           params.Principal,
+								InterestRate:        decimal.RequireFromString("3.25"),
+								TermMonths:          params.TermMonths,
+								MaturityInstruction: params.MaturityInstruction,
+								StartDate:           time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC),
+								MaturityDate:        time.Date(2025, time.April, 30, 0, 0, 0, 0, time.UTC),
+							},
+						}, nil
+					})
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"no_rekening":"9876543217","no_rekening_sumber":"1234567897","nominal":"10000000","mata_uang":"IDR","jangka_waktu":3,"suku_bunga":"3.25","instruksi_jatuh_tempo":"ARO_POKOK","tanggal_mulai":"2025-01-31","tanggal_jatuh_tempo":"2025-04-30","saldo_rekening_sumber":"5000000"}`,
+		},
+		{
+			name:               "Open Time Deposit - Unsupported Term",
+			requestBody:        map[string]interface{}{"no_rekening_sumber": "1234567897", "nominal": 10000000, "jangka_waktu": 2},
+			mockSetup:          func(t *testing.T, openTimeDepositUsecase *usecasemock.MockOpenTimeDepositUsecase) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"field":"jangka_waktu","rule":"oneof"`,
+		},
+		{
+			name:               "Open Time Deposit - Invalid Maturity Instruction",
+			requestBody:        map[string]interface{}{"no_rekening_sumber": "1234567897", "nominal": 10000000, "jangka_waktu": 12, "instruksi_jatuh_tempo": "ARO"},
+			mockSetup:          func(t *testing.T, openTimeDepositUsecase *usecasemock.MockOpenTimeDepositUsecase) {},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       `"field":"instruksi_jatuh_tempo","rule":"oneof"`,
+		},
+		{
+			name:        "Open Time Deposit - Funding Account Is Not A Saving Account",
+			requestBody: map[string]interface{}{"no_rekening_sumber": "1234567897", "nominal": 10000000, "jangka_waktu": 1},
+			mockSetup: func(t *testing.T, openTimeDepositUsecase *usecasemock.MockOpenTimeDepositUsecase) {
+				openTimeDepositUsecase.EXPECT().
+					OpenTimeDeposit(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrInvalidTimeDepositFundingAccount)
+			},
+			expectedStatusCode: http.StatusUnprocessableEntity,
+			expectedBody:       entity.ErrInvalidTimeDepositFundingAccount.Code,
+		},
+		{
+			name:        "Open Time Deposit - No Rate For The Term",
+			requestBody: map[string]interface{}{"no_rekening_sumber": "1234567897", "nominal": 1000, "mata_uang": "USD", "jangka_waktu": 24},
+			mockSetup: func(t *testing.T, openTimeDepositUsecase *usecasemock.MockOpenTimeDepositUsecase) {
+				openTimeDepositUsecase.EXPECT().
+					OpenTimeDeposit(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrTimeDepositRateNotFound)
+			},
+			expectedStatusCode: http.StatusUnprocessableEntity,
+			expectedBody:       entity.ErrTimeDepositRateNotFound.Code,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := echo.New()
+			e.Validator = server.NewCommonValidator(util.GetValidator())
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
+
+			bodyBytes, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest(http.MethodPost, "/deposito", bytes.NewReader(bodyBytes))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+
+			mockOpenTimeDepositUsecase := usecasemock.NewMockOpenTimeDepositUsecase(ctrl)
+			tt.mockSetup(t, mockOpenTimeDepositUsecase)
+
+			handler := handler.NewTimeDepositHandler(mockOpenTimeDepositUsecase)
+
+			c := e.NewContext(req, rec)
+			if err := handler.OpenTimeDeposit(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
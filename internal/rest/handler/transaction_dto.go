@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// DateLayout is the layout of the date query parameters (e.g. 2025-05-31)
+const DateLayout = "2006-01-02"
+
+// transactionTypeNames maps the transaction types to their names in the API
+var transactionTypeNames = map[entity.TransactionType]string{
+	entity.TransactionTypeCredit:         "kredit",
+	entity.TransactionTypeDebit:          "debit",
+	entity.TransactionTypeInterest:       "bunga",
+	entity.TransactionTypeWithholdingTax: "pajak",
+	entity.TransactionTypeFee:            "biaya",
+	entity.TransactionTypeReversalDebit:  "koreksi_debit",
+	entity.TransactionTypeReversalCredit: "koreksi_kredit",
+}
+
+// ListTransactionsRequest is the request for listing the transaction history (mutasi) of an account
+type ListTransactionsRequest struct {
+	AccountNumber string `param:"account_number" validate:"required,account_number"`
+	Type          string `query:"jenis" validate:"omitempty,oneof=kredit debit bunga pajak biaya koreksi_debit koreksi_kredit"`
+	StartDate     string `query:"dari" validate:"omitempty,datetime=2006-01-02"`
+	EndDate       string `query:"sampai" validate:"omitempty,datetime=2006-01-02"`
+	MinAmount     int64  `query:"nominal_min" validate:"omitempty,gt=0"`
+	MaxAmount     int64  `query:"nominal_max" validate:"omitempty,gt=0,gtefield=MinAmount"`
+	Cursor        string `query:"cursor"`
+	Limit         int    `query:"limit" validate:"omitempty,gt=0,lte=100"`
+}
+
+// ToParams converts the request into the parameters of the use case
+// The end date is inclusive, so the whole day of the end date is included
+func (l ListTransactionsRequest) ToParams() *entity.ListTransactionsParams {
+	params := &entity.ListTransactionsParams{
+		AccountNumber: l.AccountNumber,
+		MinAmount:     decimal.NewFromInt(l.MinAmount),
+		MaxAmount:     decimal.NewFromInt(l.MaxAmount),
+		Cursor:        l.Cursor,
+		Limit:         l.Limit,
+	}
+
+	for transactionType, name := range transactionTypeNames {
+		if name == l.Type {
+			params.Type = transactionType
+		}
+	}
+
+	// The dates are already validated, so parsing errors can be ignored
+	if l.StartDate != "" {
+		params.StartTime, _ = time.Parse(DateLayout, l.StartDate)
+	}
+
+	if l.EndDate != "" {
+		endDate, _ := time.Parse(DateLayout, l.EndDate)
+		params.EndTime = endDate.AddDate(0, 0, 1)
+	}
+
+	return params
+}
+
+// TransactionResponse represents a single transaction in the response body
+type TransactionResponse struct {
+	ID             uint                  `json:"id"`
+	Type           string                `json:"jenis"`
+	Amount         decimal.Decimal       `json:"nominal"`
+	InitialBalance decimal.Decimal       `json:"saldo_awal"`
+	FinalBalance   decimal.Decimal       `json:"saldo_akhir"`
+	Currency       entity.Currency       `json:"mata_uang"`
+	Conversion     *ConversionResponse   `json:"konversi,omitempty"`
+	Channel        string                `json:"kanal,omitempty"`
+	Reference      string                `json:"referensi,omitempty"`
+	Description    string                `json:"keterangan,omitempty"`
+	Counterparty   *CounterpartyResponse `json:"pihak_lawan,omitempty"`
+	CreatedAt      time.Time             `json:"waktu"`
+}
+
+// CounterpartyResponse represents the other party of a transaction
+type CounterpartyResponse struct {
+	Name          string `json:"nama"`
+	AccountNumber string `json:"no_rekening,omitempty"`
+}
+
+// ConversionResponse represents the exchange applied to a cross-currency transfer
+type ConversionResponse struct {
+	Rate           decimal.Decimal `json:"kurs"`
+	SourceAmount   decimal.Decimal `json:"nominal_asal"`
+	SourceCurrency entity.Currency `json:"mata_uang_asal"`
+	TargetAmount   decimal.Decimal `json:"nominal_tujuan"`
+	TargetCurrency entity.Currency `json:"mata_uang_tujuan"`
+}
+
+// NewTransactionResponse converts a transaction entity into its response body
+func NewTransactionResponse(transaction *entity.Transaction) TransactionResponse {
+	response := TransactionResponse{
+		ID:             transaction.ID,
+		Type:           transactionTypeNames[transaction.Type],
+		Amount:         transaction.Amount,
+		InitialBalance: transaction.InitialBalance,
+		FinalBalance:   transaction.FinalBalance,
+		Currency:       transaction.Currency,
+		Channel:        channelNames[transaction.Channel],
+		Reference:      transaction.Reference,
+		Description:    transaction.Description,
+		CreatedAt:      transaction.CreatedAt,
+	}
+
+	if counterparty := transaction.Counterparty; counterparty != nil {
+		response.Counterparty = &CounterpartyResponse{
+			Name:          counterparty.Name,
+			AccountNumber: counterparty.AccountNumber,
+		}
+	}
+
+	if conversion := transaction.Conversion; conversion != nil {
+		response.Conversion = &ConversionResponse{
+			Rate:           conversion.Rate,
+			SourceAmount:   conversion.SourceAmount,
+			SourceCurrency: conversion.SourceCurrency,
+			TargetAmount:   conversion.TargetAmount,
+			TargetCurrency: conversion.TargetCurrency,
+		}
+	}
+
+	return response
+}
+
+// ListTransactionsResponse is the response body for listing the transaction history (mutasi) of an account
+type ListTransactionsResponse struct {
+	Transactions []TransactionResponse `json:"mutasi"`
+	NextCursor   string                `json:"next_cursor,omitempty"`
+}
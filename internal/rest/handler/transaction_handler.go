@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+type transactionHandler struct {
+	listTransactionsUsecase  ListTransactionsUsecase
+	generateStatementUsecase GenerateStatementUsecase
+}
+
+func NewTransactionHandler(
+	listTransactionsUsecase ListTransactionsUsecase,
+	generateStatementUsecase GenerateStatementUsecase,
+) *transactionHandler {
+	return &transactionHandler{
+		listTransactionsUsecase:  listTransactionsUsecase,
+		generateStatementUsecase: generateStatementUsecase,
+	}
+}
+
+func (t transactionHandler) ListTransactions(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+		req = new(ListTransactionsRequest)
+	)
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	page, err := t.listTransactionsUsecase.ListTransactions(ctx, req.ToParams())
+	if err != nil {
+		return err
+	}
+
+	resp := &ListTransactionsResponse{
+		Transactions: make([]TransactionResponse, 0, len(page.Transactions)),
+		NextCursor:   page.NextCursor,
+	}
+
+	for _, transaction := range page.Transactions {
+		resp.Transactions = append(resp.Transactions, NewTransactionResponse(transaction))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetStatement downloads the statement of an account for a period as a CSV or a PDF file
+func (t transactionHandler) GetStatement(c echo.Context) error {
+	var (
+		ctx = c.Request().Context()
+		req = new(GetStatementRequest)
+	)
+
+	if err := c.Bind(req); err != nil {
+		return entity.ErrInvalidRequest
+	}
+
+	if err := c.Validate(req); err != nil {
+		return err
+	}
+
+	document, err := t.generateStatementUsecase.GenerateStatement(ctx, req.ToParams())
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", document.Filename()))
+	return c.Blob(http.StatusOK, document.Format.ContentType(), document.Content)
+}
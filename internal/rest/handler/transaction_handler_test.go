@@ -0,0 +1,240 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/internal/rest/handler"
+	usecasemock "imansohibul.my.id/account-domain-service/internal/rest/handler/mock"
+	"imansohibul.my.id/account-domain-service/internal/rest/server"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestListTransactions(t *testing.T) {
+	tests := []struct {
+		name               string
+		query              string
+		mockSetup          func(*testing.T, *usecasemock.MockListTransactionsUsecase)
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name:  "List Transactions - Success",
+			query: "?jenis=kredit&dari=2025-05-01&sampai=2025-05-31&limit=1",
+			mockSetup: func(t *testing.T, listTransactionsUsecase *usecasemock.MockListTransactionsUsecase) {
+				listTransactionsUsecase.EXPECT().
+					ListTransactions(gomock.Any(), &entity.ListTransactionsParams{
+						AccountNumber: "1234567897",
+						Type:          entity.TransactionTypeCredit,
+						StartTime:     time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+						EndTime:       time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+						MinAmount:     decimal.NewFromInt(0),
+						MaxAmount:     decimal.NewFromInt(0),
+						Limit:         1,
+					}).
+					Return(&entity.TransactionPage{
+						Transactions: []*entity.Transaction{
+							{ID: 7, Type: entity.TransactionTypeCredit, Amount: decimal.NewFromInt(50000)},
+						},
+						NextCursor: "next-page",
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"next_cursor":"next-page"`,
+		},
+		{
+			name:  "List Transactions - Interest",
+			query: "?jenis=bunga",
+			mockSetup: func(t *testing.T, listTransactionsUsecase *usecasemock.MockListTransactionsUsecase) {
+				listTransactionsUsecase.EXPECT().
+					ListTransactions(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, params *entity.ListTransactionsParams) (*entity.TransactionPage, error) {
+						assert.Equal(t, entity.TransactionTypeInterest, params.Type)
+
+						return &entity.TransactionPage{
+							Transactions: []*entity.Transaction{
+								{ID: 8, Type: entity.TransactionTypeInterest, Amount: decimal.RequireFromString("3082.19")},
+								{ID: 9, Type: entity.TransactionTypeWithholdingTax, Amount: decimal.RequireFromString("616.43")},
+							},
+						}, nil
+					})
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `"jenis":"pajak"`,
+		},
+		{
+			name:  "List Transactions - Account Not Found",
+			query: "",
+			mockSetup: func(t *testing.T, listTransactionsUsecase *usecasemock.MockListTransactionsUsecase) {
+				listTransactionsUsecase.EXPECT().
+					ListTransactions(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrAccountNotFound)
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       entity.ErrAccountNotFound.Message,
+		},
+		{
+			name:  "List Transactions - Invalid Query",
+			query: "?limit=abc",
+			mockSetup: func(t *testing.T, listTransactionsUsecase *usecasemock.MockListTransactionsUsecase) {
+				// No need to mock since it's an error test case
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       "Permintaan tidak valid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := echo.New()
+			e.Validator = server.NewCommonValidator(util.GetValidator())
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/mutasi/1234567897"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			mockListTransactionsUsecase := usecasemock.NewMockListTransactionsUsecase(ctrl)
+			tt.mockSetup(t, mockListTransactionsUsecase)
+
+			handler := handler.NewTransactionHandler(mockListTransactionsUsecase, usecasemock.NewMockGenerateStatementUsecase(ctrl))
+
+			c := e.NewContext(req, rec)
+			c.SetParamNames("account_number")
+			c.SetParamValues("1234567897")
+
+			if err := handler.ListTransactions(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
+func TestGetStatement(t *testing.T) {
+	account := &entity.Account{AccountNumber: "1234567897", Currency: entity.CurrencyIDR}
+	statement := entity.NewStatement(
+		account,
+		"Budi",
+		time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+		decimal.NewFromInt(100000),
+		nil,
+	)
+
+	tests := []struct {
+		name               string
+		query              string
+		mockSetup          func(*usecasemock.MockGenerateStatementUsecase)
+		expectedStatusCode int
+		expectedHeader     string
+		expectedBody       string
+	}{
+		{
+			name:  "Get Statement - Success",
+			query: "?from=2025-05-01&to=2025-05-31&format=csv",
+			mockSetup: func(generateStatementUsecase *usecasemock.MockGenerateStatementUsecase) {
+				generateStatementUsecase.EXPECT().
+					GenerateStatement(gomock.Any(), &entity.GenerateStatementParams{
+						AccountNumber: "1234567897",
+						From:          time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC),
+						To:            time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+						Format:        entity.StatementFormatCSV,
+					}).
+					Return(&entity.StatementDocument{
+						Statement: statement,
+						Format:    entity.StatementFormatCSV,
+						Content:   []byte("Nomor Rekening,1234567897\n"),
+					}, nil)
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedHeader:     `attachment; filename="rekening-koran-1234567897-20250501-20250531.csv"`,
+			expectedBody:       "Nomor Rekening,1234567897",
+		},
+		{
+			name:  "Get Statement - Default Format",
+			query: "?from=2025-05-01&to=2025-05-31",
+			mockSetup: func(generateStatementUsecase *usecasemock.MockGenerateStatementUsecase) {
+				generateStatementUsecase.EXPECT().
+					GenerateStatement(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ interface{}, params *entity.GenerateStatementParams) (*entity.StatementDocument, error) {
+						assert.Equal(t, entity.StatementFormatPDF, params.Format)
+
+						return &entity.StatementDocument{
+							Statement: statement,
+							Format:    entity.StatementFormatPDF,
+							Content:   []byte("%PDF-1.4"),
+						}, nil
+					})
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedHeader:     `attachment; filename="rekening-koran-1234567897-20250501-20250531.pdf"`,
+			expectedBody:       "%PDF-1.4",
+		},
+		{
+			name:  "Get Statement - Invalid Period",
+			query: "?from=2025-05-01&to=2026-12-31",
+			mockSetup: func(generateStatementUsecase *usecasemock.MockGenerateStatementUsecase) {
+				generateStatementUsecase.EXPECT().
+					GenerateStatement(gomock.Any(), gomock.Any()).
+					Return(nil, entity.ErrInvalidStatementPeriod)
+			},
+			expectedStatusCode: http.StatusBadRequest,
+			expectedBody:       entity.ErrInvalidStatementPeriod.Message,
+		},
+		{
+			name:  "Get Statement - Missing Period",
+			query: "?format=csv",
+			mockSetup: func(generateStatementUsecase *usecasemock.MockGenerateStatementUsecase) {
+				// No need to mock since it's an error test case
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:  "Get Statement - Unsupported Format",
+			query: "?from=2025-05-01&to=2025-05-31&format=xlsx",
+			mockSetup: func(generateStatementUsecase *usecasemock.MockGenerateStatementUsecase) {
+				// No need to mock since it's an error test case
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			e := echo.New()
+			e.Validator = server.NewCommonValidator(util.GetValidator())
+			e.HTTPErrorHandler = server.NewHTTPErrorHandler(util.GetZapLogger())
+
+			req := httptest.NewRequest(http.MethodGet, "/rekening/1234567897/statement"+tt.query, nil)
+			rec := httptest.NewRecorder()
+
+			mockGenerateStatementUsecase := usecasemock.NewMockGenerateStatementUsecase(ctrl)
+			tt.mockSetup(mockGenerateStatementUsecase)
+
+			handler := handler.NewTransactionHandler(usecasemock.NewMockListTransactionsUsecase(ctrl), mockGenerateStatementUsecase)
+
+			c := e.NewContext(req, rec)
+			c.SetParamNames("account_number")
+			c.SetParamValues("1234567897")
+
+			if err := handler.GetStatement(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			assert.Equal(t, tt.expectedStatusCode, rec.Code)
+			assert.Equal(t, tt.expectedHeader, rec.Header().Get(echo.HeaderContentDisposition))
+			assert.Contains(t, rec.Body.String(), tt.expectedBody)
+		})
+	}
+}
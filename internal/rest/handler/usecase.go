@@ -3,7 +3,6 @@ package handler
 import (
 	"context"
 
-	"github.com/shopspring/decimal"
 	"imansohibul.my.id/account-domain-service/entity"
 )
 
@@ -18,21 +17,173 @@ type CreateAccountUsecase interface {
 
 type GetBalanceUsecase interface {
 	// GetBalance retrieves the balance of an account
-	// returns the balance of the account
+	// returns the ledger balance and the available balance (net of the active holds) of the account in its currency
 	// returns an error if the account is not found or if the balance retrieval fails
-	GetBalance(ctx context.Context, accountNumber string) (decimal.Decimal, error)
+	GetBalance(ctx context.Context, accountNumber string) (*entity.Balance, error)
 }
 
 type DepositUsecase interface {
 	// Deposit deposits money into an account
 	// returns the transaction details of the deposit
 	// returns an error if the account is not found or if the deposit fails
-	Deposit(ctx context.Context, accountNumber string, amount decimal.Decimal) (*entity.Transaction, error)
+	// a repeated idempotency key returns the transaction of the first request
+	Deposit(ctx context.Context, params *entity.DepositParams) (*entity.Transaction, error)
 }
 
 type WithdrawUsecase interface {
 	// Withdraw withdraws money from an account
-	// returns the transaction details of the withdrawal
-	// returns an error if the account is not found or if the withdrawal fails
-	Withdraw(ctx context.Context, accountNumber string, amount decimal.Decimal) (*entity.Transaction, error)
+	// returns the debit and the fee transactions of the withdrawal
+	// returns an error if the account is not found, the amount exceeds a withdrawal limit,
+	// the balance doesn't cover the amount and the fee or if the withdrawal fails
+	// a repeated idempotency key returns the transactions of the first request
+	Withdraw(ctx context.Context, params *entity.WithdrawParams) (*entity.Withdrawal, error)
+}
+
+type TransferUsecase interface {
+	// Transfer moves money from the source account to the destination account
+	// returns the linked debit and credit transactions and the fee transaction of the transfer
+	// returns an error if either account is not found, the balance doesn't cover the amount and the fee or if the transfer fails
+	Transfer(ctx context.Context, params *entity.TransferParams) (*entity.Transfer, error)
+}
+
+type ListTransactionsUsecase interface {
+	// ListTransactions lists the transactions of an account from the newest to the oldest
+	// returns a page of transactions and the cursor of the next page
+	// returns an error if the account is not found, the cursor is invalid or if the listing fails
+	ListTransactions(ctx context.Context, params *entity.ListTransactionsParams) (*entity.TransactionPage, error)
+}
+
+type GenerateStatementUsecase interface {
+	// GenerateStatement renders the statement of an account for a period in the format of the params
+	// returns the opening balance, the transactions with the running balance, the totals and the closing balance as a document
+	// returns an error if the account is not found, the period is invalid or if the generation fails
+	GenerateStatement(ctx context.Context, params *entity.GenerateStatementParams) (*entity.StatementDocument, error)
+}
+
+type UpdateAccountStatusUsecase interface {
+	// UpdateAccountStatus changes the status of an account (e.g. block, unblock, close)
+	// returns the updated account
+	// returns an error if the account is not found, the status transition is not allowed or if the update fails
+	UpdateAccountStatus(ctx context.Context, params *entity.UpdateAccountStatusParams) (*entity.Account, error)
+}
+
+type GetTrialBalanceUsecase interface {
+	// GetTrialBalance sums the debit and credit journal entries of every account
+	// returns the trial balance of the ledger
+	// returns an error if the trial balance retrieval fails
+	GetTrialBalance(ctx context.Context) (*entity.TrialBalance, error)
+}
+
+type LoadExchangeRatesUsecase interface {
+	// LoadExchangeRates stores the exchange rates used to convert cross-currency transfers
+	// a rate of a pair that is already effective at the same time is replaced
+	// returns an error if a rate is invalid or if storing the rates fails
+	LoadExchangeRates(ctx context.Context, exchangeRates []*entity.ExchangeRate) error
+}
+
+type SetWithdrawalLimitUsecase interface {
+	// SetWithdrawalLimit overrides the withdrawal limits of the product of an account, a zero limit means unlimited
+	// returns the withdrawal limits of the account
+	// returns an error if the account is not found or if storing the limits fails
+	SetWithdrawalLimit(ctx context.Context, params *entity.SetWithdrawalLimitParams) (*entity.WithdrawalLimit, error)
+}
+
+type RemoveWithdrawalLimitUsecase interface {
+	// RemoveWithdrawalLimit removes the override of the withdrawal limits of an account
+	// returns an error if the account is not found, its limits aren't overridden or if the removal fails
+	RemoveWithdrawalLimit(ctx context.Context, accountNumber string) error
+}
+
+type OpenAccountUsecase interface {
+	// OpenAccount opens an additional account for a registered customer identified by ID or NIK
+	// returns the opened account
+	// returns an error if the customer is not found, the account type or currency is not supported or if the opening fails
+	// a repeated idempotency key returns the account of the first request
+	OpenAccount(ctx context.Context, params *entity.OpenAccountParams) (*entity.Account, error)
+}
+
+type ListCustomerAccountsUsecase interface {
+	// ListCustomerAccounts lists the accounts of a customer, the oldest account first
+	// returns the accounts with their balances
+	// returns an error if the customer is not found or if the listing fails
+	ListCustomerAccounts(ctx context.Context, customerID uint) ([]*entity.Account, error)
+}
+
+type GetCustomerUsecase interface {
+	// GetCustomer retrieves the profile of a customer
+	// returns the customer with their identities and accounts
+	// returns an error if the customer is not found or if the retrieval fails
+	GetCustomer(ctx context.Context, customerID uint) (*entity.CustomerProfile, error)
+}
+
+type UpdateCustomerUsecase interface {
+	// UpdateCustomer changes the full name and/or the phone number of a customer and records the change history
+	// returns the updated customer
+	// returns an error if the customer is not found, the phone number is used by another customer or if the update fails
+	UpdateCustomer(ctx context.Context, params *entity.UpdateCustomerParams) (*entity.Customer, error)
+}
+
+type SearchCustomersUsecase interface {
+	// SearchCustomers searches customers by the prefix of their name, their phone number and/or their NIK
+	// returns the matching customers ordered by name
+	// returns an error if no criterion is given or if the search fails
+	SearchCustomers(ctx context.Context, params *entity.SearchCustomersParams) ([]*entity.Customer, error)
+}
+
+type AddCustomerIdentityUsecase interface {
+	// AddCustomerIdentity attaches an identity document (e.g. passport, KITAS, NPWP) to a registered customer
+	// returns the added identity
+	// returns an error if the customer is not found, the customer already has an identity of the type,
+	// the identity number is registered to a customer or if the addition fails
+	AddCustomerIdentity(ctx context.Context, params *entity.AddCustomerIdentityParams) (*entity.CustomerIdentity, error)
+}
+
+type OpenTimeDepositUsecase interface {
+	// OpenTimeDeposit opens a time deposit with the funds of a saving account of the customer
+	// returns the time deposit with its account and the funding account
+	// returns an error if the funding account is not found or is not a saving account, the term is not supported,
+	// the balance is insufficient or if the opening fails
+	// a repeated idempotency key returns the time deposit of the first request
+	OpenTimeDeposit(ctx context.Context, params *entity.OpenTimeDepositParams) (*entity.TimeDepositAccount, error)
+}
+
+type QuoteFeeUsecase interface {
+	// QuoteFee computes the fee a withdrawal or a transfer would be charged, so it can be shown before it's confirmed
+	// returns the amount, the fee and the total debited from the account
+	// returns an error if the account is not found, the currency differs from the account or if the computation fails
+	QuoteFee(ctx context.Context, params *entity.FeeQuoteParams) (*entity.FeeQuote, error)
+}
+
+type PlaceHoldUsecase interface {
+	// PlaceHold reserves an amount of an account until it's captured, released or expired
+	// returns the active hold
+	// returns an error if the account is not found, the available balance doesn't cover the amount,
+	// the duration is not allowed or if placing the hold fails
+	// a repeated idempotency key returns the hold of the first request
+	PlaceHold(ctx context.Context, params *entity.PlaceHoldParams) (*entity.Hold, error)
+}
+
+type CaptureHoldUsecase interface {
+	// CaptureHold debits the account of an active hold with the full or a part of its amount, the rest is released
+	// returns the captured hold with its debit transaction and the account after the capture
+	// returns an error if the hold is not found, is no longer active or expired,
+	// the amount exceeds the hold or if the capture fails
+	// a repeated idempotency key returns the capture of the first request
+	CaptureHold(ctx context.Context, params *entity.CaptureHoldParams) (*entity.HoldCapture, error)
+}
+
+type ReleaseHoldUsecase interface {
+	// ReleaseHold cancels an active hold without debiting the account
+	// returns the released hold
+	// returns an error if the hold is not found, is no longer active or if the release fails
+	// a repeated idempotency key returns the hold released by the first request
+	ReleaseHold(ctx context.Context, params *entity.ReleaseHoldParams) (*entity.Hold, error)
+}
+
+type ReverseTransactionUsecase interface {
+	// ReverseTransaction posts a transaction in the opposite direction of a transaction posted by mistake
+	// returns the reversed transaction, the reversal transaction and the record of the operator and the reason
+	// returns an error if the transaction is not found, is already reversed or is a reversal itself,
+	// the reversal would make the balance negative without being forced or if the reversal fails
+	ReverseTransaction(ctx context.Context, params *entity.ReverseTransactionParams) (*entity.Reversal, error)
 }
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// HeaderOperator is the header the API gateway sets to the ID of the authenticated back-office operator,
+// the gateway has to drop the header of the incoming requests so it can't be set by the clients
+const HeaderOperator = "X-Operator-ID"
+
+// maxOperatorLength is the length of the operator column of the audit records
+const maxOperatorLength = 64
+
+// operatorKey is the key of the authenticated operator in the echo context
+const operatorKey = "operator"
+
+// RequireOperator rejects the back-office requests without an operator authenticated by the gateway
+// and keeps the operator in the context for the handlers, see Operator
+func RequireOperator() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			operator := strings.TrimSpace(c.Request().Header.Get(HeaderOperator))
+			if operator == "" {
+				return entity.ErrUnauthenticated
+			}
+
+			if len(operator) > maxOperatorLength {
+				return entity.ErrInvalidRequest
+			}
+
+			c.Set(operatorKey, operator)
+			return next(c)
+		}
+	}
+}
+
+// Operator returns the operator authenticated by RequireOperator, empty when the request has none
+func Operator(c echo.Context) string {
+	operator, _ := c.Get(operatorKey).(string)
+	return operator
+}
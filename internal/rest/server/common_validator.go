@@ -1,10 +1,7 @@
 package server
 
 import (
-	"net/http"
-
 	"github.com/go-playground/validator/v10"
-	"github.com/labstack/echo/v4"
 )
 
 type CommonValidator struct {
@@ -17,10 +14,8 @@ func NewCommonValidator(validate *validator.Validate) *CommonValidator {
 	}
 }
 
+// Validate returns the validator.ValidationErrors as is,
+// the HTTP error handler reports them per field
 func (c CommonValidator) Validate(i interface{}) error {
-	if err := c.validator.Struct(i); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, map[string]string{"remarks": err.Error()})
-	}
-
-	return nil
+	return c.validator.Struct(i)
 }
@@ -0,0 +1,203 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// domainErrorStatuses maps the domain error codes to HTTP statuses
+// Domain errors that aren't listed break a business rule and are returned as 422
+var domainErrorStatuses = map[string]int{
+	entity.ErrInvalidRequest.Code:                    http.StatusBadRequest,
+	entity.ErrTransferToSameAccount.Code:             http.StatusBadRequest,
+	entity.ErrInvalidCursor.Code:                     http.StatusBadRequest,
+	entity.ErrInvalidExchangeRate.Code:               http.StatusBadRequest,
+	entity.ErrUnsupportedAccountType.Code:            http.StatusBadRequest,
+	entity.ErrUnsupportedIdentityType.Code:           http.StatusBadRequest,
+	entity.ErrInvalidNIK.Code:                        http.StatusBadRequest,
+	entity.ErrInvalidPhoneNumber.Code:                http.StatusBadRequest,
+	entity.ErrUnsupportedTimeDepositTerm.Code:        http.StatusBadRequest,
+	entity.ErrInvalidHoldDuration.Code:               http.StatusBadRequest,
+	entity.ErrInvalidStatementPeriod.Code:            http.StatusBadRequest,
+	entity.ErrUnauthenticated.Code:                   http.StatusUnauthorized,
+	entity.ErrAccountNotFound.Code:                   http.StatusNotFound,
+	entity.ErrCustomerNotFound.Code:                  http.StatusNotFound,
+	entity.ErrCustomerIdentityNotFound.Code:          http.StatusNotFound,
+	entity.ErrTimeDepositNotFound.Code:               http.StatusNotFound,
+	entity.ErrWithdrawalLimitNotFound.Code:           http.StatusNotFound,
+	entity.ErrHoldNotFound.Code:                      http.StatusNotFound,
+	entity.ErrTransactionNotFound.Code:               http.StatusNotFound,
+	entity.ErrAccountAlreadyExists.Code:              http.StatusConflict,
+	entity.ErrPhoneNumberAlreadyExists.Code:          http.StatusConflict,
+	entity.ErrCustomerIdentityAlreadyExists.Code:     http.StatusConflict,
+	entity.ErrIdempotencyKeyAlreadyExists.Code:       http.StatusConflict,
+	entity.ErrIdempotencyKeyReused.Code:              http.StatusConflict,
+	entity.ErrInvalidAccountStatusTransition.Code:    http.StatusConflict,
+	entity.ErrHoldNotActive.Code:                     http.StatusConflict,
+	entity.ErrTransactionAlreadyReversed.Code:        http.StatusConflict,
+	entity.ErrTransactionReferenceAlreadyExists.Code: http.StatusConflict,
+	entity.ErrInsufficientBalance.Code:               http.StatusUnprocessableEntity,
+	entity.ErrAccountBlocked.Code:                    http.StatusUnprocessableEntity,
+	entity.ErrAccountDebitBlocked.Code:               http.StatusUnprocessableEntity,
+	entity.ErrAccountDormant.Code:                    http.StatusUnprocessableEntity,
+	entity.ErrAccountClosed.Code:                     http.StatusUnprocessableEntity,
+	entity.ErrAccountBalanceNotZero.Code:             http.StatusUnprocessableEntity,
+	entity.ErrUnbalancedJournal.Code:                 http.StatusInternalServerError,
+	entity.ErrIdempotencyKeyNotFound.Code:            http.StatusInternalServerError,
+	entity.ErrTransactionReversalNotFound.Code:       http.StatusInternalServerError,
+	entity.ErrInternal.Code:                          http.StatusInternalServerError,
+}
+
+// ErrorResponse is the response body of every failed request
+type ErrorResponse struct {
+	Code      string            `json:"code"`
+	Message   string            `json:"message"`
+	Details   map[string]string `json:"details,omitempty"`
+	RequestID string            `json:"request_id,omitempty"`
+	Errors    []FieldError      `json:"errors,omitempty"`
+}
+
+// FieldError describes a request field that failed the validation
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// NewHTTPErrorHandler translates the errors returned by the handlers to an ErrorResponse:
+// domain errors with the status of their code, validation errors as 400 with the invalid fields
+// and any other error as 500 without exposing its details
+func NewHTTPErrorHandler(logger util.Logger) echo.HTTPErrorHandler {
+	return func(err error, c echo.Context) {
+		if c.Response().Committed {
+			return
+		}
+
+		var (
+			status          int
+			resp            ErrorResponse
+			domainError     *entity.DomainError
+			validationError validator.ValidationErrors
+			httpError       *echo.HTTPError
+		)
+
+		switch {
+		case errors.As(err, &domainError):
+			status = http.StatusUnprocessableEntity
+			if domainStatus, ok := domainErrorStatuses[domainError.Code]; ok {
+				status = domainStatus
+			}
+
+			resp = ErrorResponse{Code: domainError.Code, Message: domainError.Message, Details: domainError.Details}
+		case errors.As(err, &validationError):
+			status = http.StatusBadRequest
+			resp = ErrorResponse{
+				Code:    entity.ErrInvalidRequest.Code,
+				Message: entity.ErrInvalidRequest.Message,
+				Errors:  newFieldErrors(validationError),
+			}
+		case errors.As(err, &httpError):
+			// Errors of Echo itself, e.g. unknown route or method not allowed
+			status = httpError.Code
+			resp = ErrorResponse{
+				Code:    strings.ToUpper(strings.ReplaceAll(http.StatusText(status), " ", "_")),
+				Message: fmt.Sprint(httpError.Message),
+			}
+		default:
+			status = http.StatusInternalServerError
+			resp = ErrorResponse{Code: entity.ErrInternal.Code, Message: entity.ErrInternal.Message}
+		}
+
+		if status >= http.StatusInternalServerError {
+			logger.Error(c.Request().Context(), "Request failed", err, map[string]interface{}{
+				"method": c.Request().Method,
+				"path":   c.Path(),
+			})
+		}
+
+		resp.RequestID = c.Response().Header().Get(echo.HeaderXRequestID)
+
+		if c.Request().Method == http.MethodHead {
+			err = c.NoContent(status)
+		} else {
+			err = c.JSON(status, resp)
+		}
+
+		if err != nil {
+			logger.Error(c.Request().Context(), "Failed to write error response", err, nil)
+		}
+	}
+}
+
+// newFieldErrors lists the fields that failed the validation
+func newFieldErrors(validationErrors validator.ValidationErrors) []FieldError {
+	fieldErrors := make([]FieldError, 0, len(validationErrors))
+	for _, fieldError := range validationErrors {
+		fieldErrors = append(fieldErrors, FieldError{
+			Field:   fieldError.Field(),
+			Rule:    fieldError.Tag(),
+			Message: fieldErrorMessage(fieldError),
+		})
+	}
+
+	return fieldErrors
+}
+
+// fieldErrorMessage describes the failed validation rule
+func fieldErrorMessage(fieldError validator.FieldError) string {
+	switch fieldError.Tag() {
+	case "required":
+		return "wajib diisi"
+	case "required_without":
+		return "wajib diisi jika " + fieldError.Param() + " kosong"
+	case "gt":
+		return "harus lebih besar dari " + fieldError.Param()
+	case "gte":
+		return "harus lebih besar dari atau sama dengan " + fieldError.Param()
+	case "lt":
+		return "harus lebih kecil dari " + fieldError.Param()
+	case "lte":
+		return "harus lebih kecil dari atau sama dengan " + fieldError.Param()
+	case "min":
+		return "minimal " + fieldError.Param() + " karakter"
+	case "max":
+		return "maksimal " + fieldError.Param() + " karakter"
+	case "oneof":
+		return "harus salah satu dari: " + fieldError.Param()
+	case "datetime":
+		return "format tanggal harus " + fieldError.Param()
+	case "e164":
+		return "format nomor telepon harus E.164, contoh +6281234567890"
+	case "phone_number":
+		return "nomor telepon harus nomor seluler Indonesia, contoh 081234567890, atau E.164 untuk nomor luar negeri"
+	case "iso4217":
+		return "kode mata uang harus ISO 4217, contoh IDR"
+	case "nik":
+		return "NIK harus 16 digit angka dengan kode wilayah dan tanggal lahir yang valid"
+	case "passport":
+		return "nomor paspor harus 6-9 huruf kapital atau angka"
+	case "kitas":
+		return "nomor KITAS harus 11-16 huruf kapital atau angka"
+	case "npwp":
+		return "NPWP harus 15 atau 16 digit angka tanpa tanda baca"
+	case "identity_number":
+		return "format nomor identitas tidak sesuai dengan jenis identitas"
+	case "account_number":
+		return "nomor rekening tidak valid, periksa kembali nomor rekening"
+	case "fullname":
+		return "nama hanya boleh berisi huruf, spasi, titik, tanda hubung dan apostrof (3-100 karakter)"
+	case "nefield":
+		return "tidak boleh sama dengan " + fieldError.Param()
+	case "gtefield":
+		return "harus lebih besar dari atau sama dengan " + fieldError.Param()
+	default:
+		return "tidak valid"
+	}
+}
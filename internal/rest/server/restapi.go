@@ -7,16 +7,37 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"imansohibul.my.id/account-domain-service/internal/rest/handler"
+	restmiddleware "imansohibul.my.id/account-domain-service/internal/rest/middleware"
 	"imansohibul.my.id/account-domain-service/util"
 )
 
 // RestServer encapsulates the Echo instance and usecases
 type RestAPIServer struct {
-	echo                 *echo.Echo
-	createAccountUsecase handler.CreateAccountUsecase
-	depositUsecase       handler.DepositUsecase
-	withdrawUsecase      handler.WithdrawUsecase
-	getBalanceUsecase    handler.GetBalanceUsecase
+	echo                         *echo.Echo
+	createAccountUsecase         handler.CreateAccountUsecase
+	depositUsecase               handler.DepositUsecase
+	withdrawUsecase              handler.WithdrawUsecase
+	getBalanceUsecase            handler.GetBalanceUsecase
+	transferUsecase              handler.TransferUsecase
+	listTransactionsUsecase      handler.ListTransactionsUsecase
+	updateAccountStatusUsecase   handler.UpdateAccountStatusUsecase
+	getTrialBalanceUsecase       handler.GetTrialBalanceUsecase
+	loadExchangeRatesUsecase     handler.LoadExchangeRatesUsecase
+	openAccountUsecase           handler.OpenAccountUsecase
+	listCustomerAccountsUsecase  handler.ListCustomerAccountsUsecase
+	getCustomerUsecase           handler.GetCustomerUsecase
+	updateCustomerUsecase        handler.UpdateCustomerUsecase
+	searchCustomersUsecase       handler.SearchCustomersUsecase
+	addCustomerIdentityUsecase   handler.AddCustomerIdentityUsecase
+	openTimeDepositUsecase       handler.OpenTimeDepositUsecase
+	quoteFeeUsecase              handler.QuoteFeeUsecase
+	setWithdrawalLimitUsecase    handler.SetWithdrawalLimitUsecase
+	removeWithdrawalLimitUsecase handler.RemoveWithdrawalLimitUsecase
+	placeHoldUsecase             handler.PlaceHoldUsecase
+	captureHoldUsecase           handler.CaptureHoldUsecase
+	releaseHoldUsecase           handler.ReleaseHoldUsecase
+	reverseTransactionUsecase    handler.ReverseTransactionUsecase
+	generateStatementUsecase     handler.GenerateStatementUsecase
 }
 
 // NewRestAPIServer constructs the server with injected usecases
@@ -25,8 +46,29 @@ func NewRestAPIServer(
 	depositUsecase handler.DepositUsecase,
 	withdrawUsecase handler.WithdrawUsecase,
 	getBalanceUsecase handler.GetBalanceUsecase,
+	transferUsecase handler.TransferUsecase,
+	listTransactionsUsecase handler.ListTransactionsUsecase,
+	updateAccountStatusUsecase handler.UpdateAccountStatusUsecase,
+	getTrialBalanceUsecase handler.GetTrialBalanceUsecase,
+	loadExchangeRatesUsecase handler.LoadExchangeRatesUsecase,
+	openAccountUsecase handler.OpenAccountUsecase,
+	listCustomerAccountsUsecase handler.ListCustomerAccountsUsecase,
+	getCustomerUsecase handler.GetCustomerUsecase,
+	updateCustomerUsecase handler.UpdateCustomerUsecase,
+	searchCustomersUsecase handler.SearchCustomersUsecase,
+	addCustomerIdentityUsecase handler.AddCustomerIdentityUsecase,
+	openTimeDepositUsecase handler.OpenTimeDepositUsecase,
+	quoteFeeUsecase handler.QuoteFeeUsecase,
+	setWithdrawalLimitUsecase handler.SetWithdrawalLimitUsecase,
+	removeWithdrawalLimitUsecase handler.RemoveWithdrawalLimitUsecase,
+	placeHoldUsecase handler.PlaceHoldUsecase,
+	captureHoldUsecase handler.CaptureHoldUsecase,
+	releaseHoldUsecase handler.ReleaseHoldUsecase,
+	reverseTransactionUsecase handler.ReverseTransactionUsecase,
+	generateStatementUsecase handler.GenerateStatementUsecase,
 ) *RestAPIServer {
 	e := echo.New()
+	e.HTTPErrorHandler = NewHTTPErrorHandler(util.GetZapLogger())
 
 	// Set up middleware
 	e.Use(middleware.Logger())
@@ -38,11 +80,31 @@ func NewRestAPIServer(
 	e.GET("/metrics", echoprometheus.NewHandler()) // adds route to serve gathered metrics
 
 	return &RestAPIServer{
-		echo:                 e,
-		createAccountUsecase: createAccountUsecase,
-		depositUsecase:       depositUsecase,
-		withdrawUsecase:      withdrawUsecase,
-		getBalanceUsecase:    getBalanceUsecase,
+		echo:                         e,
+		createAccountUsecase:         createAccountUsecase,
+		depositUsecase:               depositUsecase,
+		withdrawUsecase:              withdrawUsecase,
+		getBalanceUsecase:            getBalanceUsecase,
+		transferUsecase:              transferUsecase,
+		listTransactionsUsecase:      listTransactionsUsecase,
+		updateAccountStatusUsecase:   updateAccountStatusUsecase,
+		getTrialBalanceUsecase:       getTrialBalanceUsecase,
+		loadExchangeRatesUsecase:     loadExchangeRatesUsecase,
+		openAccountUsecase:           openAccountUsecase,
+		listCustomerAccountsUsecase:  listCustomerAccountsUsecase,
+		getCustomerUsecase:           getCustomerUsecase,
+		updateCustomerUsecase:        updateCustomerUsecase,
+		searchCustomersUsecase:       searchCustomersUsecase,
+		addCustomerIdentityUsecase:   addCustomerIdentityUsecase,
+		openTimeDepositUsecase:       openTimeDepositUsecase,
+		quoteFeeUsecase:              quoteFeeUsecase,
+		setWithdrawalLimitUsecase:    setWithdrawalLimitUsecase,
+		removeWithdrawalLimitUsecase: removeWithdrawalLimitUsecase,
+		placeHoldUsecase:             placeHoldUsecase,
+		captureHoldUsecase:           captureHoldUsecase,
+		releaseHoldUsecase:           releaseHoldUsecase,
+		reverseTransactionUsecase:    reverseTransactionUsecase,
+		generateStatementUsecase:     generateStatementUsecase,
 	}
 }
 
@@ -54,18 +116,108 @@ func (s *RestAPIServer) setupAccountRoutes() {
 		s.depositUsecase,
 		s.withdrawUsecase,
 		s.getBalanceUsecase,
+		s.transferUsecase,
 	)
 
 	s.echo.POST("/daftar", accountHandler.CreateAccount)
 	s.echo.POST("/tabung", accountHandler.Deposit)
 	s.echo.POST("/tarik", accountHandler.Withdraw)
 	s.echo.GET("/saldo/:account_number", accountHandler.GetBalance)
+	s.echo.POST("/transfer", accountHandler.Transfer)
+}
+
+// setupCustomerRoutes sets up the routes for the profile and the accounts of registered customers
+func (s *RestAPIServer) setupCustomerRoutes() {
+	customerHandler := handler.NewCustomerHandler(
+		s.openAccountUsecase,
+		s.listCustomerAccountsUsecase,
+		s.getCustomerUsecase,
+		s.updateCustomerUsecase,
+		s.searchCustomersUsecase,
+		s.addCustomerIdentityUsecase,
+	)
+
+	s.echo.POST("/buka-rekening", customerHandler.OpenAccount)
+	s.echo.GET("/nasabah", customerHandler.SearchCustomers)
+	s.echo.GET("/nasabah/:id", customerHandler.GetCustomer)
+	s.echo.PUT("/nasabah/:id", customerHandler.UpdateCustomer)
+	s.echo.GET("/nasabah/:id/rekening", customerHandler.ListCustomerAccounts)
+	s.echo.POST("/nasabah/:id/identitas", customerHandler.AddCustomerIdentity)
+}
+
+// setupTimeDepositRoutes sets up the routes for time deposit operations
+func (s *RestAPIServer) setupTimeDepositRoutes() {
+	timeDepositHandler := handler.NewTimeDepositHandler(
+		s.openTimeDepositUsecase,
+	)
+
+	s.echo.POST("/deposito", timeDepositHandler.OpenTimeDeposit)
+}
+
+// setupFeeRoutes sets up the routes for showing the fee of an operation before it's confirmed
+func (s *RestAPIServer) setupFeeRoutes() {
+	feeHandler := handler.NewFeeHandler(
+		s.quoteFeeUsecase,
+	)
+
+	s.echo.GET("/biaya", feeHandler.QuoteFee)
+}
+
+// setupHoldRoutes sets up the routes for reserving funds of an account and capturing or releasing them later
+func (s *RestAPIServer) setupHoldRoutes() {
+	holdHandler := handler.NewHoldHandler(
+		s.placeHoldUsecase,
+		s.captureHoldUsecase,
+		s.releaseHoldUsecase,
+	)
+
+	s.echo.POST("/hold", holdHandler.PlaceHold)
+	s.echo.POST("/hold/:id/capture", holdHandler.CaptureHold)
+	s.echo.POST("/hold/:id/release", holdHandler.ReleaseHold)
+}
+
+// setupTransactionRoutes sets up the routes for transaction history and statement operations
+func (s *RestAPIServer) setupTransactionRoutes() {
+	transactionHandler := handler.NewTransactionHandler(
+		s.listTransactionsUsecase,
+		s.generateStatementUsecase,
+	)
+
+	s.echo.GET("/mutasi/:account_number", transactionHandler.ListTransactions)
+	s.echo.GET("/rekening/:account_number/statement", transactionHandler.GetStatement)
+}
+
+// setupAdminRoutes sets up the routes for back-office operations
+func (s *RestAPIServer) setupAdminRoutes() {
+	adminHandler := handler.NewAdminHandler(
+		s.updateAccountStatusUsecase,
+		s.getTrialBalanceUsecase,
+		s.loadExchangeRatesUsecase,
+		s.setWithdrawalLimitUsecase,
+		s.removeWithdrawalLimitUsecase,
+		s.reverseTransactionUsecase,
+	)
+
+	// The back-office requests are authenticated by the gateway, which forwards the operator
+	admin := s.echo.Group("/admin", restmiddleware.RequireOperator())
+	admin.PUT("/rekening/:account_number/status", adminHandler.UpdateAccountStatus)
+	admin.GET("/neraca-saldo", adminHandler.GetTrialBalance)
+	admin.POST("/kurs", adminHandler.LoadExchangeRates)
+	admin.PUT("/rekening/:account_number/limit", adminHandler.SetWithdrawalLimit)
+	admin.DELETE("/rekening/:account_number/limit", adminHandler.RemoveWithdrawalLimit)
+	admin.POST("/transaksi/:id/koreksi", adminHandler.ReverseTransaction)
 }
 
 // Start launches the Echo HTTP server
 func (s *RestAPIServer) Start(address string) error {
 	s.registerValidator()
 	s.setupAccountRoutes()
+	s.setupCustomerRoutes()
+	s.setupTimeDepositRoutes()
+	s.setupFeeRoutes()
+	s.setupHoldRoutes()
+	s.setupTransactionRoutes()
+	s.setupAdminRoutes()
 	return s.echo.Start(address)
 }
 
@@ -0,0 +1,61 @@
+package statement
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// csvRenderer renders the statements as CSV, the header rows describe the account and the period,
+// followed by a row per transaction and the footer rows with the totals and the closing balance
+type csvRenderer struct{}
+
+func NewCSVRenderer() *csvRenderer {
+	return &csvRenderer{}
+}
+
+func (c csvRenderer) Format() entity.StatementFormat {
+	return entity.StatementFormatCSV
+}
+
+func (c csvRenderer) Render(statement *entity.Statement, writer io.Writer) error {
+	var (
+		csvWriter = csv.NewWriter(writer)
+		currency  = statement.Account.Currency
+	)
+
+	rows := [][]string{
+		{"Nomor Rekening", statement.Account.AccountNumber},
+		{"Nama", statement.CustomerName},
+		{"Mata Uang", string(currency)},
+		{"Periode", statement.From.Format(dateLayout), statement.LastDay().Format(dateLayout)},
+		{"Saldo Awal", formatAmount(statement.OpeningBalance, currency)},
+		{},
+		{"Tanggal", "ID Transaksi", "Jenis", "Keterangan", "Kanal", "Debit", "Kredit", "Saldo"},
+	}
+
+	for _, line := range statement.Lines {
+		debit, credit := debitCredit(line.Transaction)
+		rows = append(rows, []string{
+			line.Transaction.CreatedAt.Format(dateLayout),
+			strconv.FormatUint(uint64(line.Transaction.ID), 10),
+			transactionTypeLabels[line.Transaction.Type],
+			describe(line.Transaction),
+			channelLabels[line.Transaction.Channel],
+			debit,
+			credit,
+			formatAmount(line.Balance, currency),
+		})
+	}
+
+	rows = append(rows,
+		[]string{},
+		[]string{"Total Debit", formatAmount(statement.TotalDebit, currency)},
+		[]string{"Total Kredit", formatAmount(statement.TotalCredit, currency)},
+		[]string{"Saldo Akhir", formatAmount(statement.ClosingBalance, currency)},
+	)
+
+	return csvWriter.WriteAll(rows)
+}
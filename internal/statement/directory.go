@@ -0,0 +1,27 @@
+package statement
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// directoryStore saves the statements as files in a local directory
+type directoryStore struct {
+	dir string
+}
+
+func NewDirectoryStore(dir string) *directoryStore {
+	return &directoryStore{dir: dir}
+}
+
+// Save writes the document to the directory, replacing the file of a statement generated before
+func (d directoryStore) Save(ctx context.Context, document *entity.StatementDocument) error {
+	if err := os.MkdirAll(d.dir, 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(d.dir, document.Filename()), document.Content, 0o644)
+}
@@ -0,0 +1,71 @@
+package statement
+
+import (
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// dateLayout is the layout of the dates printed on the statements
+const dateLayout = "02/01/2006"
+
+// transactionTypeLabels maps the transaction types to their labels on the statements
+var transactionTypeLabels = map[entity.TransactionType]string{
+	entity.TransactionTypeCredit:         "KREDIT",
+	entity.TransactionTypeDebit:          "DEBIT",
+	entity.TransactionTypeInterest:       "BUNGA",
+	entity.TransactionTypeWithholdingTax: "PAJAK",
+	entity.TransactionTypeFee:            "BIAYA",
+	entity.TransactionTypeReversalDebit:  "KOREKSI DEBIT",
+	entity.TransactionTypeReversalCredit: "KOREKSI KREDIT",
+}
+
+// channelLabels maps the transaction channels to their labels on the statements
+var channelLabels = map[entity.Channel]string{
+	entity.ChannelTeller:     "TELLER",
+	entity.ChannelATM:        "ATM",
+	entity.ChannelMobile:     "MOBILE",
+	entity.ChannelInternet:   "INTERNET",
+	entity.ChannelPartnerAPI: "PARTNER",
+}
+
+// formatAmount formats an amount with the decimal places of the currency
+func formatAmount(amount decimal.Decimal, currency entity.Currency) string {
+	return amount.StringFixed(currency.MinorUnits())
+}
+
+// describe returns the text describing a transaction on the statements:
+// the description, the reference and the counterparty when given
+func describe(transaction *entity.Transaction) string {
+	parts := make([]string, 0, 3)
+
+	if transaction.Description != "" {
+		parts = append(parts, transaction.Description)
+	}
+
+	if transaction.Reference != "" {
+		parts = append(parts, "Ref "+transaction.Reference)
+	}
+
+	if transaction.Counterparty != nil {
+		counterparty := transaction.Counterparty.Name
+		if transaction.Counterparty.AccountNumber != "" {
+			counterparty += " " + transaction.Counterparty.AccountNumber
+		}
+
+		parts = append(parts, counterparty)
+	}
+
+	return strings.Join(parts, " / ")
+}
+
+// debitCredit returns the amount of a transaction in the debit or the credit column
+func debitCredit(transaction *entity.Transaction) (debit, credit string) {
+	amount := formatAmount(transaction.Amount, transaction.Currency)
+	if transaction.Type.IsDebit() {
+		return amount, ""
+	}
+
+	return "", amount
+}
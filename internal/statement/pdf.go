@@ -0,0 +1,191 @@
+package statement
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// The statements are printed on landscape A4 pages with the Courier standard font,
+// which every PDF reader provides, so no font is embedded
+const (
+	pdfPageWidth    = 842
+	pdfPageHeight   = 595
+	pdfMargin       = 36
+	pdfFontSize     = 8
+	pdfLeading      = 11
+	pdfLinesPerPage = (pdfPageHeight-2*pdfMargin)/pdfLeading - 2 // leaving room for the page number
+)
+
+// pdfColumns is the format of a transaction row, the columns are fixed width as the font is monospaced
+const pdfColumns = "%-10s %10s %-14s %-50s %-8s %18s %18s %20s"
+
+// pdfRenderer renders the statements as a simple text-only PDF document
+type pdfRenderer struct{}
+
+func NewPDFRenderer() *pdfRenderer {
+	return &pdfRenderer{}
+}
+
+func (p pdfRenderer) Format() entity.StatementFormat {
+	return entity.StatementFormatPDF
+}
+
+func (p pdfRenderer) Render(statement *entity.Statement, writer io.Writer) error {
+	var (
+		lines    = p.lines(statement)
+		pages    = make([][]string, 0, len(lines)/pdfLinesPerPage+1)
+		document = &pdfDocument{}
+	)
+
+	for len(lines) > pdfLinesPerPage {
+		pages = append(pages, lines[:pdfLinesPerPage])
+		lines = lines[pdfLinesPerPage:]
+	}
+
+	pages = append(pages, lines)
+
+	// Objects 1 to 3 are the catalog, the page tree and the font, followed by a page and its content per page
+	pageRefs := make([]string, 0, len(pages))
+	for i := range pages {
+		pageRefs = append(pageRefs, fmt.Sprintf("%d 0 R", 4+2*i))
+	}
+
+	document.add("<< /Type /Catalog /Pages 2 0 R >>")
+	document.add(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(pageRefs, " "), len(pages)))
+	document.add("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+
+	for i, page := range pages {
+		footer := fmt.Sprintf("Halaman %d dari %d", i+1, len(pages))
+		content := p.content(page, footer)
+
+		document.add(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			pdfPageWidth, pdfPageHeight, 5+2*i,
+		))
+		document.add(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content))
+	}
+
+	_, err := writer.Write(document.bytes())
+	return err
+}
+
+// lines returns the text of the statement, line by line
+func (p pdfRenderer) lines(statement *entity.Statement) []string {
+	currency := statement.Account.Currency
+
+	lines := []string{
+		"REKENING KORAN",
+		"",
+		fmt.Sprintf("Nomor Rekening : %s", statement.Account.AccountNumber),
+		fmt.Sprintf("Nama           : %s", statement.CustomerName),
+		fmt.Sprintf("Mata Uang      : %s", currency),
+		fmt.Sprintf("Periode        : %s - %s", statement.From.Format(dateLayout), statement.LastDay().Format(dateLayout)),
+		fmt.Sprintf("Saldo Awal     : %s", formatAmount(statement.OpeningBalance, currency)),
+		"",
+		fmt.Sprintf(pdfColumns, "Tanggal", "ID", "Jenis", "Keterangan", "Kanal", "Debit", "Kredit", "Saldo"),
+	}
+
+	for _, line := range statement.Lines {
+		debit, credit := debitCredit(line.Transaction)
+		lines = append(lines, fmt.Sprintf(
+			pdfColumns,
+			line.Transaction.CreatedAt.Format(dateLayout),
+			fmt.Sprint(line.Transaction.ID),
+			transactionTypeLabels[line.Transaction.Type],
+			truncate(describe(line.Transaction), 50),
+			channelLabels[line.Transaction.Channel],
+			debit,
+			credit,
+			formatAmount(line.Balance, currency),
+		))
+	}
+
+	return append(lines,
+		"",
+		fmt.Sprintf("Total Debit    : %s", formatAmount(statement.TotalDebit, currency)),
+		fmt.Sprintf("Total Kredit   : %s", formatAmount(statement.TotalCredit, currency)),
+		fmt.Sprintf("Saldo Akhir    : %s", formatAmount(statement.ClosingBalance, currency)),
+	)
+}
+
+// content returns the content stream printing the lines from the top left corner of a page
+// and the footer below them
+func (p pdfRenderer) content(lines []string, footer string) string {
+	var content strings.Builder
+
+	fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", pdfFontSize, pdfLeading, pdfMargin, pdfPageHeight-pdfMargin-pdfFontSize)
+	for _, line := range lines {
+		fmt.Fprintf(&content, "(%s) Tj T*\n", escapePDFText(line))
+	}
+
+	fmt.Fprintf(&content, "T* (%s) Tj\n", escapePDFText(footer))
+
+	content.WriteString("ET")
+	return content.String()
+}
+
+// pdfDocument builds a PDF document from its objects, numbered from 1 in the order they are added
+type pdfDocument struct {
+	objects []string
+}
+
+func (d *pdfDocument) add(object string) {
+	d.objects = append(d.objects, object)
+}
+
+// bytes returns the document with the cross-reference table of the byte offsets of the objects
+func (d *pdfDocument) bytes() []byte {
+	var (
+		buffer  bytes.Buffer
+		offsets = make([]int, 0, len(d.objects))
+	)
+
+	buffer.WriteString("%PDF-1.4\n")
+	for i, object := range d.objects {
+		offsets = append(offsets, buffer.Len())
+		fmt.Fprintf(&buffer, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xref := buffer.Len()
+	fmt.Fprintf(&buffer, "xref\n0 %d\n0000000000 65535 f \n", len(d.objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buffer, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(&buffer, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(d.objects)+1, xref)
+	return buffer.Bytes()
+}
+
+// escapePDFText escapes the delimiters of a PDF string and replaces the characters
+// the standard font can't print, so the text is printable ASCII only
+func escapePDFText(text string) string {
+	var escaped strings.Builder
+
+	for _, r := range text {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			escaped.WriteRune('\\')
+			escaped.WriteRune(r)
+		case r < ' ' || r > '~':
+			escaped.WriteRune('?')
+		default:
+			escaped.WriteRune(r)
+		}
+	}
+
+	return escaped.String()
+}
+
+// truncate shortens a text to the maximum number of characters
+func truncate(text string, length int) string {
+	runes := []rune(text)
+	if len(runes) <= length {
+		return text
+	}
+
+	return string(runes[:length-3]) + "..."
+}
@@ -0,0 +1,34 @@
+package usecase
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// accountNumberGenerator generates the account numbers of the configured scheme
+type accountNumberGenerator struct {
+	scheme            util.AccountNumberScheme
+	accountRepository AccountRepository
+}
+
+func newAccountNumberGenerator(scheme util.AccountNumberScheme, accountRepository AccountRepository) accountNumberGenerator {
+	return accountNumberGenerator{
+		scheme:            scheme,
+		accountRepository: accountRepository,
+	}
+}
+
+// Generate returns a new account number, the body is random or the next value of the account number sequence
+func (g accountNumberGenerator) Generate(ctx context.Context) (string, error) {
+	if g.scheme.Body != util.AccountNumberBodySequence {
+		return g.scheme.Random()
+	}
+
+	sequence, err := g.accountRepository.NextAccountNumberSequence(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return g.scheme.FromSequence(sequence)
+}
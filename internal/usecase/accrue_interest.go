@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// DefaultAccrueInterestBatchSize is the number of accounts read per query while accruing the interest
+const DefaultAccrueInterestBatchSize = 100
+
+// interestProduct identifies the rate tiers of an account
+type interestProduct struct {
+	accountType entity.AccountType
+	currency    entity.Currency
+}
+
+type accrueInterestUsecase struct {
+	accountRepository     AccountRepository
+	transactionRepository TransactionRepository
+	interestRepository    InterestRepository
+	logger                util.Logger
+}
+
+func NewAccrueInterestUsecase(
+	accountRepository AccountRepository,
+	transactionRepository TransactionRepository,
+	interestRepository InterestRepository,
+	logger util.Logger,
+) *accrueInterestUsecase {
+	return &accrueInterestUsecase{
+		accountRepository:     accountRepository,
+		transactionRepository: transactionRepository,
+		interestRepository:    interestRepository,
+		logger:                logger,
+	}
+}
+
+// AccrueInterest accrues the interest of a day for every open account whose product has rate tiers.
+// The interest is computed on the end-of-day balance at the rate of its tier, see entity.DailyInterest.
+// An account that is already accrued for the day is skipped, so the job can be run again for the same date
+func (a accrueInterestUsecase) AccrueInterest(ctx context.Context, date time.Time) (*entity.InterestAccrualReport, error) {
+	var (
+		err     error
+		afterID uint
+		report  = &entity.InterestAccrualReport{
+			Date:   startOfDay(date),
+			Totals: make(map[entity.Currency]decimal.Decimal),
+		}
+		logger = a.logger.WithDuration(
+			ctx,
+			"accrueInterestUsecase.AccrueInterest",
+			map[string]interface{}{
+				"date": report.Date,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	var tiers []*entity.InterestRateTier
+	tiers, err = a.interestRepository.FindInterestRateTiers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	productTiers := make(map[interestProduct][]*entity.InterestRateTier)
+	for _, tier := range tiers {
+		product := interestProduct{accountType: tier.AccountType, currency: tier.Currency}
+		productTiers[product] = append(productTiers[product], tier)
+	}
+
+	// The end-of-day balance is the balance at the start of the next day
+	endOfDay := report.Date.AddDate(0, 0, 1)
+
+	for {
+		var accounts []*entity.Account
+		accounts, err = a.accountRepository.FindAccounts(ctx, afterID, DefaultAccrueInterestBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, account := range accounts {
+			afterID = account.ID
+
+			accountTiers, found := productTiers[interestProduct{accountType: account.AccountType, currency: account.Currency}]
+			if !found || account.Status == entity.AccountStatusClosed {
+				continue
+			}
+
+			var balance decimal.Decimal
+			balance, err = a.transactionRepository.FindBalanceAt(ctx, account.ID, endOfDay)
+			if err != nil {
+				return nil, err
+			}
+
+			rate := entity.InterestRateForBalance(accountTiers, balance)
+			amount := entity.DailyInterest(balance, rate)
+			if !amount.IsPositive() {
+				continue
+			}
+
+			_, err = a.interestRepository.CreateInterestAccrual(ctx, &entity.InterestAccrual{
+				AccountID:   account.ID,
+				AccrualDate: report.Date,
+				Balance:     balance,
+				AnnualRate:  rate,
+				Amount:      amount,
+			})
+			if errors.Is(err, entity.ErrInterestAlreadyAccrued) {
+				report.AlreadyAccrued++
+				err = nil
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+
+			report.Accrued++
+			report.Totals[account.Currency] = report.Totals[account.Currency].Add(amount)
+		}
+
+		if len(accounts) < DefaultAccrueInterestBatchSize {
+			break
+		}
+	}
+
+	return report, nil
+}
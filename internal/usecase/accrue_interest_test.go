@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	repositorymock "imansohibul.my.id/account-domain-service/internal/usecase/mock"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestAccrueInterest(t *testing.T) {
+	var (
+		ctrl                  = gomock.NewController(t)
+		accountRepository     = repositorymock.NewMockAccountRepository(ctrl)
+		transactionRepository = repositorymock.NewMockTransactionRepository(ctrl)
+		interestRepository    = repositorymock.NewMockInterestRepository(ctrl)
+
+		date     = time.Date(2025, time.May, 31, 18, 30, 0, 0, time.UTC)
+		endOfDay = time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+		tiers = []*entity.InterestRateTier{
+			{AccountType: entity.AccountTypeSaving, Currency: entity.CurrencyIDR, MinBalance: decimal.Zero, AnnualRate: decimal.Zero},
+			{AccountType: entity.AccountTypeSaving, Currency: entity.CurrencyIDR, MinBalance: decimal.NewFromInt(10000000), AnnualRate: decimal.RequireFromString("0.75")},
+			{AccountType: entity.AccountTypeSaving, Currency: entity.CurrencyIDR, MinBalance: decimal.NewFromInt(1000000), AnnualRate: decimal.RequireFromString("0.50")},
+		}
+
+		accounts = []*entity.Account{
+			{ID: 1, AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, Currency: entity.CurrencyIDR},
+			{ID: 2, AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusClosed, Currency: entity.CurrencyIDR},
+			{ID: 3, AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusBlocked, Currency: entity.CurrencyIDR},
+			{ID: 4, AccountType: entity.AccountTypeTimeDeposit, Status: entity.AccountStatusActive, Currency: entity.CurrencyIDR},
+			{ID: 5, AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, Currency: entity.CurrencyIDR},
+			{ID: 6, AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, Currency: entity.CurrencyUSD},
+		}
+
+		accruals []*entity.InterestAccrual
+	)
+
+	interestRepository.EXPECT().FindInterestRateTiers(gomock.Any()).Return(tiers, nil)
+	accountRepository.EXPECT().FindAccounts(gomock.Any(), uint(0), DefaultAccrueInterestBatchSize).Return(accounts, nil)
+
+	// The balance of a closed account, a product without tiers or a currency without tiers isn't read
+	transactionRepository.EXPECT().FindBalanceAt(gomock.Any(), uint(1), endOfDay).Return(decimal.NewFromInt(50000000), nil)
+	transactionRepository.EXPECT().FindBalanceAt(gomock.Any(), uint(3), endOfDay).Return(decimal.NewFromInt(5000000), nil)
+	transactionRepository.EXPECT().FindBalanceAt(gomock.Any(), uint(5), endOfDay).Return(decimal.NewFromInt(500000), nil)
+
+	interestRepository.EXPECT().CreateInterestAccrual(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, accrual *entity.InterestAccrual) (*entity.InterestAccrual, error) {
+			if accrual.AccountID == 3 {
+				return nil, entity.ErrInterestAlreadyAccrued
+			}
+
+			accruals = append(accruals, accrual)
+			return accrual, nil
+		}).Times(2)
+
+	accrueInterestUsecase := NewAccrueInterestUsecase(
+		accountRepository,
+		transactionRepository,
+		interestRepository,
+		util.GetZapLogger(),
+	)
+
+	report, err := accrueInterestUsecase.AccrueInterest(context.Background(), date)
+
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2025, time.May, 31, 0, 0, 0, 0, time.UTC), report.Date)
+	assert.Equal(t, 1, report.Accrued)
+	assert.Equal(t, 1, report.AlreadyAccrued)
+	assert.Len(t, accruals, 1)
+
+	// 50.000.000 at 0,75% for 1/365 of a year
+	assert.Equal(t, uint(1), accruals[0].AccountID)
+	assert.Equal(t, report.Date, accruals[0].AccrualDate)
+	assert.Equal(t, "0.75", accruals[0].AnnualRate.String())
+	assert.Equal(t, "1027.3972602739", accruals[0].Amount.String())
+	assert.Equal(t, "1027.3972602739", report.Totals[entity.CurrencyIDR].String())
+}
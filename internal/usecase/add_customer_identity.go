@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+type addCustomerIdentityUsecase struct {
+	customerRepository         CustomerRepository
+	customerIdentityRepository CustomerIdentityRepository
+	logger                     util.Logger
+}
+
+func NewAddCustomerIdentityUsecase(
+	customerRepository CustomerRepository,
+	customerIdentityRepository CustomerIdentityRepository,
+	logger util.Logger,
+) *addCustomerIdentityUsecase {
+	return &addCustomerIdentityUsecase{
+		customerRepository:         customerRepository,
+		customerIdentityRepository: customerIdentityRepository,
+		logger:                     logger,
+	}
+}
+
+// AddCustomerIdentity attaches an identity document to a registered customer
+// A customer has at most one identity per type, and an identity number can't be shared by two customers
+func (a addCustomerIdentityUsecase) AddCustomerIdentity(ctx context.Context, params *entity.AddCustomerIdentityParams) (*entity.CustomerIdentity, error) {
+	var (
+		err       error
+		applyLock = false
+		logger    = a.logger.WithDuration(
+			ctx,
+			"addCustomerIdentityUsecase.AddCustomerIdentity",
+			map[string]interface{}{
+				"customer_id":     params.CustomerID,
+				"identity_type":   params.IdentityType,
+				"identity_number": params.IdentityNumber,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	if !params.IdentityType.IsSupported() {
+		err = entity.ErrUnsupportedIdentityType
+		return nil, err
+	}
+
+	if params.IdentityType == entity.IdentityTypeNIK {
+		if _, err = util.ParseNIK(params.IdentityNumber); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err = a.customerRepository.FindByID(ctx, params.CustomerID, applyLock); err != nil {
+		return nil, err
+	}
+
+	if err = validateIdentityNumber(ctx, a.customerIdentityRepository, params.IdentityType, params.IdentityNumber); err != nil {
+		return nil, err
+	}
+
+	// The unique constraint rejects a second identity of the same type
+	customerIdentity, err := a.customerIdentityRepository.CreateCustomerIdentity(ctx, &entity.CustomerIdentity{
+		CustomerID:     params.CustomerID,
+		IdentityType:   params.IdentityType,
+		IdentityNumber: params.IdentityNumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return customerIdentity, nil
+}
@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+type captureHoldUsecase struct {
+	accountRepository     AccountRepository
+	transactionRepository TransactionRepository
+	holdRepository        HoldRepository
+	idempotencyGuard      idempotencyGuard
+	ledger                ledger
+	outbox                outbox
+	logger                util.Logger
+}
+
+func NewCaptureHoldUsecase(
+	accountRepository AccountRepository,
+	transactionRepository TransactionRepository,
+	holdRepository HoldRepository,
+	transactionManager TransactionManager,
+	idempotencyKeyRepository IdempotencyKeyRepository,
+	journalRepository JournalRepository,
+	outboxRepository OutboxRepository,
+	logger util.Logger,
+) *captureHoldUsecase {
+	return &captureHoldUsecase{
+		accountRepository:     accountRepository,
+		transactionRepository: transactionRepository,
+		holdRepository:        holdRepository,
+		idempotencyGuard:      newIdempotencyGuard(idempotencyKeyRepository, transactionManager),
+		ledger:                newLedger(accountRepository, journalRepository),
+		outbox:                newOutbox(outboxRepository),
+		logger:                logger,
+	}
+}
+
+// CaptureHold debits the account of an active hold with the full or a part of its amount.
+// The captured amount is owed to the payment partner through the hold settlement system account,
+// the rest of the amount is released. A hold is captured once, a repeated capture returns ErrHoldNotActive
+// unless it repeats the idempotency key of the capture
+func (c captureHoldUsecase) CaptureHold(ctx context.Context, params *entity.CaptureHoldParams) (*entity.HoldCapture, error) {
+	var (
+		err     error
+		capture = new(entity.HoldCapture)
+		logger  = c.logger.WithDuration(
+			ctx,
+			"captureHoldUsecase.CaptureHold",
+			map[string]interface{}{
+				"hold_id":         params.HoldID,
+				"amount":          params.Amount,
+				"idempotency_key": params.IdempotencyKey,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	requestHash := hashRequest(strconv.FormatUint(uint64(params.HoldID), 10), params.Amount.String())
+
+	err = c.idempotencyGuard.Run(ctx, entity.IdempotencyScopeCaptureHold, params.IdempotencyKey, requestHash, capture, func(ctx context.Context) error {
+		account, hold, err := lockHold(ctx, c.accountRepository, c.holdRepository, params.HoldID)
+		if err != nil {
+			return err
+		}
+
+		amount := params.Amount
+		if amount.IsZero() {
+			amount = hold.Amount
+		}
+
+		if err := hold.ValidateCapture(amount, time.Now()); err != nil {
+			return err
+		}
+
+		if err := account.ValidateDebit(); err != nil {
+			return err
+		}
+
+		if err := account.ValidateAmount(amount, hold.Currency); err != nil {
+			return err
+		}
+
+		// Holds are placed by the payment partners, the debit is referenced by the hold and keeps the reference
+		// of the partner in its description so it can be traced to its order on the statement
+		debit, err := c.transactionRepository.CreateTransaction(ctx, &entity.Transaction{
+			AccountID:      account.ID,
+			Type:           entity.TransactionTypeDebit,
+			Amount:         amount,
+			InitialBalance: account.Balance,
+			FinalBalance:   account.Balance.Sub(amount),
+			Currency:       account.Currency,
+			Channel:        entity.ChannelPartnerAPI,
+			Reference:      hold.CaptureReference(),
+			Description:    hold.Reference,
+		})
+		if err != nil {
+			return err
+		}
+
+		// The whole hold is removed from the held amount, the part that isn't captured becomes available again
+		account.Balance = account.Balance.Sub(amount)
+		account.HeldAmount = account.HeldAmount.Sub(hold.Amount)
+		if _, err := c.accountRepository.UpdateAccount(ctx, account); err != nil {
+			return err
+		}
+
+		hold.Status = entity.HoldStatusCaptured
+		hold.CapturedAmount = amount
+		hold.TransactionID = debit.ID
+		hold, err = c.holdRepository.UpdateHold(ctx, hold)
+		if err != nil {
+			return err
+		}
+
+		settlement, err := c.ledger.SystemAccount(ctx, entity.SystemAccountHoldSettlement)
+		if err != nil {
+			return err
+		}
+
+		journal := entity.NewJournal("Capture hold dana").
+			Debit(account.ID, debit.ID, amount, account.Currency).
+			Credit(settlement.ID, 0, amount, account.Currency)
+
+		if err := c.ledger.Post(ctx, journal); err != nil {
+			return err
+		}
+
+		if err := c.outbox.RecordBalanceChanged(ctx, account, debit); err != nil {
+			return err
+		}
+
+		capture.Hold = hold
+		capture.Debit = debit
+		capture.Account = account
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return capture, nil
+}
@@ -0,0 +1,279 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	repositorymock "imansohibul.my.id/account-domain-service/internal/usecase/mock"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestCaptureHold(t *testing.T) {
+	tests := []struct {
+		name               string
+		holdStatus         entity.HoldStatus
+		expiresIn          time.Duration
+		amount             decimal.Decimal
+		expectedErr        error
+		expectedCaptured   string
+		expectedBalance    string
+		expectedHeldAmount string
+		expectedAvailable  string
+	}{
+		{
+			name:               "Full Capture",
+			holdStatus:         entity.HoldStatusActive,
+			expiresIn:          time.Hour,
+			amount:             decimal.Zero,
+			expectedCaptured:   "300000",
+			expectedBalance:    "700000",
+			expectedHeldAmount: "100000",
+			expectedAvailable:  "600000",
+		},
+		{
+			name:               "Partial Capture - Rest Released",
+			holdStatus:         entity.HoldStatusActive,
+			expiresIn:          time.Hour,
+			amount:             decimal.NewFromInt(120000),
+			expectedCaptured:   "120000",
+			expectedBalance:    "880000",
+			expectedHeldAmount: "100000",
+			expectedAvailable:  "780000",
+		},
+		{
+			name:        "Exceeds Hold Amount",
+			holdStatus:  entity.HoldStatusActive,
+			expiresIn:   time.Hour,
+			amount:      decimal.NewFromInt(300001),
+			expectedErr: entity.ErrHoldCaptureExceedsAmount,
+		},
+		{
+			name:        "Expired Before The Expiry Job",
+			holdStatus:  entity.HoldStatusActive,
+			expiresIn:   -time.Minute,
+			amount:      decimal.Zero,
+			expectedErr: entity.ErrHoldExpired,
+		},
+		{
+			name:        "Already Released",
+			holdStatus:  entity.HoldStatusReleased,
+			expiresIn:   time.Hour,
+			amount:      decimal.Zero,
+			expectedErr: entity.ErrHoldNotActive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctrl                  = gomock.NewController(t)
+				accountRepository     = repositorymock.NewMockAccountRepository(ctrl)
+				transactionRepository = repositorymock.NewMockTransactionRepository(ctrl)
+				holdRepository        = repositorymock.NewMockHoldRepository(ctrl)
+				transactionManager    = repositorymock.NewMockTransactionManager(ctrl)
+				journalRepository     = repositorymock.NewMockJournalRepository(ctrl)
+				outboxRepository      = repositorymock.NewMockOutboxRepository(ctrl)
+
+				// Another hold of 100.000 stays active on the account
+				account    = &entity.Account{ID: 1, AccountNumber: "1111111111", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, Currency: entity.CurrencyIDR, Balance: decimal.NewFromInt(1000000), HeldAmount: decimal.NewFromInt(400000)}
+				settlement = &entity.Account{ID: 8, AccountNumber: entity.SystemAccountHoldSettlement, AccountType: entity.AccountTypeInternal}
+				hold       = &entity.Hold{ID: 5, AccountID: account.ID, Amount: decimal.NewFromInt(300000), Currency: entity.CurrencyIDR, Status: tt.holdStatus, Reference: "ORDER-123", ExpiresAt: time.Now().Add(tt.expiresIn)}
+				journal    *entity.Journal
+			)
+
+			transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction)
+			holdRepository.EXPECT().FindHoldByID(gomock.Any(), hold.ID, false).Return(hold, nil)
+			accountRepository.EXPECT().FindByID(gomock.Any(), account.ID, true).Return(account, nil)
+			holdRepository.EXPECT().FindHoldByID(gomock.Any(), hold.ID, true).Return(hold, nil)
+			holdRepository.EXPECT().UpdateHold(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, h *entity.Hold) (*entity.Hold, error) {
+					return h, nil
+				}).AnyTimes()
+			accountRepository.EXPECT().FindSystemAccount(gomock.Any(), entity.SystemAccountHoldSettlement).Return(settlement, nil).AnyTimes()
+			accountRepository.EXPECT().UpdateAccount(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, a *entity.Account) (*entity.Account, error) {
+					return a, nil
+				}).AnyTimes()
+			transactionRepository.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error) {
+					transaction.ID = 42
+					return transaction, nil
+				}).AnyTimes()
+			journalRepository.EXPECT().CreateJournal(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, j *entity.Journal) (*entity.Journal, error) {
+					journal = j
+					return j, nil
+				}).AnyTimes()
+			outboxRepository.EXPECT().CreateOutboxEvent(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, event *entity.OutboxEvent) (*entity.OutboxEvent, error) {
+					return event, nil
+				}).AnyTimes()
+
+			captureHoldUsecase := NewCaptureHoldUsecase(
+				accountRepository,
+				transactionRepository,
+				holdRepository,
+				transactionManager,
+				repositorymock.NewMockIdempotencyKeyRepository(ctrl),
+				journalRepository,
+				outboxRepository,
+				util.GetZapLogger(),
+			)
+
+			capture, err := captureHoldUsecase.CaptureHold(context.Background(), &entity.CaptureHoldParams{
+				HoldID: hold.ID,
+				Amount: tt.amount,
+			})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.True(t, decimal.NewFromInt(1000000).Equal(account.Balance))
+				assert.True(t, decimal.NewFromInt(400000).Equal(account.HeldAmount))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.HoldStatusCaptured, capture.Hold.Status)
+			assert.Equal(t, tt.expectedCaptured, capture.Hold.CapturedAmount.String())
+			assert.Equal(t, capture.Debit.ID, capture.Hold.TransactionID)
+			assert.Equal(t, entity.TransactionTypeDebit, capture.Debit.Type)
+			assert.Equal(t, entity.ChannelPartnerAPI, capture.Debit.Channel)
+			assert.Equal(t, "hold-5", capture.Debit.Reference)
+			assert.Equal(t, "ORDER-123", capture.Debit.Description)
+			assert.Equal(t, tt.expectedBalance, capture.Account.Balance.String())
+			assert.Equal(t, tt.expectedHeldAmount, capture.Account.HeldAmount.String())
+			assert.Equal(t, tt.expectedAvailable, capture.Account.AvailableBalance().String())
+
+			// The captured amount is owed to the payment partner through the hold settlement account
+			assert.Len(t, journal.Entries, 2)
+			assert.Equal(t, settlement.ID, journal.Entries[1].AccountID)
+			assert.NoError(t, journal.Validate())
+		})
+	}
+}
+
+func TestCaptureHoldRepeatedIdempotencyKey(t *testing.T) {
+	var (
+		ctrl                  = gomock.NewController(t)
+		idempotencyRepository = repositorymock.NewMockIdempotencyKeyRepository(ctrl)
+		params                = &entity.CaptureHoldParams{HoldID: 5, Amount: decimal.NewFromInt(120000), IdempotencyKey: "capture-5"}
+		requestHash           = hashRequest("5", params.Amount.String())
+	)
+
+	// The retry returns the capture of the first request instead of failing with ErrHoldNotActive
+	idempotencyRepository.EXPECT().FindIdempotencyKey(gomock.Any(), entity.IdempotencyScopeCaptureHold, "capture-5").
+		Return(&entity.IdempotencyKey{RequestHash: requestHash, Response: `{"Hold":{"ID":5,"Status":2},"Debit":{"ID":42}}`}, nil)
+
+	captureHoldUsecase := NewCaptureHoldUsecase(
+		repositorymock.NewMockAccountRepository(ctrl),
+		repositorymock.NewMockTransactionRepository(ctrl),
+		repositorymock.NewMockHoldRepository(ctrl),
+		repositorymock.NewMockTransactionManager(ctrl),
+		idempotencyRepository,
+		repositorymock.NewMockJournalRepository(ctrl),
+		repositorymock.NewMockOutboxRepository(ctrl),
+		util.GetZapLogger(),
+	)
+
+	capture, err := captureHoldUsecase.CaptureHold(context.Background(), params)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.HoldStatusCaptured, capture.Hold.Status)
+	assert.Equal(t, uint(42), capture.Debit.ID)
+
+	// The same key with another amount is rejected
+	idempotencyRepository.EXPECT().FindIdempotencyKey(gomock.Any(), entity.IdempotencyScopeCaptureHold, "capture-5").
+		Return(&entity.IdempotencyKey{RequestHash: requestHash}, nil)
+
+	_, err = captureHoldUsecase.CaptureHold(context.Background(), &entity.CaptureHoldParams{HoldID: 5, IdempotencyKey: "capture-5"})
+	assert.Equal(t, entity.ErrIdempotencyKeyReused, err)
+}
+
+func TestCaptureHoldsWithSameReference(t *testing.T) {
+	var (
+		ctrl                  = gomock.NewController(t)
+		accountRepository     = repositorymock.NewMockAccountRepository(ctrl)
+		transactionRepository = repositorymock.NewMockTransactionRepository(ctrl)
+		holdRepository        = repositorymock.NewMockHoldRepository(ctrl)
+		transactionManager    = repositorymock.NewMockTransactionManager(ctrl)
+		journalRepository     = repositorymock.NewMockJournalRepository(ctrl)
+		outboxRepository      = repositorymock.NewMockOutboxRepository(ctrl)
+
+		account    = &entity.Account{ID: 1, AccountNumber: "1111111111", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, Currency: entity.CurrencyIDR, Balance: decimal.NewFromInt(1000000), HeldAmount: decimal.NewFromInt(300000)}
+		settlement = &entity.Account{ID: 8, AccountNumber: entity.SystemAccountHoldSettlement, AccountType: entity.AccountTypeInternal}
+
+		// The partner placed two holds for the same order
+		holds = []*entity.Hold{
+			{ID: 5, AccountID: account.ID, Amount: decimal.NewFromInt(100000), Currency: entity.CurrencyIDR, Status: entity.HoldStatusActive, Reference: "ORDER-123", ExpiresAt: time.Now().Add(time.Hour)},
+			{ID: 6, AccountID: account.ID, Amount: decimal.NewFromInt(200000), Currency: entity.CurrencyIDR, Status: entity.HoldStatusActive, Reference: "ORDER-123", ExpiresAt: time.Now().Add(time.Hour)},
+		}
+		references    = make(map[string]bool)
+		transactionID uint
+	)
+
+	transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction).Times(2)
+	accountRepository.EXPECT().FindByID(gomock.Any(), account.ID, true).Return(account, nil).Times(2)
+	for _, hold := range holds {
+		holdRepository.EXPECT().FindHoldByID(gomock.Any(), hold.ID, false).Return(hold, nil)
+		holdRepository.EXPECT().FindHoldByID(gomock.Any(), hold.ID, true).Return(hold, nil)
+	}
+	holdRepository.EXPECT().UpdateHold(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, h *entity.Hold) (*entity.Hold, error) {
+			return h, nil
+		}).Times(2)
+	accountRepository.EXPECT().FindSystemAccount(gomock.Any(), entity.SystemAccountHoldSettlement).Return(settlement, nil).Times(2)
+	accountRepository.EXPECT().UpdateAccount(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, a *entity.Account) (*entity.Account, error) {
+			return a, nil
+		}).Times(2)
+
+	// The transactions are unique per channel and reference
+	transactionRepository.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error) {
+			key := fmt.Sprintf("%d/%s", transaction.Channel, transaction.Reference)
+			if references[key] {
+				return nil, entity.ErrTransactionReferenceAlreadyExists
+			}
+
+			references[key] = true
+			transactionID++
+			transaction.ID = transactionID
+			return transaction, nil
+		}).Times(2)
+	journalRepository.EXPECT().CreateJournal(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, j *entity.Journal) (*entity.Journal, error) {
+			return j, nil
+		}).Times(2)
+	outboxRepository.EXPECT().CreateOutboxEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, event *entity.OutboxEvent) (*entity.OutboxEvent, error) {
+			return event, nil
+		}).Times(2)
+
+	captureHoldUsecase := NewCaptureHoldUsecase(
+		accountRepository,
+		transactionRepository,
+		holdRepository,
+		transactionManager,
+		repositorymock.NewMockIdempotencyKeyRepository(ctrl),
+		journalRepository,
+		outboxRepository,
+		util.GetZapLogger(),
+	)
+
+	for _, hold := range holds {
+		capture, err := captureHoldUsecase.CaptureHold(context.Background(), &entity.CaptureHoldParams{HoldID: hold.ID})
+
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("hold-%d", hold.ID), capture.Debit.Reference)
+		assert.Equal(t, "ORDER-123", capture.Debit.Description)
+	}
+
+	assert.True(t, decimal.NewFromInt(700000).Equal(account.Balance))
+	assert.True(t, account.HeldAmount.IsZero())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/avast/retry-go"
@@ -12,9 +13,6 @@ import (
 	"imansohibul.my.id/account-domain-service/util"
 )
 
-// DefaultAccountNumberLength is the length of the account number
-const DefaultAccountNumberLength = 10
-
 // DefaultMaxRetries is the maximum number of retries for creating an account
 const DefaultMaxRetries = 3
 
@@ -25,6 +23,9 @@ type createAccountUsecase struct {
 	customerRepository         CustomerRepository
 	customerIdentityRepository CustomerIdentityRepository
 	transactionRepository      TransactionRepository
+	idempotencyGuard           idempotencyGuard
+	outbox                     outbox
+	accountNumberGenerator     accountNumberGenerator
 	logger                     util.Logger
 }
 
@@ -34,6 +35,9 @@ func NewCreateAccountUsecase(
 	customerRepository CustomerRepository,
 	customerIdentityRepository CustomerIdentityRepository,
 	transactionRepository TransactionRepository,
+	idempotencyKeyRepository IdempotencyKeyRepository,
+	outboxRepository OutboxRepository,
+	accountNumberScheme util.AccountNumberScheme,
 	logger util.Logger,
 ) *createAccountUsecase {
 	return &createAccountUsecase{
@@ -42,6 +46,9 @@ func NewCreateAccountUsecase(
 		customerRepository:         customerRepository,
 		customerIdentityRepository: customerIdentityRepository,
 		transactionRepository:      transactionRepository,
+		idempotencyGuard:           newIdempotencyGuard(idempotencyKeyRepository, transactionManager),
+		outbox:                     newOutbox(outboxRepository),
+		accountNumberGenerator:     newAccountNumberGenerator(accountNumberScheme, accountRepository),
 		logger:                     logger,
 	}
 }
@@ -55,32 +62,73 @@ func (a createAccountUsecase) CreateAccount(ctx context.Context, params *entity.
 			map[string]interface{}{
 				"fullname":        params.Fullname,
 				"phone_number":    params.PhoneNumber,
+				"identity_type":   params.IdentityType,
 				"identity_number": params.IdentityNumber,
+				"birth_date":      params.BirthDate,
+				"gender":          params.Gender,
+				"currency":        params.Currency,
+				"idempotency_key": params.IdempotencyKey,
 			},
 		)
 	)
 
 	defer logger(&err)
 
-	// Validate phone number
-	err = a.validatePhoneNumber(ctx, params.PhoneNumber)
-	if err != nil {
+	if !params.IdentityType.IsSupported() {
+		err = entity.ErrUnsupportedIdentityType
+		return nil, err
+	}
+
+	if !params.Currency.IsSupported() {
+		err = entity.ErrUnsupportedCurrency
 		return nil, err
 	}
 
-	// Validate identity number
-	err = a.validateIdentityNumber(ctx, params.IdentityNumber)
+	if params.IdentityType == entity.IdentityTypeNIK {
+		if err = validateNIKHolder(params, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	// Variants of the same number e.g. 0812... and +62812... are checked and stored as one
+	phoneNumber, err := util.NormalizePhoneNumber(params.PhoneNumber)
 	if err != nil {
 		return nil, err
 	}
 
-	account := new(entity.Account)
+	var (
+		account     = new(entity.Account)
+		requestHash = hashRequest(
+			params.Fullname,
+			phoneNumber,
+			strconv.Itoa(int(params.IdentityType)),
+			params.IdentityNumber,
+			params.BirthDate.Format(time.DateOnly),
+			strconv.Itoa(int(params.Gender)),
+			string(params.Currency),
+		)
+	)
+
+	// The validations run inside the guard, so a retried request returns
+	// the created account instead of failing on its own phone number
+	err = a.idempotencyGuard.Run(ctx, entity.IdempotencyScopeCreateAccount, params.IdempotencyKey, requestHash, &account, func(ctx context.Context) error {
+		// Validate phone number
+		err := a.validatePhoneNumber(ctx, phoneNumber)
+		if err != nil {
+			return err
+		}
+
+		// Validate identity number
+		err = validateIdentityNumber(ctx, a.customerIdentityRepository, params.IdentityType, params.IdentityNumber)
+		if err != nil {
+			return err
+		}
 
-	err = a.transactionManager.WithTransaction(ctx, func(ctx context.Context) error {
 		// Create customer
 		customer, err := a.customerRepository.CreateCustomer(ctx, &entity.Customer{
 			Fullname:    params.Fullname,
-			PhoneNumber: params.PhoneNumber,
+			PhoneNumber: phoneNumber,
+			Tier:        entity.CustomerTierRegular,
 		})
 		if err != nil {
 			return err
@@ -89,7 +137,7 @@ func (a createAccountUsecase) CreateAccount(ctx context.Context, params *entity.
 		// Create customer identity
 		_, err = a.customerIdentityRepository.CreateCustomerIdentity(ctx, &entity.CustomerIdentity{
 			CustomerID:     customer.ID,
-			IdentityType:   entity.IdentityTypeNIK,
+			IdentityType:   params.IdentityType,
 			IdentityNumber: params.IdentityNumber,
 		})
 		if err != nil {
@@ -97,12 +145,18 @@ func (a createAccountUsecase) CreateAccount(ctx context.Context, params *entity.
 		}
 
 		// Check account
-		account, err = a.createAccountWithRetry(ctx, customer, DefaultMaxRetries)
+		account, err = createAccountWithRetry(ctx, a.accountNumberGenerator, a.accountRepository, a.logger, &entity.Account{
+			CustomerID:  customer.ID,
+			AccountType: entity.AccountTypeSaving,
+			Status:      entity.AccountStatusActive,
+			Balance:     decimal.Zero,
+			Currency:    params.Currency,
+		}, DefaultMaxRetries)
 		if err != nil {
 			return err
 		}
 
-		return nil
+		return a.outbox.RecordAccountCreated(ctx, account)
 	})
 
 	return account, err
@@ -122,9 +176,32 @@ func (a createAccountUsecase) validatePhoneNumber(ctx context.Context, phoneNumb
 	return nil
 }
 
-// validateIdentityNumber checks if the identity number already exists
-func (a createAccountUsecase) validateIdentityNumber(ctx context.Context, identityNumber string) error {
-	customerIdentity, err := a.customerIdentityRepository.FindByIdentity(ctx, entity.IdentityTypeNIK, identityNumber)
+// validateNIKHolder decodes the NIK and checks it against the applicant:
+// the birth date and gender when they are given, and the minimum age
+func validateNIKHolder(params *entity.CreateAccountParams, now time.Time) error {
+	nik, err := util.ParseNIK(params.IdentityNumber)
+	if err != nil {
+		return err
+	}
+
+	if !params.BirthDate.IsZero() && !params.BirthDate.Equal(nik.BirthDate) {
+		return entity.ErrCustomerIdentityMismatch
+	}
+
+	if params.Gender != entity.GenderUnspecified && params.Gender != nik.Gender {
+		return entity.ErrCustomerIdentityMismatch
+	}
+
+	if nik.Age(now) < entity.MinimumCustomerAge {
+		return entity.ErrCustomerUnderAge
+	}
+
+	return nil
+}
+
+// validateIdentityNumber checks if the identity number of the type is already registered to a customer
+func validateIdentityNumber(ctx context.Context, customerIdentityRepository CustomerIdentityRepository, identityType entity.CustomerIdentityType, identityNumber string) error {
+	customerIdentity, err := customerIdentityRepository.FindByIdentity(ctx, identityType, identityNumber)
 	if err != nil && err != entity.ErrCustomerIdentityNotFound {
 		return err
 	}
@@ -136,28 +213,23 @@ func (a createAccountUsecase) validateIdentityNumber(ctx context.Context, identi
 	return nil
 }
 
-// createAccountWithRetry validates if the account number is unique during the insert operation
-func (a createAccountUsecase) createAccountWithRetry(ctx context.Context, customer *entity.Customer, maxRetries int) (*entity.Account, error) {
+// createAccountWithRetry generates the account number and retries with a new one
+// when the number is already taken, the uniqueness is validated by the insert operation
+func createAccountWithRetry(ctx context.Context, accountNumberGenerator accountNumberGenerator, accountRepository AccountRepository, logger util.Logger, account *entity.Account, maxRetries int) (*entity.Account, error) {
 	var (
 		err     error
-		account = &entity.Account{
-			CustomerID:  customer.ID,
-			AccountType: entity.AccountTypeSaving,
-			Status:      entity.AccountStatusActive,
-			Balance:     decimal.Zero,
-			Currency:    entity.CurrencyIDR,
-		}
+		created *entity.Account
 	)
 
 	// Retry mechanism using retry-go
 	err = retry.Do(
 		func() error {
-			account.AccountNumber, err = util.GenerateSecureNumber(DefaultAccountNumberLength)
+			account.AccountNumber, err = accountNumberGenerator.Generate(ctx)
 			if err != nil {
 				return fmt.Errorf("failed to generate account number: %w", err)
 			}
 
-			account, err = a.accountRepository.CreateAccount(ctx, account)
+			created, err = accountRepository.CreateAccount(ctx, account)
 			return err
 		},
 		retry.Attempts(uint(maxRetries)),
@@ -168,7 +240,7 @@ func (a createAccountUsecase) createAccountWithRetry(ctx context.Context, custom
 		}),
 		retry.OnRetry(func(n uint, err error) {
 			// log the retry attempt
-			a.logger.Warn(ctx,
+			logger.Warn(ctx,
 				"Retrying account creation due to duplicate account number",
 				map[string]interface{}{
 					"attempt": n,
@@ -182,5 +254,5 @@ func (a createAccountUsecase) createAccountWithRetry(ctx context.Context, custom
 		return nil, fmt.Errorf("failed to create account after %d attempts: %w", maxRetries, err)
 	}
 
-	return account, nil
+	return created, nil
 }
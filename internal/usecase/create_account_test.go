@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+func TestValidateNIKHolder(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		params      *entity.CreateAccountParams
+		expectedErr error
+	}{
+		{
+			name:   "Matching Birth Date And Gender",
+			params: &entity.CreateAccountParams{IdentityNumber: "3204084501970002", BirthDate: time.Date(1997, time.January, 5, 0, 0, 0, 0, time.UTC), Gender: entity.GenderFemale},
+		},
+		{
+			name:   "Birth Date And Gender Not Given",
+			params: &entity.CreateAccountParams{IdentityNumber: "3204081901970002"},
+		},
+		{
+			name:        "Invalid NIK",
+			params:      &entity.CreateAccountParams{IdentityNumber: "0000000000000000"},
+			expectedErr: entity.ErrInvalidNIK,
+		},
+		{
+			name:        "Birth Date Mismatch",
+			params:      &entity.CreateAccountParams{IdentityNumber: "3204081901970002", BirthDate: time.Date(1997, time.January, 20, 0, 0, 0, 0, time.UTC)},
+			expectedErr: entity.ErrCustomerIdentityMismatch,
+		},
+		{
+			name:        "Gender Mismatch",
+			params:      &entity.CreateAccountParams{IdentityNumber: "3204081901970002", Gender: entity.GenderFemale},
+			expectedErr: entity.ErrCustomerIdentityMismatch,
+		},
+		{
+			name:        "Under Age",
+			params:      &entity.CreateAccountParams{IdentityNumber: "3204080206080002"},
+			expectedErr: entity.ErrCustomerUnderAge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateNIKHolder(tt.params, now)
+			if tt.expectedErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.True(t, errors.Is(err, tt.expectedErr), err)
+		})
+	}
+}
@@ -2,8 +2,8 @@ package usecase
 
 import (
 	"context"
+	"strconv"
 
-	"github.com/shopspring/decimal"
 	"imansohibul.my.id/account-domain-service/entity"
 	"imansohibul.my.id/account-domain-service/util"
 )
@@ -12,6 +12,9 @@ type depositUsecase struct {
 	accountRepository     AccountRepository
 	transactionRepository TransactionRepository
 	transactionManager    TransactionManager
+	idempotencyGuard      idempotencyGuard
+	ledger                ledger
+	outbox                outbox
 	logger                util.Logger
 }
 
@@ -19,17 +22,25 @@ func NewDepositUsecase(
 	accountRepository AccountRepository,
 	transactionRepository TransactionRepository,
 	transactionManager TransactionManager,
+	idempotencyKeyRepository IdempotencyKeyRepository,
+	journalRepository JournalRepository,
+	outboxRepository OutboxRepository,
 	logger util.Logger,
 ) *depositUsecase {
 	return &depositUsecase{
 		accountRepository:     accountRepository,
 		transactionRepository: transactionRepository,
 		transactionManager:    transactionManager,
+		idempotencyGuard:      newIdempotencyGuard(idempotencyKeyRepository, transactionManager),
+		ledger:                newLedger(accountRepository, journalRepository),
+		outbox:                newOutbox(outboxRepository),
 		logger:                logger,
 	}
 }
 
-func (d depositUsecase) Deposit(ctx context.Context, accountNumber string, amount decimal.Decimal) (*entity.Transaction, error) {
+// Deposit deposits money into an account
+// The reference of the deposit must be unique per channel, a repeated reference returns ErrTransactionReferenceAlreadyExists
+func (d depositUsecase) Deposit(ctx context.Context, params *entity.DepositParams) (*entity.Transaction, error) {
 	var (
 		applyLock = true
 		err       error
@@ -37,30 +48,54 @@ func (d depositUsecase) Deposit(ctx context.Context, accountNumber string, amoun
 			ctx,
 			"depositUsecase.Deposit",
 			map[string]interface{}{
-				"account_number": accountNumber,
-				"amount":         amount,
+				"account_number":  params.AccountNumber,
+				"amount":          params.Amount,
+				"currency":        params.Currency,
+				"channel":         params.Channel,
+				"reference":       params.Reference,
+				"idempotency_key": params.IdempotencyKey,
 			},
 		)
 	)
 
 	defer logger(&err)
 
-	transaction := new(entity.Transaction)
+	var (
+		amount      = params.Amount
+		transaction = new(entity.Transaction)
+		requestHash = hashRequest(params.AccountNumber, params.Amount.String(), string(params.Currency), strconv.Itoa(int(params.Channel)), params.Reference, params.Description, hashCounterparty(params.Counterparty))
+	)
 
-	err = d.transactionManager.WithTransaction(ctx, func(ctx context.Context) error {
+	err = d.idempotencyGuard.Run(ctx, entity.IdempotencyScopeDeposit, params.IdempotencyKey, requestHash, &transaction, func(ctx context.Context) error {
 		// Find account by account number and lock it for update
 		// to prevent concurrent access and update the balance
-		account, err := d.accountRepository.FindByAccountNumber(ctx, entity.AccountTypeSaving, accountNumber, applyLock)
+		account, err := d.accountRepository.FindByAccountNumber(ctx, params.AccountNumber, applyLock)
 		if err != nil {
 			return err
 		}
 
+		if err := account.ValidateCredit(); err != nil {
+			return err
+		}
+
+		if err := account.ValidateAmount(amount, params.Currency); err != nil {
+			return err
+		}
+
+		if err := account.Currency.ValidateDepositAmount(amount); err != nil {
+			return err
+		}
+
 		transaction.AccountID = account.ID
 		transaction.Amount = amount
 		transaction.Type = entity.TransactionTypeCredit
 		transaction.InitialBalance = account.Balance
 		transaction.FinalBalance = account.Balance.Add(amount)
 		transaction.Currency = account.Currency
+		transaction.Channel = params.Channel
+		transaction.Reference = params.Reference
+		transaction.Description = params.Description
+		transaction.Counterparty = params.Counterparty
 
 		account.Balance = account.Balance.Add(amount)
 		_, err = d.accountRepository.UpdateAccount(ctx, account)
@@ -73,8 +108,21 @@ func (d depositUsecase) Deposit(ctx context.Context, accountNumber string, amoun
 			return err
 		}
 
-		return nil
+		// Cash received by the bank is owed to the customer
+		cashIn, err := d.ledger.SystemAccount(ctx, entity.SystemAccountCashIn)
+		if err != nil {
+			return err
+		}
+
+		journal := entity.NewJournal("Setoran tunai").
+			Debit(cashIn.ID, 0, amount, account.Currency).
+			Credit(account.ID, transaction.ID, amount, account.Currency)
+
+		if err := d.ledger.Post(ctx, journal); err != nil {
+			return err
+		}
 
+		return d.outbox.RecordBalanceChanged(ctx, account, transaction)
 	})
 
 	return transaction, err
@@ -0,0 +1,36 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// exchange converts amounts between currencies with the effective exchange rates
+type exchange struct {
+	exchangeRateRepository ExchangeRateRepository
+}
+
+func newExchange(exchangeRateRepository ExchangeRateRepository) exchange {
+	return exchange{exchangeRateRepository: exchangeRateRepository}
+}
+
+// Convert converts the amount from the source to the target currency with the rate effective now
+// The rate of the pair can be quoted in either direction e.g. USD/IDR converts IDR to USD as well
+func (e exchange) Convert(ctx context.Context, amount decimal.Decimal, source, target entity.Currency) (*entity.CurrencyConversion, error) {
+	now := time.Now()
+
+	rate, err := e.exchangeRateRepository.FindExchangeRate(ctx, source, target, now)
+	if errors.Is(err, entity.ErrExchangeRateNotFound) {
+		rate, err = e.exchangeRateRepository.FindExchangeRate(ctx, target, source, now)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rate.Convert(amount, source)
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// DefaultExpireHoldsBatchSize is the number of holds read per query while releasing the expired holds
+const DefaultExpireHoldsBatchSize = 100
+
+type expireHoldsUsecase struct {
+	accountRepository  AccountRepository
+	holdRepository     HoldRepository
+	transactionManager TransactionManager
+	logger             util.Logger
+}
+
+func NewExpireHoldsUsecase(
+	accountRepository AccountRepository,
+	holdRepository HoldRepository,
+	transactionManager TransactionManager,
+	logger util.Logger,
+) *expireHoldsUsecase {
+	return &expireHoldsUsecase{
+		accountRepository:  accountRepository,
+		holdRepository:     holdRepository,
+		transactionManager: transactionManager,
+		logger:             logger,
+	}
+}
+
+// ExpireHolds releases the active holds that reached their expiry at now, their amounts become available again.
+// Every hold is expired in its own database transaction, a hold captured or released
+// while the job runs is left as it is
+func (e expireHoldsUsecase) ExpireHolds(ctx context.Context, now time.Time) (*entity.HoldExpiryReport, error) {
+	var (
+		err     error
+		afterID uint
+		report  = &entity.HoldExpiryReport{Date: now}
+		logger  = e.logger.WithDuration(
+			ctx,
+			"expireHoldsUsecase.ExpireHolds",
+			map[string]interface{}{
+				"now": now,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	for {
+		var holds []*entity.Hold
+		holds, err = e.holdRepository.FindExpiredHolds(ctx, now, afterID, DefaultExpireHoldsBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hold := range holds {
+			var expiry *entity.HoldExpiry
+			expiry, err = e.expireHold(ctx, hold.ID, now)
+			if err != nil {
+				return nil, err
+			}
+
+			if expiry != nil {
+				report.Expiries = append(report.Expiries, expiry)
+			}
+
+			afterID = hold.ID
+		}
+
+		if len(holds) < DefaultExpireHoldsBatchSize {
+			break
+		}
+	}
+
+	return report, nil
+}
+
+// expireHold releases a single hold, returns nil when the hold is no longer active
+func (e expireHoldsUsecase) expireHold(ctx context.Context, holdID uint, now time.Time) (*entity.HoldExpiry, error) {
+	var expiry *entity.HoldExpiry
+
+	err := e.transactionManager.WithTransaction(ctx, func(ctx context.Context) error {
+		account, hold, err := lockHold(ctx, e.accountRepository, e.holdRepository, holdID)
+		if err != nil {
+			return err
+		}
+
+		if !hold.IsExpired(now) {
+			return nil
+		}
+
+		account.HeldAmount = account.HeldAmount.Sub(hold.Amount)
+		if _, err := e.accountRepository.UpdateAccount(ctx, account); err != nil {
+			return err
+		}
+
+		hold.Status = entity.HoldStatusExpired
+		if _, err := e.holdRepository.UpdateHold(ctx, hold); err != nil {
+			return err
+		}
+
+		expiry = &entity.HoldExpiry{
+			HoldID:        hold.ID,
+			AccountNumber: account.AccountNumber,
+			Amount:        hold.Amount,
+			Currency:      hold.Currency,
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return expiry, nil
+}
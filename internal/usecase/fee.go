@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// feeSchedule computes and charges the fees of the withdrawals and the transfers
+type feeSchedule struct {
+	feeRuleRepository     FeeRuleRepository
+	transactionRepository TransactionRepository
+	ledger                ledger
+}
+
+func newFeeSchedule(
+	feeRuleRepository FeeRuleRepository,
+	transactionRepository TransactionRepository,
+	ledger ledger,
+) feeSchedule {
+	return feeSchedule{
+		feeRuleRepository:     feeRuleRepository,
+		transactionRepository: transactionRepository,
+		ledger:                ledger,
+	}
+}
+
+// Fee computes the fee of an operation on the amount in the currency of the account
+// The rules of the product of the account are used, an operation without rules is free
+func (f feeSchedule) Fee(ctx context.Context, account *entity.Account, operation entity.FeeOperation, channel entity.Channel, amount decimal.Decimal) (decimal.Decimal, error) {
+	if channel == entity.ChannelUnspecified {
+		channel = entity.DefaultChannel
+	}
+
+	rules, err := f.feeRuleRepository.FindFeeRules(ctx, account.AccountType, operation, channel, account.Currency)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return entity.CalculateFee(rules, amount, account.Currency), nil
+}
+
+// Charge debits the fee from the account as a fee transaction linked to the transaction it's charged for
+// and adds its entries to the journal of the operation, crediting the fee income system account.
+// The balance of the account is updated in memory only, the caller stores the account and posts the journal.
+// returns nil when the fee is zero
+func (f feeSchedule) Charge(ctx context.Context, account *entity.Account, fee decimal.Decimal, chargedFor *entity.Transaction, journal *entity.Journal) (*entity.Transaction, error) {
+	if !fee.IsPositive() {
+		return nil, nil
+	}
+
+	feeIncome, err := f.ledger.SystemAccount(ctx, entity.SystemAccountFeeIncome)
+	if err != nil {
+		return nil, err
+	}
+
+	feeTransaction, err := f.transactionRepository.CreateTransaction(ctx, &entity.Transaction{
+		AccountID:           account.ID,
+		Type:                entity.TransactionTypeFee,
+		Amount:              fee,
+		InitialBalance:      account.Balance,
+		FinalBalance:        account.Balance.Sub(fee),
+		Currency:            account.Currency,
+		LinkedTransactionID: chargedFor.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	account.Balance = account.Balance.Sub(fee)
+
+	journal.
+		Debit(account.ID, feeTransaction.ID, fee, account.Currency).
+		Credit(feeIncome.ID, 0, fee, account.Currency)
+
+	return feeTransaction, nil
+}
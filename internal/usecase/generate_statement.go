@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// DefaultStatementBatchSize is the number of transactions of a statement read per query
+const DefaultStatementBatchSize = 500
+
+type generateStatementUsecase struct {
+	accountRepository     AccountRepository
+	customerRepository    CustomerRepository
+	transactionRepository TransactionRepository
+	renderers             map[entity.StatementFormat]StatementRenderer
+	logger                util.Logger
+}
+
+func NewGenerateStatementUsecase(
+	accountRepository AccountRepository,
+	customerRepository CustomerRepository,
+	transactionRepository TransactionRepository,
+	renderers []StatementRenderer,
+	logger util.Logger,
+) *generateStatementUsecase {
+	usecase := &generateStatementUsecase{
+		accountRepository:     accountRepository,
+		customerRepository:    customerRepository,
+		transactionRepository: transactionRepository,
+		renderers:             make(map[entity.StatementFormat]StatementRenderer, len(renderers)),
+		logger:                logger,
+	}
+
+	for _, renderer := range renderers {
+		usecase.renderers[renderer.Format()] = renderer
+	}
+
+	return usecase
+}
+
+// GenerateStatement renders the statement (rekening koran) of an account for a period:
+// the balance at the start of the period, every transaction with the running balance,
+// the totals of the credits and the debits and the balance at the end of the period
+func (g generateStatementUsecase) GenerateStatement(ctx context.Context, params *entity.GenerateStatementParams) (*entity.StatementDocument, error) {
+	var (
+		err    error
+		logger = g.logger.WithDuration(
+			ctx,
+			"generateStatementUsecase.GenerateStatement",
+			map[string]interface{}{
+				"account_number": params.AccountNumber,
+				"from":           params.From,
+				"to":             params.To,
+				"format":         params.Format,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	if err = params.Validate(); err != nil {
+		return nil, err
+	}
+
+	var account *entity.Account
+	account, err = g.accountRepository.FindByAccountNumber(ctx, params.AccountNumber, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// System accounts have no statement, their transactions are only in the journal
+	if account.AccountType == entity.AccountTypeInternal {
+		err = entity.ErrAccountNotFound
+		return nil, err
+	}
+
+	var document *entity.StatementDocument
+	document, err = g.generate(ctx, account, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+// generate reads the statement of an account and renders it in the format of the params
+func (g generateStatementUsecase) generate(ctx context.Context, account *entity.Account, params *entity.GenerateStatementParams) (*entity.StatementDocument, error) {
+	renderer, ok := g.renderers[params.Format]
+	if !ok {
+		return nil, entity.ErrInvalidRequest
+	}
+
+	customer, err := g.customerRepository.FindByID(ctx, account.CustomerID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	openingBalance, err := g.transactionRepository.FindBalanceAt(ctx, account.ID, params.From)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		transactions      []*entity.Transaction
+		lastTransactionID uint
+	)
+
+	for {
+		batch, err := g.transactionRepository.FindTransactionsBetween(ctx, account.ID, params.From, params.To, lastTransactionID, DefaultStatementBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		transactions = append(transactions, batch...)
+		if len(batch) < DefaultStatementBatchSize {
+			break
+		}
+
+		lastTransactionID = batch[len(batch)-1].ID
+	}
+
+	statement := entity.NewStatement(account, customer.Fullname, params.From, params.To, openingBalance, transactions)
+
+	var content bytes.Buffer
+	if err := renderer.Render(statement, &content); err != nil {
+		return nil, err
+	}
+
+	return &entity.StatementDocument{
+		Statement: statement,
+		Format:    params.Format,
+		Content:   content.Bytes(),
+	}, nil
+}
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	repositorymock "imansohibul.my.id/account-domain-service/internal/usecase/mock"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestGenerateStatement(t *testing.T) {
+	var (
+		from = time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC)
+		to   = time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name             string
+		params           *entity.GenerateStatementParams
+		accountType      entity.AccountType
+		transactions     []*entity.Transaction
+		expectedErr      error
+		expectedBalances []string
+		expectedCredit   string
+		expectedDebit    string
+		expectedClosing  string
+	}{
+		{
+			name:        "Generated - Running Balance And Totals",
+			params:      &entity.GenerateStatementParams{AccountNumber: "1234567897", From: from, To: to, Format: entity.StatementFormatCSV},
+			accountType: entity.AccountTypeSaving,
+			transactions: []*entity.Transaction{
+				{ID: 11, Type: entity.TransactionTypeCredit, Amount: decimal.NewFromInt(50000)},
+				{ID: 12, Type: entity.TransactionTypeDebit, Amount: decimal.NewFromInt(20000)},
+				{ID: 13, Type: entity.TransactionTypeFee, Amount: decimal.NewFromInt(2500)},
+				{ID: 14, Type: entity.TransactionTypeReversalCredit, Amount: decimal.NewFromInt(2500)},
+				{ID: 15, Type: entity.TransactionTypeInterest, Amount: decimal.RequireFromString("3082.19")},
+				{ID: 16, Type: entity.TransactionTypeWithholdingTax, Amount: decimal.RequireFromString("616.43")},
+			},
+			expectedBalances: []string{"150000", "130000", "127500", "130000", "133082.19", "132465.76"},
+			expectedCredit:   "55582.19",
+			expectedDebit:    "23116.43",
+			expectedClosing:  "132465.76",
+		},
+		{
+			name:             "Generated - No Transactions",
+			params:           &entity.GenerateStatementParams{AccountNumber: "1234567897", From: from, To: to, Format: entity.StatementFormatCSV},
+			accountType:      entity.AccountTypeSaving,
+			expectedBalances: []string{},
+			expectedCredit:   "0",
+			expectedDebit:    "0",
+			expectedClosing:  "100000",
+		},
+		{
+			name:        "Failed - Period Longer Than A Year",
+			params:      &entity.GenerateStatementParams{AccountNumber: "1234567897", From: from, To: from.AddDate(1, 1, 0), Format: entity.StatementFormatCSV},
+			expectedErr: entity.ErrInvalidStatementPeriod,
+		},
+		{
+			name:        "Failed - Empty Period",
+			params:      &entity.GenerateStatementParams{AccountNumber: "1234567897", From: from, To: from, Format: entity.StatementFormatCSV},
+			expectedErr: entity.ErrInvalidStatementPeriod,
+		},
+		{
+			name:        "Failed - System Account",
+			params:      &entity.GenerateStatementParams{AccountNumber: entity.SystemAccountCashIn, From: from, To: to, Format: entity.StatementFormatCSV},
+			accountType: entity.AccountTypeInternal,
+			expectedErr: entity.ErrAccountNotFound,
+		},
+		{
+			name:        "Failed - Unsupported Format",
+			params:      &entity.GenerateStatementParams{AccountNumber: "1234567897", From: from, To: to, Format: "xlsx"},
+			accountType: entity.AccountTypeSaving,
+			expectedErr: entity.ErrInvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctrl                  = gomock.NewController(t)
+				accountRepository     = repositorymock.NewMockAccountRepository(ctrl)
+				customerRepository    = repositorymock.NewMockCustomerRepository(ctrl)
+				transactionRepository = repositorymock.NewMockTransactionRepository(ctrl)
+				renderer              = repositorymock.NewMockStatementRenderer(ctrl)
+
+				account  = &entity.Account{ID: 10, CustomerID: 3, AccountNumber: tt.params.AccountNumber, AccountType: tt.accountType, Currency: entity.CurrencyIDR}
+				rendered *entity.Statement
+			)
+
+			renderer.EXPECT().Format().Return(entity.StatementFormatCSV)
+			accountRepository.EXPECT().FindByAccountNumber(gomock.Any(), tt.params.AccountNumber, false).Return(account, nil).AnyTimes()
+			customerRepository.EXPECT().FindByID(gomock.Any(), account.CustomerID, false).Return(&entity.Customer{ID: 3, Fullname: "Budi Santoso"}, nil).AnyTimes()
+			transactionRepository.EXPECT().FindBalanceAt(gomock.Any(), account.ID, from).Return(decimal.NewFromInt(100000), nil).AnyTimes()
+			transactionRepository.EXPECT().FindTransactionsBetween(gomock.Any(), account.ID, from, to, uint(0), DefaultStatementBatchSize).Return(tt.transactions, nil).AnyTimes()
+			renderer.EXPECT().Render(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(statement *entity.Statement, writer io.Writer) error {
+					rendered = statement
+					_, err := writer.Write([]byte("statement"))
+					return err
+				}).AnyTimes()
+
+			generateStatementUsecase := NewGenerateStatementUsecase(
+				accountRepository,
+				customerRepository,
+				transactionRepository,
+				[]StatementRenderer{renderer},
+				util.GetZapLogger(),
+			)
+
+			document, err := generateStatementUsecase.GenerateStatement(context.Background(), tt.params)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, document)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, []byte("statement"), document.Content)
+			assert.Equal(t, "rekening-koran-1234567897-20250501-20250531.csv", document.Filename())
+			assert.Equal(t, "Budi Santoso", rendered.CustomerName)
+			assert.Equal(t, "100000", rendered.OpeningBalance.String())
+			assert.Equal(t, tt.expectedCredit, rendered.TotalCredit.String())
+			assert.Equal(t, tt.expectedDebit, rendered.TotalDebit.String())
+			assert.Equal(t, tt.expectedClosing, rendered.ClosingBalance.String())
+
+			balances := make([]string, 0, len(rendered.Lines))
+			for _, line := range rendered.Lines {
+				balances = append(balances, line.Balance.String())
+			}
+
+			assert.Equal(t, tt.expectedBalances, balances)
+		})
+	}
+}
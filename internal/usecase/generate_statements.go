@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// DefaultGenerateStatementsBatchSize is the number of accounts read per query by the month-end statement job
+const DefaultGenerateStatementsBatchSize = 100
+
+type generateStatementsUsecase struct {
+	accountRepository              AccountRepository
+	accountStatusHistoryRepository AccountStatusHistoryRepository
+	statementStore                 StatementStore
+	generator                      *generateStatementUsecase
+	logger                         util.Logger
+}
+
+func NewGenerateStatementsUsecase(
+	accountRepository AccountRepository,
+	accountStatusHistoryRepository AccountStatusHistoryRepository,
+	customerRepository CustomerRepository,
+	transactionRepository TransactionRepository,
+	renderers []StatementRenderer,
+	statementStore StatementStore,
+	logger util.Logger,
+) *generateStatementsUsecase {
+	return &generateStatementsUsecase{
+		accountRepository:              accountRepository,
+		accountStatusHistoryRepository: accountStatusHistoryRepository,
+		statementStore:                 statementStore,
+		generator:                      NewGenerateStatementUsecase(accountRepository, customerRepository, transactionRepository, renderers, logger),
+		logger:                         logger,
+	}
+}
+
+// GenerateStatements generates the statements of a month for every customer account in each of the formats
+// and saves them in the statement store. The accounts opened after the month or closed before it are skipped,
+// a statement that can't be generated or saved is reported as failed and the other statements are still generated
+func (g generateStatementsUsecase) GenerateStatements(ctx context.Context, month time.Time, formats []entity.StatementFormat) (*entity.StatementBatchReport, error) {
+	var (
+		err     error
+		afterID uint
+		from    = time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC)
+		report  = &entity.StatementBatchReport{Month: from}
+		logger  = g.logger.WithDuration(
+			ctx,
+			"generateStatementsUsecase.GenerateStatements",
+			map[string]interface{}{
+				"month":   from,
+				"formats": formats,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	for {
+		var accounts []*entity.Account
+		accounts, err = g.accountRepository.FindAccounts(ctx, afterID, DefaultGenerateStatementsBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, account := range accounts {
+			afterID = account.ID
+
+			// System accounts have no statement, their transactions are only in the journal
+			if account.AccountType == entity.AccountTypeInternal {
+				continue
+			}
+
+			params := &entity.GenerateStatementParams{
+				AccountNumber: account.AccountNumber,
+				From:          from,
+				To:            from.AddDate(0, 1, 0),
+			}
+
+			var outside bool
+			outside, err = g.isOutsidePeriod(ctx, account, params)
+			if err != nil {
+				return nil, err
+			}
+
+			if outside {
+				report.Skipped++
+				continue
+			}
+
+			for _, format := range formats {
+				params.Format = format
+
+				generated, generateErr := g.generateStatement(ctx, account, params)
+				if generateErr != nil && ctx.Err() != nil {
+					err = ctx.Err()
+					return nil, err
+				} else if generateErr != nil {
+					report.Failed = append(report.Failed, &entity.FailedStatement{
+						AccountNumber: account.AccountNumber,
+						Format:        format,
+						Reason:        statementFailureReason(generateErr),
+					})
+
+					g.logger.Warn(ctx, "Failed to generate statement", map[string]interface{}{
+						"account_number": account.AccountNumber,
+						"format":         format,
+						"error":          generateErr.Error(),
+					})
+					continue
+				}
+
+				report.Generated = append(report.Generated, generated)
+			}
+		}
+
+		if len(accounts) < DefaultGenerateStatementsBatchSize {
+			break
+		}
+	}
+
+	return report, nil
+}
+
+// isOutsidePeriod tells whether the account didn't exist during the period of the statement,
+// i.e. it was opened after the period or closed before it
+func (g generateStatementsUsecase) isOutsidePeriod(ctx context.Context, account *entity.Account, params *entity.GenerateStatementParams) (bool, error) {
+	if !account.CreatedAt.Before(params.To) {
+		return true, nil
+	}
+
+	if account.Status != entity.AccountStatusClosed {
+		return false, nil
+	}
+
+	// An account closed without a status history has its statement generated, the period may have transactions
+	closed, err := g.accountStatusHistoryRepository.FindLastAccountStatusHistory(ctx, account.ID, entity.AccountStatusClosed)
+	if err != nil && errors.Is(err, entity.ErrAccountStatusHistoryNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return closed.CreatedAt.Before(params.From), nil
+}
+
+// generateStatement generates the statement of an account in the format of the params and saves it
+func (g generateStatementsUsecase) generateStatement(ctx context.Context, account *entity.Account, params *entity.GenerateStatementParams) (*entity.GeneratedStatement, error) {
+	document, err := g.generator.generate(ctx, account, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := g.statementStore.Save(ctx, document); err != nil {
+		return nil, err
+	}
+
+	return &entity.GeneratedStatement{
+		AccountNumber:  account.AccountNumber,
+		Filename:       document.Filename(),
+		Transactions:   len(document.Statement.Lines),
+		ClosingBalance: document.Statement.ClosingBalance,
+		Currency:       account.Currency,
+	}, nil
+}
+
+// statementFailureReason returns the domain error code of the error, or its message when it's unexpected
+func statementFailureReason(err error) string {
+	var domainError *entity.DomainError
+	if errors.As(err, &domainError) {
+		return domainError.Code
+	}
+
+	return err.Error()
+}
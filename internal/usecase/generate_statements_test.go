@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	repositorymock "imansohibul.my.id/account-domain-service/internal/usecase/mock"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestGenerateStatements(t *testing.T) {
+	var (
+		ctrl                           = gomock.NewController(t)
+		accountRepository              = repositorymock.NewMockAccountRepository(ctrl)
+		accountStatusHistoryRepository = repositorymock.NewMockAccountStatusHistoryRepository(ctrl)
+		customerRepository             = repositorymock.NewMockCustomerRepository(ctrl)
+		transactionRepository          = repositorymock.NewMockTransactionRepository(ctrl)
+		renderer                       = repositorymock.NewMockStatementRenderer(ctrl)
+		statementStore                 = repositorymock.NewMockStatementStore(ctrl)
+
+		from     = time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC)
+		to       = time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+		openedAt = time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC)
+
+		system         = &entity.Account{ID: 1, AccountNumber: entity.SystemAccountCashIn, AccountType: entity.AccountTypeInternal}
+		openedAfter    = &entity.Account{ID: 11, CustomerID: 3, AccountNumber: "1000000011", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, CreatedAt: to}
+		closedBefore   = &entity.Account{ID: 12, CustomerID: 3, AccountNumber: "1000000012", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusClosed, CreatedAt: openedAt}
+		closedDuring   = &entity.Account{ID: 13, CustomerID: 3, AccountNumber: "1000000013", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusClosed, CreatedAt: openedAt}
+		unknownHolder  = &entity.Account{ID: 14, CustomerID: 4, AccountNumber: "1000000014", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, CreatedAt: openedAt}
+		active         = &entity.Account{ID: 15, CustomerID: 3, AccountNumber: "1000000015", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, CreatedAt: openedAt}
+		savedFilenames []string
+	)
+
+	accountRepository.EXPECT().FindAccounts(gomock.Any(), uint(0), DefaultGenerateStatementsBatchSize).
+		Return([]*entity.Account{system, openedAfter, closedBefore, closedDuring, unknownHolder, active}, nil)
+	accountStatusHistoryRepository.EXPECT().FindLastAccountStatusHistory(gomock.Any(), closedBefore.ID, entity.AccountStatusClosed).
+		Return(&entity.AccountStatusHistory{CreatedAt: time.Date(2025, time.April, 30, 15, 0, 0, 0, time.UTC)}, nil)
+	accountStatusHistoryRepository.EXPECT().FindLastAccountStatusHistory(gomock.Any(), closedDuring.ID, entity.AccountStatusClosed).
+		Return(&entity.AccountStatusHistory{CreatedAt: time.Date(2025, time.May, 20, 10, 0, 0, 0, time.UTC)}, nil)
+
+	// The holder of an account can't be found, the accounts after it still get their statement
+	customerRepository.EXPECT().FindByID(gomock.Any(), uint(3), false).Return(&entity.Customer{ID: 3, Fullname: "Budi Santoso"}, nil).Times(2)
+	customerRepository.EXPECT().FindByID(gomock.Any(), uint(4), false).Return(nil, entity.ErrCustomerNotFound)
+	transactionRepository.EXPECT().FindBalanceAt(gomock.Any(), gomock.Any(), from).Return(decimal.Zero, nil).Times(2)
+	transactionRepository.EXPECT().FindTransactionsBetween(gomock.Any(), gomock.Any(), from, to, uint(0), DefaultStatementBatchSize).Return(nil, nil).Times(2)
+	renderer.EXPECT().Format().Return(entity.StatementFormatCSV)
+	renderer.EXPECT().Render(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(statement *entity.Statement, writer io.Writer) error {
+			_, err := writer.Write([]byte("statement"))
+			return err
+		}).Times(2)
+	statementStore.EXPECT().Save(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, document *entity.StatementDocument) error {
+			savedFilenames = append(savedFilenames, document.Filename())
+			return nil
+		}).Times(2)
+
+	generateStatementsUsecase := NewGenerateStatementsUsecase(
+		accountRepository,
+		accountStatusHistoryRepository,
+		customerRepository,
+		transactionRepository,
+		[]StatementRenderer{renderer},
+		statementStore,
+		util.GetZapLogger(),
+	)
+
+	report, err := generateStatementsUsecase.GenerateStatements(context.Background(), from, []entity.StatementFormat{entity.StatementFormatCSV})
+
+	assert.NoError(t, err)
+	assert.Equal(t, from, report.Month)
+	assert.Equal(t, 2, report.Skipped)
+	assert.Equal(t, []*entity.FailedStatement{
+		{AccountNumber: unknownHolder.AccountNumber, Format: entity.StatementFormatCSV, Reason: entity.ErrCustomerNotFound.Code},
+	}, report.Failed)
+	assert.Len(t, report.Generated, 2)
+	assert.Equal(t, closedDuring.AccountNumber, report.Generated[0].AccountNumber)
+	assert.Equal(t, active.AccountNumber, report.Generated[1].AccountNumber)
+	assert.Equal(t, []string{
+		"rekening-koran-1000000013-20250501-20250531.csv",
+		"rekening-koran-1000000015-20250501-20250531.csv",
+	}, savedFilenames)
+}
@@ -3,7 +3,6 @@ package usecase
 import (
 	"context"
 
-	"github.com/shopspring/decimal"
 	"imansohibul.my.id/account-domain-service/entity"
 	"imansohibul.my.id/account-domain-service/util"
 )
@@ -23,7 +22,8 @@ func NewGetBalanceUsecase(
 	}
 }
 
-func (g getBalanceUsecase) GetBalance(ctx context.Context, accountNumber string) (decimal.Decimal, error) {
+// GetBalance returns the ledger balance of an account and its available balance, net of the active holds
+func (g getBalanceUsecase) GetBalance(ctx context.Context, accountNumber string) (*entity.Balance, error) {
 	var (
 		err       error
 		applyLock = false
@@ -38,10 +38,14 @@ func (g getBalanceUsecase) GetBalance(ctx context.Context, accountNumber string)
 
 	defer logger(&err)
 
-	account, err := g.accountRepository.FindByAccountNumber(ctx, entity.AccountTypeSaving, accountNumber, applyLock)
+	account, err := g.accountRepository.FindByAccountNumber(ctx, accountNumber, applyLock)
 	if err != nil {
-		return decimal.Zero, err
+		return nil, err
 	}
 
-	return account.Balance, nil
+	return &entity.Balance{
+		Ledger:    account.Balance,
+		Available: account.AvailableBalance(),
+		Currency:  account.Currency,
+	}, nil
 }
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+type getCustomerUsecase struct {
+	customerRepository         CustomerRepository
+	customerIdentityRepository CustomerIdentityRepository
+	accountRepository          AccountRepository
+	logger                     util.Logger
+}
+
+func NewGetCustomerUsecase(
+	customerRepository CustomerRepository,
+	customerIdentityRepository CustomerIdentityRepository,
+	accountRepository AccountRepository,
+	logger util.Logger,
+) *getCustomerUsecase {
+	return &getCustomerUsecase{
+		customerRepository:         customerRepository,
+		customerIdentityRepository: customerIdentityRepository,
+		accountRepository:          accountRepository,
+		logger:                     logger,
+	}
+}
+
+func (g getCustomerUsecase) GetCustomer(ctx context.Context, customerID uint) (*entity.CustomerProfile, error) {
+	var (
+		err       error
+		applyLock = false
+		logger    = g.logger.WithDuration(
+			ctx,
+			"getCustomerUsecase.GetCustomer",
+			map[string]interface{}{
+				"customer_id": customerID,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	profile := new(entity.CustomerProfile)
+
+	profile.Customer, err = g.customerRepository.FindByID(ctx, customerID, applyLock)
+	if err != nil {
+		return nil, err
+	}
+
+	profile.Identities, err = g.customerIdentityRepository.FindByCustomerID(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile.Accounts, err = g.accountRepository.FindByCustomerID(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+type getTrialBalanceUsecase struct {
+	journalRepository JournalRepository
+	logger            util.Logger
+}
+
+func NewGetTrialBalanceUsecase(
+	journalRepository JournalRepository,
+	logger util.Logger,
+) *getTrialBalanceUsecase {
+	return &getTrialBalanceUsecase{
+		journalRepository: journalRepository,
+		logger:            logger,
+	}
+}
+
+func (g getTrialBalanceUsecase) GetTrialBalance(ctx context.Context) (*entity.TrialBalance, error) {
+	var (
+		err    error
+		logger = g.logger.WithDuration(
+			ctx,
+			"getTrialBalanceUsecase.GetTrialBalance",
+			map[string]interface{}{},
+		)
+	)
+
+	defer logger(&err)
+
+	lines, err := g.journalRepository.FindTrialBalance(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	trialBalance := entity.NewTrialBalance(lines)
+	if !trialBalance.IsBalanced() {
+		// Should never happen since every journal is validated before it's posted
+		g.logger.Error(ctx, "ledger is not balanced", entity.ErrUnbalancedJournal, map[string]interface{}{
+			"total_debit":  trialBalance.TotalDebit,
+			"total_credit": trialBalance.TotalCredit,
+		})
+	}
+
+	return trialBalance, nil
+}
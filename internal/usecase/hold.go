@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// lockHold finds and locks a hold and its account for update.
+// The account is always locked before the hold, like when the hold is placed,
+// so a capture, a release and the expiry job can never wait on each other (deadlock)
+func lockHold(ctx context.Context, accountRepository AccountRepository, holdRepository HoldRepository, holdID uint) (*entity.Account, *entity.Hold, error) {
+	applyLock := true
+
+	hold, err := holdRepository.FindHoldByID(ctx, holdID, !applyLock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	account, err := accountRepository.FindByID(ctx, hold.AccountID, applyLock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Read the hold again under the lock, it may have changed while the account was locked
+	hold, err = holdRepository.FindHoldByID(ctx, holdID, applyLock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return account, hold, nil
+}
@@ -0,0 +1,114 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// idempotencyGuard runs a usecase at most once per idempotency key
+type idempotencyGuard struct {
+	idempotencyKeyRepository IdempotencyKeyRepository
+	transactionManager       TransactionManager
+}
+
+func newIdempotencyGuard(
+	idempotencyKeyRepository IdempotencyKeyRepository,
+	transactionManager TransactionManager,
+) idempotencyGuard {
+	return idempotencyGuard{
+		idempotencyKeyRepository: idempotencyKeyRepository,
+		transactionManager:       transactionManager,
+	}
+}
+
+// Run executes fn inside a database transaction guarded by the idempotency key.
+// The key is inserted in the same transaction as fn, together with the JSON encoded result,
+// so a retried request with the same key and payload decodes the stored result instead of executing fn again.
+// result must be a pointer to the value that fn sets.
+// Without a key fn is simply executed inside a database transaction.
+func (g idempotencyGuard) Run(ctx context.Context, scope entity.IdempotencyScope, key, requestHash string, result interface{}, fn func(ctx context.Context) error) error {
+	if key == "" {
+		return g.transactionManager.WithTransaction(ctx, fn)
+	}
+
+	replayed, err := g.replay(ctx, scope, key, requestHash, result)
+	if err != nil || replayed {
+		return err
+	}
+
+	err = g.transactionManager.WithTransaction(ctx, func(ctx context.Context) error {
+		// The unique constraint makes a concurrent request with the same key wait
+		// until this transaction is finished, then it fails and replays our result
+		idempotencyKey, err := g.idempotencyKeyRepository.CreateIdempotencyKey(ctx, &entity.IdempotencyKey{
+			Scope:       scope,
+			Key:         key,
+			RequestHash: requestHash,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := fn(ctx); err != nil {
+			return err
+		}
+
+		response, err := json.Marshal(result)
+		if err != nil {
+			return fmt.Errorf("failed to encode idempotent response: %w", err)
+		}
+
+		idempotencyKey.Response = string(response)
+		_, err = g.idempotencyKeyRepository.UpdateIdempotencyKey(ctx, idempotencyKey)
+		return err
+	})
+
+	if errors.Is(err, entity.ErrIdempotencyKeyAlreadyExists) {
+		_, err = g.replay(ctx, scope, key, requestHash, result)
+	}
+
+	return err
+}
+
+// replay decodes the stored result of a previous request with the same key into result
+// returns false when the key has never been used
+func (g idempotencyGuard) replay(ctx context.Context, scope entity.IdempotencyScope, key, requestHash string, result interface{}) (bool, error) {
+	idempotencyKey, err := g.idempotencyKeyRepository.FindIdempotencyKey(ctx, scope, key)
+	if errors.Is(err, entity.ErrIdempotencyKeyNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	if idempotencyKey.RequestHash != requestHash {
+		return false, entity.ErrIdempotencyKeyReused
+	}
+
+	if err := json.Unmarshal([]byte(idempotencyKey.Response), result); err != nil {
+		return false, fmt.Errorf("failed to decode idempotent response: %w", err)
+	}
+
+	return true, nil
+}
+
+// hashRequest returns the SHA-256 (hex) of the request fields
+// used to detect a key that is reused with a different payload
+func hashRequest(fields ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(fields, "\x1f")))
+	return hex.EncodeToString(sum[:])
+}
+
+// hashCounterparty returns the counterparty as a single field of the request hash, empty when not given
+func hashCounterparty(counterparty *entity.Counterparty) string {
+	if counterparty == nil {
+		return ""
+	}
+
+	return hashRequest(counterparty.Name, counterparty.AccountNumber)
+}
@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	repositorymock "imansohibul.my.id/account-domain-service/internal/usecase/mock"
+)
+
+func TestIdempotencyGuardRun(t *testing.T) {
+	requestHash := hashRequest("1234567890", "50000")
+
+	tests := []struct {
+		name           string
+		key            string
+		mockSetup      func(*repositorymock.MockIdempotencyKeyRepository, *repositorymock.MockTransactionManager)
+		expectedCalls  int
+		expectedResult string
+		expectedErr    error
+	}{
+		{
+			name: "Without Key - Executes Once",
+			key:  "",
+			mockSetup: func(repo *repositorymock.MockIdempotencyKeyRepository, tm *repositorymock.MockTransactionManager) {
+				tm.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction)
+			},
+			expectedCalls:  1,
+			expectedResult: "executed",
+		},
+		{
+			name: "New Key - Executes And Stores Response",
+			key:  "key-1",
+			mockSetup: func(repo *repositorymock.MockIdempotencyKeyRepository, tm *repositorymock.MockTransactionManager) {
+				repo.EXPECT().FindIdempotencyKey(gomock.Any(), entity.IdempotencyScopeDeposit, "key-1").Return(nil, entity.ErrIdempotencyKeyNotFound)
+				tm.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction)
+				repo.EXPECT().CreateIdempotencyKey(gomock.Any(), gomock.Any()).Return(&entity.IdempotencyKey{ID: 1}, nil)
+				repo.EXPECT().UpdateIdempotencyKey(gomock.Any(), &entity.IdempotencyKey{ID: 1, Response: `"executed"`}).Return(&entity.IdempotencyKey{ID: 1}, nil)
+			},
+			expectedCalls:  1,
+			expectedResult: "executed",
+		},
+		{
+			name: "Repeated Key - Returns Stored Response",
+			key:  "key-1",
+			mockSetup: func(repo *repositorymock.MockIdempotencyKeyRepository, tm *repositorymock.MockTransactionManager) {
+				repo.EXPECT().FindIdempotencyKey(gomock.Any(), entity.IdempotencyScopeDeposit, "key-1").
+					Return(&entity.IdempotencyKey{RequestHash: requestHash, Response: `"stored"`}, nil)
+			},
+			expectedCalls:  0,
+			expectedResult: "stored",
+		},
+		{
+			name: "Repeated Key With Different Payload - Rejected",
+			key:  "key-1",
+			mockSetup: func(repo *repositorymock.MockIdempotencyKeyRepository, tm *repositorymock.MockTransactionManager) {
+				repo.EXPECT().FindIdempotencyKey(gomock.Any(), entity.IdempotencyScopeDeposit, "key-1").
+					Return(&entity.IdempotencyKey{RequestHash: hashRequest("1234567890", "75000"), Response: `"stored"`}, nil)
+			},
+			expectedCalls: 0,
+			expectedErr:   entity.ErrIdempotencyKeyReused,
+		},
+		{
+			name: "Concurrent Key - Returns Response Of The Winner",
+			key:  "key-1",
+			mockSetup: func(repo *repositorymock.MockIdempotencyKeyRepository, tm *repositorymock.MockTransactionManager) {
+				gomock.InOrder(
+					repo.EXPECT().FindIdempotencyKey(gomock.Any(), entity.IdempotencyScopeDeposit, "key-1").Return(nil, entity.ErrIdempotencyKeyNotFound),
+					repo.EXPECT().FindIdempotencyKey(gomock.Any(), entity.IdempotencyScopeDeposit, "key-1").
+						Return(&entity.IdempotencyKey{RequestHash: requestHash, Response: `"winner"`}, nil),
+				)
+				tm.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction)
+				repo.EXPECT().CreateIdempotencyKey(gomock.Any(), gomock.Any()).Return(nil, entity.ErrIdempotencyKeyAlreadyExists)
+			},
+			expectedCalls:  0,
+			expectedResult: "winner",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := repositorymock.NewMockIdempotencyKeyRepository(ctrl)
+			tm := repositorymock.NewMockTransactionManager(ctrl)
+			tt.mockSetup(repo, tm)
+
+			var (
+				calls  int
+				result string
+				guard  = newIdempotencyGuard(repo, tm)
+			)
+
+			err := guard.Run(context.Background(), entity.IdempotencyScopeDeposit, tt.key, requestHash, &result, func(ctx context.Context) error {
+				calls++
+				result = "executed"
+				return nil
+			})
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedCalls, calls)
+			assert.Equal(t, tt.expectedResult, result)
+		})
+	}
+}
+
+func withTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
@@ -0,0 +1,40 @@
+package usecase
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// ledger posts the double-entry journal of every balance movement
+type ledger struct {
+	accountRepository AccountRepository
+	journalRepository JournalRepository
+}
+
+func newLedger(
+	accountRepository AccountRepository,
+	journalRepository JournalRepository,
+) ledger {
+	return ledger{
+		accountRepository: accountRepository,
+		journalRepository: journalRepository,
+	}
+}
+
+// SystemAccount finds an internal system account by its account number
+// System accounts are never locked since their balance column is not maintained
+func (l ledger) SystemAccount(ctx context.Context, accountNumber string) (*entity.Account, error) {
+	return l.accountRepository.FindSystemAccount(ctx, accountNumber)
+}
+
+// Post validates that the journal is balanced and stores it
+// It must be called inside the database transaction of the balance update
+func (l ledger) Post(ctx context.Context, journal *entity.Journal) error {
+	if err := journal.Validate(); err != nil {
+		return err
+	}
+
+	_, err := l.journalRepository.CreateJournal(ctx, journal)
+	return err
+}
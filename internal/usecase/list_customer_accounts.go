@@ -0,0 +1,54 @@
+package usecase
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+type listCustomerAccountsUsecase struct {
+	accountRepository  AccountRepository
+	customerRepository CustomerRepository
+	logger             util.Logger
+}
+
+func NewListCustomerAccountsUsecase(
+	accountRepository AccountRepository,
+	customerRepository CustomerRepository,
+	logger util.Logger,
+) *listCustomerAccountsUsecase {
+	return &listCustomerAccountsUsecase{
+		accountRepository:  accountRepository,
+		customerRepository: customerRepository,
+		logger:             logger,
+	}
+}
+
+func (l listCustomerAccountsUsecase) ListCustomerAccounts(ctx context.Context, customerID uint) ([]*entity.Account, error) {
+	var (
+		err       error
+		applyLock = false
+		logger    = l.logger.WithDuration(
+			ctx,
+			"listCustomerAccountsUsecase.ListCustomerAccounts",
+			map[string]interface{}{
+				"customer_id": customerID,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	// A customer without accounts is returned as an empty list, an unknown customer is not found
+	if _, err = l.customerRepository.FindByID(ctx, customerID, applyLock); err != nil {
+		return nil, err
+	}
+
+	accounts, err := l.accountRepository.FindByCustomerID(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+type listTransactionsUsecase struct {
+	accountRepository     AccountRepository
+	transactionRepository TransactionRepository
+	logger                util.Logger
+}
+
+func NewListTransactionsUsecase(
+	accountRepository AccountRepository,
+	transactionRepository TransactionRepository,
+	logger util.Logger,
+) *listTransactionsUsecase {
+	return &listTransactionsUsecase{
+		accountRepository:     accountRepository,
+		transactionRepository: transactionRepository,
+		logger:                logger,
+	}
+}
+
+func (l listTransactionsUsecase) ListTransactions(ctx context.Context, params *entity.ListTransactionsParams) (*entity.TransactionPage, error) {
+	var (
+		err       error
+		applyLock = false
+		logger    = l.logger.WithDuration(
+			ctx,
+			"listTransactionsUsecase.ListTransactions",
+			map[string]interface{}{
+				"account_number": params.AccountNumber,
+				"type":           params.Type,
+				"cursor":         params.Cursor,
+				"limit":          params.Limit,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	filter := &entity.TransactionFilter{
+		Type:      params.Type,
+		StartTime: params.StartTime,
+		EndTime:   params.EndTime,
+		MinAmount: params.MinAmount,
+		MaxAmount: params.MaxAmount,
+		Limit:     params.Limit,
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = entity.DefaultTransactionPageSize
+	} else if filter.Limit > entity.MaxTransactionPageSize {
+		filter.Limit = entity.MaxTransactionPageSize
+	}
+
+	if params.Cursor != "" {
+		filter.After, err = entity.DecodeTransactionCursor(params.Cursor)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	account, err := l.accountRepository.FindByAccountNumber(ctx, params.AccountNumber, applyLock)
+	if err != nil {
+		return nil, err
+	}
+
+	filter.AccountID = account.ID
+	pageSize := filter.Limit
+
+	// Fetch one extra row to know whether there is a next page
+	filter.Limit = pageSize + 1
+	transactions, err := l.transactionRepository.FindTransactions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &entity.TransactionPage{Transactions: transactions}
+	if len(transactions) > pageSize {
+		page.Transactions = transactions[:pageSize]
+		page.NextCursor = entity.NewTransactionCursor(page.Transactions[pageSize-1]).Encode()
+	}
+
+	return page, nil
+}
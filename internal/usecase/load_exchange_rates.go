@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+type loadExchangeRatesUsecase struct {
+	exchangeRateRepository ExchangeRateRepository
+	logger                 util.Logger
+}
+
+func NewLoadExchangeRatesUsecase(
+	exchangeRateRepository ExchangeRateRepository,
+	logger util.Logger,
+) *loadExchangeRatesUsecase {
+	return &loadExchangeRatesUsecase{
+		exchangeRateRepository: exchangeRateRepository,
+		logger:                 logger,
+	}
+}
+
+// LoadExchangeRates stores the exchange rates, all of them or none when one is invalid
+// A rate of a pair that is already effective at the same time is replaced
+func (l loadExchangeRatesUsecase) LoadExchangeRates(ctx context.Context, exchangeRates []*entity.ExchangeRate) error {
+	var (
+		err    error
+		logger = l.logger.WithDuration(
+			ctx,
+			"loadExchangeRatesUsecase.LoadExchangeRates",
+			map[string]interface{}{
+				"count": len(exchangeRates),
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	if len(exchangeRates) == 0 {
+		err = entity.ErrInvalidExchangeRate
+		return err
+	}
+
+	type rateKey struct {
+		baseCurrency  entity.Currency
+		quoteCurrency entity.Currency
+		effectiveAt   int64
+	}
+
+	// A pair can only have one rate per effective time, the database rejects duplicates within a single insert
+	keys := make(map[rateKey]struct{}, len(exchangeRates))
+	for _, rate := range exchangeRates {
+		if err = rate.Validate(); err != nil {
+			return err
+		}
+
+		key := rateKey{rate.BaseCurrency, rate.QuoteCurrency, rate.EffectiveAt.UnixNano()}
+		if _, found := keys[key]; found {
+			err = entity.ErrInvalidExchangeRate
+			return err
+		}
+
+		keys[key] = struct{}{}
+	}
+
+	err = l.exchangeRateRepository.CreateExchangeRates(ctx, exchangeRates)
+	return err
+}
@@ -0,0 +1,340 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// DefaultMatureTimeDepositsBatchSize is the number of time deposits read per query while processing the maturities
+const DefaultMatureTimeDepositsBatchSize = 100
+
+type matureTimeDepositsUsecase struct {
+	accountRepository              AccountRepository
+	transactionRepository          TransactionRepository
+	timeDepositRepository          TimeDepositRepository
+	accountStatusHistoryRepository AccountStatusHistoryRepository
+	transactionManager             TransactionManager
+	ledger                         ledger
+	outbox                         outbox
+	taxPolicy                      entity.InterestTaxPolicy
+	logger                         util.Logger
+}
+
+func NewMatureTimeDepositsUsecase(
+	accountRepository AccountRepository,
+	transactionRepository TransactionRepository,
+	timeDepositRepository TimeDepositRepository,
+	accountStatusHistoryRepository AccountStatusHistoryRepository,
+	transactionManager TransactionManager,
+	journalRepository JournalRepository,
+	outboxRepository OutboxRepository,
+	taxPolicy entity.InterestTaxPolicy,
+	logger util.Logger,
+) *matureTimeDepositsUsecase {
+	return &matureTimeDepositsUsecase{
+		accountRepository:              accountRepository,
+		transactionRepository:          transactionRepository,
+		timeDepositRepository:          timeDepositRepository,
+		accountStatusHistoryRepository: accountStatusHistoryRepository,
+		transactionManager:             transactionManager,
+		ledger:                         newLedger(accountRepository, journalRepository),
+		outbox:                         newOutbox(outboxRepository),
+		taxPolicy:                      taxPolicy,
+		logger:                         logger,
+	}
+}
+
+// MatureTimeDeposits processes the time deposits that reached their maturity date at now:
+// the interest of the term is paid from the interest expense system account net of the withholding tax, then the deposit is paid out
+// to the funding account or rolled over according to its maturity instruction.
+// Every deposit is processed in its own database transaction, a deposit that can't be processed
+// because of a business rule (e.g. the funding account is closed) is reported as failed and retried by the next run.
+// A deposit rolled over to a term that already ended is processed by the next run, so the job should run daily
+func (m matureTimeDepositsUsecase) MatureTimeDeposits(ctx context.Context, now time.Time) (*entity.TimeDepositMaturityReport, error) {
+	var (
+		err     error
+		afterID uint
+		report  = &entity.TimeDepositMaturityReport{Date: now}
+		logger  = m.logger.WithDuration(
+			ctx,
+			"matureTimeDepositsUsecase.MatureTimeDeposits",
+			map[string]interface{}{
+				"now": now,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	for {
+		var deposits []*entity.TimeDeposit
+		deposits, err = m.timeDepositRepository.FindMaturedTimeDeposits(ctx, now, afterID, DefaultMatureTimeDepositsBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, deposit := range deposits {
+			maturity := &entity.TimeDepositMaturity{TimeDepositID: deposit.ID}
+			report.Maturities = append(report.Maturities, maturity)
+
+			err = m.matureTimeDeposit(ctx, deposit.ID, now, maturity)
+
+			var domainError *entity.DomainError
+			if errors.As(err, &domainError) {
+				maturity.Status = entity.TimeDepositFailed
+				maturity.Reason = domainError.Code
+				err = nil
+			} else if err != nil {
+				return nil, err
+			}
+
+			afterID = deposit.ID
+		}
+
+		if len(deposits) < DefaultMatureTimeDepositsBatchSize {
+			break
+		}
+	}
+
+	return report, nil
+}
+
+// matureTimeDeposit pays the interest of a single deposit and pays it out or rolls it over
+func (m matureTimeDepositsUsecase) matureTimeDeposit(ctx context.Context, timeDepositID uint, now time.Time, maturity *entity.TimeDepositMaturity) error {
+	applyLock := true
+
+	return m.transactionManager.WithTransaction(ctx, func(ctx context.Context) error {
+		// Lock the deposit so concurrent runs of the job can't process it twice
+		deposit, err := m.timeDepositRepository.FindTimeDepositByID(ctx, timeDepositID, applyLock)
+		if err != nil {
+			return err
+		}
+
+		if !deposit.IsMatured(now) {
+			return nil
+		}
+
+		// The funding account is always opened before the deposit account,
+		// locking them in ascending ID order avoids deadlocks between concurrent runs
+		fundingAccount, err := m.accountRepository.FindByID(ctx, deposit.FundingAccountID, applyLock)
+		if err != nil {
+			return err
+		}
+
+		account, err := m.accountRepository.FindByID(ctx, deposit.AccountID, applyLock)
+		if err != nil {
+			return err
+		}
+
+		// A blocked deposit (e.g. pledged as collateral) stays placed until it's unblocked
+		switch account.Status {
+		case entity.AccountStatusBlocked:
+			return entity.ErrAccountBlocked
+		case entity.AccountStatusClosed:
+			return entity.ErrAccountClosed
+		}
+
+		var (
+			interest = deposit.Interest(account.Currency)
+			tax      = m.taxPolicy.WithholdingTax(interest, deposit.Principal, account.Currency)
+		)
+
+		maturity.AccountNumber = account.AccountNumber
+		maturity.Principal = deposit.Principal
+		maturity.Interest = interest
+		maturity.Tax = tax
+		maturity.Currency = account.Currency
+
+		if deposit.MaturityInstruction == entity.MaturityInstructionRolloverPrincipalAndInterest {
+			err = m.payInterest(ctx, account, interest, tax)
+		} else {
+			err = m.payInterest(ctx, fundingAccount, interest, tax)
+		}
+		if err != nil {
+			return err
+		}
+
+		if deposit.MaturityInstruction == entity.MaturityInstructionPayOut {
+			if err := m.payOut(ctx, deposit, account, fundingAccount); err != nil {
+				return err
+			}
+
+			maturity.Status = entity.TimeDepositPaidOut
+		} else {
+			deposit.Rollover(interest.Sub(tax))
+			maturity.Status = entity.TimeDepositRolledOver
+			maturity.NextMaturityDate = deposit.MaturityDate
+		}
+
+		_, err = m.timeDepositRepository.UpdateTimeDeposit(ctx, deposit)
+		return err
+	})
+}
+
+// payInterest credits the interest of the term to the account from the interest expense system account
+// and debits the withholding tax from it, as the interest of the saving accounts
+func (m matureTimeDepositsUsecase) payInterest(ctx context.Context, account *entity.Account, interest, tax decimal.Decimal) error {
+	// A rate too low for the principal earns less than the minor unit of the currency
+	if !interest.IsPositive() {
+		return nil
+	}
+
+	if account.AccountType != entity.AccountTypeTimeDeposit {
+		if err := account.ValidateCredit(); err != nil {
+			return err
+		}
+	}
+
+	interestExpense, err := m.ledger.SystemAccount(ctx, entity.SystemAccountInterestExpense)
+	if err != nil {
+		return err
+	}
+
+	credit, err := m.transactionRepository.CreateTransaction(ctx, &entity.Transaction{
+		AccountID:      account.ID,
+		Type:           entity.TransactionTypeInterest,
+		Amount:         interest,
+		InitialBalance: account.Balance,
+		FinalBalance:   account.Balance.Add(interest),
+		Currency:       account.Currency,
+	})
+	if err != nil {
+		return err
+	}
+
+	account.Balance = account.Balance.Add(interest)
+
+	journal := entity.NewJournal("Bunga deposito").
+		Debit(interestExpense.ID, 0, interest, account.Currency).
+		Credit(account.ID, credit.ID, interest, account.Currency)
+
+	var taxDebit *entity.Transaction
+	if tax.IsPositive() {
+		withholdingTax, err := m.ledger.SystemAccount(ctx, entity.SystemAccountWithholdingTax)
+		if err != nil {
+			return err
+		}
+
+		taxDebit, err = m.transactionRepository.CreateTransaction(ctx, &entity.Transaction{
+			AccountID:      account.ID,
+			Type:           entity.TransactionTypeWithholdingTax,
+			Amount:         tax,
+			InitialBalance: account.Balance,
+			FinalBalance:   account.Balance.Sub(tax),
+			Currency:       account.Currency,
+		})
+		if err != nil {
+			return err
+		}
+
+		account.Balance = account.Balance.Sub(tax)
+
+		journal.
+			Debit(account.ID, taxDebit.ID, tax, account.Currency).
+			Credit(withholdingTax.ID, 0, tax, account.Currency)
+	}
+
+	if _, err := m.accountRepository.UpdateAccount(ctx, account); err != nil {
+		return err
+	}
+
+	if err := m.ledger.Post(ctx, journal); err != nil {
+		return err
+	}
+
+	if err := m.outbox.RecordBalanceChanged(ctx, account, credit); err != nil {
+		return err
+	}
+
+	if taxDebit != nil {
+		return m.outbox.RecordBalanceChanged(ctx, account, taxDebit)
+	}
+
+	return nil
+}
+
+// payOut moves the principal back to the funding account and closes the deposit account
+func (m matureTimeDepositsUsecase) payOut(ctx context.Context, deposit *entity.TimeDeposit, account, fundingAccount *entity.Account) error {
+	if err := fundingAccount.ValidateCredit(); err != nil {
+		return err
+	}
+
+	principal := account.Balance
+
+	debit, err := m.transactionRepository.CreateTransaction(ctx, &entity.Transaction{
+		AccountID:      account.ID,
+		Type:           entity.TransactionTypeDebit,
+		Amount:         principal,
+		InitialBalance: account.Balance,
+		FinalBalance:   account.Balance.Sub(principal),
+		Currency:       account.Currency,
+	})
+	if err != nil {
+		return err
+	}
+
+	credit, err := m.transactionRepository.CreateTransaction(ctx, &entity.Transaction{
+		AccountID:           fundingAccount.ID,
+		Type:                entity.TransactionTypeCredit,
+		Amount:              principal,
+		InitialBalance:      fundingAccount.Balance,
+		FinalBalance:        fundingAccount.Balance.Add(principal),
+		Currency:            fundingAccount.Currency,
+		LinkedTransactionID: debit.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	debit.LinkedTransactionID = credit.ID
+	debit, err = m.transactionRepository.UpdateTransaction(ctx, debit)
+	if err != nil {
+		return err
+	}
+
+	fundingAccount.Balance = fundingAccount.Balance.Add(principal)
+	if _, err := m.accountRepository.UpdateAccount(ctx, fundingAccount); err != nil {
+		return err
+	}
+
+	history := &entity.AccountStatusHistory{
+		AccountID:  account.ID,
+		FromStatus: account.Status,
+		ToStatus:   entity.AccountStatusClosed,
+		Reason:     "Deposito jatuh tempo",
+	}
+
+	account.Balance = account.Balance.Sub(principal)
+	account.Status = entity.AccountStatusClosed
+	if _, err := m.accountRepository.UpdateAccount(ctx, account); err != nil {
+		return err
+	}
+
+	if _, err := m.accountStatusHistoryRepository.CreateAccountStatusHistory(ctx, history); err != nil {
+		return err
+	}
+
+	journal := entity.NewJournal("Pencairan deposito").
+		Debit(account.ID, debit.ID, principal, account.Currency).
+		Credit(fundingAccount.ID, credit.ID, principal, fundingAccount.Currency)
+
+	if err := m.ledger.Post(ctx, journal); err != nil {
+		return err
+	}
+
+	if err := m.outbox.RecordBalanceChanged(ctx, account, debit); err != nil {
+		return err
+	}
+
+	if err := m.outbox.RecordBalanceChanged(ctx, fundingAccount, credit); err != nil {
+		return err
+	}
+
+	deposit.Status = entity.TimeDepositStatusPaidOut
+	return nil
+}
@@ -0,0 +1,251 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	repositorymock "imansohibul.my.id/account-domain-service/internal/usecase/mock"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestMatureTimeDeposits(t *testing.T) {
+	var (
+		startDate    = time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+		maturityDate = time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC)
+		now          = maturityDate.Add(2 * time.Hour)
+	)
+
+	tests := []struct {
+		name                   string
+		instruction            entity.MaturityInstruction
+		fundingStatus          entity.AccountStatus
+		expectedStatus         entity.TimeDepositMaturityStatus
+		expectedReason         string
+		expectedFundingBalance string
+		expectedAccountBalance string
+		expectedDeposit        *entity.TimeDeposit // nil when the deposit is left unchanged
+	}{
+		{
+			name:                   "Pay Out - Principal And Interest To Funding Account",
+			instruction:            entity.MaturityInstructionPayOut,
+			fundingStatus:          entity.AccountStatusActive,
+			expectedStatus:         entity.TimeDepositPaidOut,
+			expectedFundingBalance: "11098630.14",
+			expectedAccountBalance: "0",
+			expectedDeposit: &entity.TimeDeposit{
+				Principal:    decimal.NewFromInt(10000000),
+				Status:       entity.TimeDepositStatusPaidOut,
+				StartDate:    startDate,
+				MaturityDate: maturityDate,
+			},
+		},
+		{
+			name:                   "Rollover Principal - Interest To Funding Account",
+			instruction:            entity.MaturityInstructionRolloverPrincipal,
+			fundingStatus:          entity.AccountStatusActive,
+			expectedStatus:         entity.TimeDepositRolledOver,
+			expectedFundingBalance: "1098630.14",
+			expectedAccountBalance: "10000000",
+			expectedDeposit: &entity.TimeDeposit{
+				Principal:    decimal.NewFromInt(10000000),
+				Status:       entity.TimeDepositStatusActive,
+				StartDate:    maturityDate,
+				MaturityDate: time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC),
+				Rollovers:    1,
+			},
+		},
+		{
+			name:                   "Rollover Principal And Interest - Interest Placed Again",
+			instruction:            entity.MaturityInstructionRolloverPrincipalAndInterest,
+			fundingStatus:          entity.AccountStatusClosed,
+			expectedStatus:         entity.TimeDepositRolledOver,
+			expectedFundingBalance: "1000000",
+			expectedAccountBalance: "10098630.14",
+			expectedDeposit: &entity.TimeDeposit{
+				Principal:    decimal.RequireFromString("10098630.14"),
+				Status:       entity.TimeDepositStatusActive,
+				StartDate:    maturityDate,
+				MaturityDate: time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC),
+				Rollovers:    1,
+			},
+		},
+		{
+			name:                   "Pay Out - Funding Account Closed",
+			instruction:            entity.MaturityInstructionPayOut,
+			fundingStatus:          entity.AccountStatusClosed,
+			expectedStatus:         entity.TimeDepositFailed,
+			expectedReason:         entity.ErrAccountClosed.Code,
+			expectedFundingBalance: "1000000",
+			expectedAccountBalance: "10000000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctrl                           = gomock.NewController(t)
+				accountRepository              = repositorymock.NewMockAccountRepository(ctrl)
+				transactionRepository          = repositorymock.NewMockTransactionRepository(ctrl)
+				timeDepositRepository          = repositorymock.NewMockTimeDepositRepository(ctrl)
+				accountStatusHistoryRepository = repositorymock.NewMockAccountStatusHistoryRepository(ctrl)
+				transactionManager             = repositorymock.NewMockTransactionManager(ctrl)
+				journalRepository              = repositorymock.NewMockJournalRepository(ctrl)
+				outboxRepository               = repositorymock.NewMockOutboxRepository(ctrl)
+
+				fundingAccount  = &entity.Account{ID: 10, AccountType: entity.AccountTypeSaving, Status: tt.fundingStatus, Currency: entity.CurrencyIDR, Balance: decimal.NewFromInt(1000000)}
+				account         = &entity.Account{ID: 20, AccountNumber: "1234567897", AccountType: entity.AccountTypeTimeDeposit, Status: entity.AccountStatusActive, Currency: entity.CurrencyIDR, Balance: decimal.NewFromInt(10000000)}
+				interestExpense = &entity.Account{ID: 5, AccountNumber: entity.SystemAccountInterestExpense, AccountType: entity.AccountTypeInternal}
+				withholdingTax  = &entity.Account{ID: 6, AccountNumber: entity.SystemAccountWithholdingTax, AccountType: entity.AccountTypeInternal}
+				deposit         = &entity.TimeDeposit{
+					ID:                  3,
+					AccountID:           account.ID,
+					FundingAccountID:    fundingAccount.ID,
+					Principal:           decimal.NewFromInt(10000000),
+					InterestRate:        decimal.NewFromInt(5),
+					TermMonths:          3,
+					MaturityInstruction: tt.instruction,
+					Status:              entity.TimeDepositStatusActive,
+					OpenDate:            startDate,
+					StartDate:           startDate,
+					MaturityDate:        maturityDate,
+				}
+				updatedDeposit *entity.TimeDeposit
+				transactionID  uint
+				interestTypes  []entity.TransactionType
+			)
+
+			transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction)
+			timeDepositRepository.EXPECT().FindMaturedTimeDeposits(gomock.Any(), now, uint(0), DefaultMatureTimeDepositsBatchSize).Return([]*entity.TimeDeposit{deposit}, nil)
+			timeDepositRepository.EXPECT().FindTimeDepositByID(gomock.Any(), deposit.ID, true).Return(deposit, nil)
+			timeDepositRepository.EXPECT().UpdateTimeDeposit(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, d *entity.TimeDeposit) (*entity.TimeDeposit, error) {
+					updatedDeposit = d
+					return d, nil
+				}).AnyTimes()
+			accountRepository.EXPECT().FindByID(gomock.Any(), fundingAccount.ID, true).Return(fundingAccount, nil)
+			accountRepository.EXPECT().FindByID(gomock.Any(), account.ID, true).Return(account, nil)
+			accountRepository.EXPECT().FindSystemAccount(gomock.Any(), entity.SystemAccountInterestExpense).Return(interestExpense, nil).AnyTimes()
+			accountRepository.EXPECT().FindSystemAccount(gomock.Any(), entity.SystemAccountWithholdingTax).Return(withholdingTax, nil).AnyTimes()
+			accountRepository.EXPECT().UpdateAccount(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, a *entity.Account) (*entity.Account, error) {
+					return a, nil
+				}).AnyTimes()
+			transactionRepository.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error) {
+					transactionID++
+					transaction.ID = transactionID
+					if transaction.Type == entity.TransactionTypeInterest || transaction.Type == entity.TransactionTypeWithholdingTax {
+						interestTypes = append(interestTypes, transaction.Type)
+					}
+
+					return transaction, nil
+				}).AnyTimes()
+			transactionRepository.EXPECT().UpdateTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error) {
+					return transaction, nil
+				}).AnyTimes()
+			journalRepository.EXPECT().CreateJournal(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, journal *entity.Journal) (*entity.Journal, error) {
+					assert.NoError(t, journal.Validate())
+					return journal, nil
+				}).AnyTimes()
+			outboxRepository.EXPECT().CreateOutboxEvent(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, event *entity.OutboxEvent) (*entity.OutboxEvent, error) {
+					return event, nil
+				}).AnyTimes()
+			accountStatusHistoryRepository.EXPECT().CreateAccountStatusHistory(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, history *entity.AccountStatusHistory) (*entity.AccountStatusHistory, error) {
+					assert.Equal(t, entity.AccountStatusClosed, history.ToStatus)
+					return history, nil
+				}).AnyTimes()
+
+			matureTimeDepositsUsecase := NewMatureTimeDepositsUsecase(
+				accountRepository,
+				transactionRepository,
+				timeDepositRepository,
+				accountStatusHistoryRepository,
+				transactionManager,
+				journalRepository,
+				outboxRepository,
+				entity.InterestTaxPolicy{Rate: decimal.NewFromInt(20), TaxFreeBalance: decimal.NewFromInt(7500000)},
+				util.GetZapLogger(),
+			)
+
+			report, err := matureTimeDepositsUsecase.MatureTimeDeposits(context.Background(), now)
+
+			assert.NoError(t, err)
+			assert.Len(t, report.Maturities, 1)
+			assert.Equal(t, tt.expectedStatus, report.Maturities[0].Status)
+			assert.Equal(t, tt.expectedReason, report.Maturities[0].Reason)
+			assert.Equal(t, tt.expectedFundingBalance, fundingAccount.Balance.String())
+			assert.Equal(t, tt.expectedAccountBalance, account.Balance.String())
+
+			if tt.expectedDeposit == nil {
+				assert.Nil(t, updatedDeposit)
+				return
+			}
+
+			// 10.000.000 at 5% for the 90 days between 1 January and 1 April, 20% of it is withheld
+			assert.Equal(t, "123287.67", report.Maturities[0].Interest.String())
+			assert.Equal(t, "24657.53", report.Maturities[0].Tax.String())
+			assert.Equal(t, []entity.TransactionType{entity.TransactionTypeInterest, entity.TransactionTypeWithholdingTax}, interestTypes)
+			assert.True(t, tt.expectedDeposit.Principal.Equal(updatedDeposit.Principal))
+			assert.Equal(t, tt.expectedDeposit.Status, updatedDeposit.Status)
+			assert.Equal(t, tt.expectedDeposit.StartDate, updatedDeposit.StartDate)
+			assert.Equal(t, tt.expectedDeposit.MaturityDate, updatedDeposit.MaturityDate)
+			assert.Equal(t, tt.expectedDeposit.Rollovers, updatedDeposit.Rollovers)
+		})
+	}
+}
+
+func TestTimeDepositMaturityDate(t *testing.T) {
+	tests := []struct {
+		startDate    time.Time
+		termMonths   int
+		maturityDate time.Time
+	}{
+		{time.Date(2025, time.January, 15, 0, 0, 0, 0, time.UTC), 1, time.Date(2025, time.February, 15, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC), 1, time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2023, time.August, 31, 0, 0, 0, 0, time.UTC), 6, time.Date(2024, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{time.Date(2025, time.November, 30, 0, 0, 0, 0, time.UTC), 24, time.Date(2027, time.November, 30, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.maturityDate, entity.TimeDepositMaturityDate(tt.startDate, tt.termMonths))
+	}
+}
+
+func TestTimeDepositRolloverKeepsOpenDay(t *testing.T) {
+	openDate := time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC)
+	deposit := &entity.TimeDeposit{
+		Principal:           decimal.NewFromInt(10000000),
+		TermMonths:          1,
+		MaturityInstruction: entity.MaturityInstructionRolloverPrincipal,
+		Status:              entity.TimeDepositStatusActive,
+		OpenDate:            openDate,
+		StartDate:           openDate,
+		MaturityDate:        entity.TimeDepositMaturityDate(openDate, 1),
+	}
+
+	// Every term ends on the 31st, or on the last day of a shorter month, instead of drifting to the 28th
+	expectedMaturityDates := []time.Time{
+		time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.April, 30, 0, 0, 0, 0, time.UTC),
+		time.Date(2025, time.May, 31, 0, 0, 0, 0, time.UTC),
+	}
+
+	assert.Equal(t, time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC), deposit.MaturityDate)
+	for i, expectedMaturityDate := range expectedMaturityDates {
+		startDate := deposit.MaturityDate
+		deposit.Rollover(decimal.Zero)
+
+		assert.Equal(t, startDate, deposit.StartDate)
+		assert.Equal(t, expectedMaturityDate, deposit.MaturityDate)
+		assert.Equal(t, i+1, deposit.Rollovers)
+	}
+}
@@ -6,9 +6,12 @@ package mock
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	decimal "github.com/shopspring/decimal"
 	entity "imansohibul.my.id/account-domain-service/entity"
 )
 
@@ -87,19 +90,94 @@ func (mr *MockAccountRepositoryMockRecorder) CreateAccount(ctx, account interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockAccountRepository)(nil).CreateAccount), ctx, account)
 }
 
+// FindAccounts mocks base method.
+func (m *MockAccountRepository) FindAccounts(ctx context.Context, afterID uint, limit int) ([]*entity.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAccounts", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*entity.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAccounts indicates an expected call of FindAccounts.
+func (mr *MockAccountRepositoryMockRecorder) FindAccounts(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAccounts", reflect.TypeOf((*MockAccountRepository)(nil).FindAccounts), ctx, afterID, limit)
+}
+
 // FindByAccountNumber mocks base method.
-func (m *MockAccountRepository) FindByAccountNumber(ctx context.Context, accountType entity.AccountType, accountNumber string, lock bool) (*entity.Account, error) {
+func (m *MockAccountRepository) FindByAccountNumber(ctx context.Context, accountNumber string, lock bool) (*entity.Account, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByAccountNumber", ctx, accountType, accountNumber, lock)
+	ret := m.ctrl.Call(m, "FindByAccountNumber", ctx, accountNumber, lock)
 	ret0, _ := ret[0].(*entity.Account)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByAccountNumber indicates an expected call of FindByAccountNumber.
-func (mr *MockAccountRepositoryMockRecorder) FindByAccountNumber(ctx, accountType, accountNumber, lock interface{}) *gomock.Call {
+func (mr *MockAccountRepositoryMockRecorder) FindByAccountNumber(ctx, accountNumber, lock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAccountNumber", reflect.TypeOf((*MockAccountRepository)(nil).FindByAccountNumber), ctx, accountNumber, lock)
+}
+
+// FindByCustomerID mocks base method.
+func (m *MockAccountRepository) FindByCustomerID(ctx context.Context, customerID uint) ([]*entity.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCustomerID", ctx, customerID)
+	ret0, _ := ret[0].([]*entity.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCustomerID indicates an expected call of FindByCustomerID.
+func (mr *MockAccountRepositoryMockRecorder) FindByCustomerID(ctx, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCustomerID", reflect.TypeOf((*MockAccountRepository)(nil).FindByCustomerID), ctx, customerID)
+}
+
+// FindByID mocks base method.
+func (m *MockAccountRepository) FindByID(ctx context.Context, id uint, lock bool) (*entity.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id, lock)
+	ret0, _ := ret[0].(*entity.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockAccountRepositoryMockRecorder) FindByID(ctx, id, lock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockAccountRepository)(nil).FindByID), ctx, id, lock)
+}
+
+// FindSystemAccount mocks base method.
+func (m *MockAccountRepository) FindSystemAccount(ctx context.Context, accountNumber string) (*entity.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSystemAccount", ctx, accountNumber)
+	ret0, _ := ret[0].(*entity.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSystemAccount indicates an expected call of FindSystemAccount.
+func (mr *MockAccountRepositoryMockRecorder) FindSystemAccount(ctx, accountNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSystemAccount", reflect.TypeOf((*MockAccountRepository)(nil).FindSystemAccount), ctx, accountNumber)
+}
+
+// NextAccountNumberSequence mocks base method.
+func (m *MockAccountRepository) NextAccountNumberSequence(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextAccountNumberSequence", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextAccountNumberSequence indicates an expected call of NextAccountNumberSequence.
+func (mr *MockAccountRepositoryMockRecorder) NextAccountNumberSequence(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByAccountNumber", reflect.TypeOf((*MockAccountRepository)(nil).FindByAccountNumber), ctx, accountType, accountNumber, lock)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextAccountNumberSequence", reflect.TypeOf((*MockAccountRepository)(nil).NextAccountNumberSequence), ctx)
 }
 
 // UpdateAccount mocks base method.
@@ -155,6 +233,21 @@ func (mr *MockCustomerRepositoryMockRecorder) CreateCustomer(ctx, customer inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomer", reflect.TypeOf((*MockCustomerRepository)(nil).CreateCustomer), ctx, customer)
 }
 
+// FindByID mocks base method.
+func (m *MockCustomerRepository) FindByID(ctx context.Context, id uint, lock bool) (*entity.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id, lock)
+	ret0, _ := ret[0].(*entity.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockCustomerRepositoryMockRecorder) FindByID(ctx, id, lock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCustomerRepository)(nil).FindByID), ctx, id, lock)
+}
+
 // FindByPhoneNumber mocks base method.
 func (m *MockCustomerRepository) FindByPhoneNumber(ctx context.Context, phoneNumber string) (*entity.Customer, error) {
 	m.ctrl.T.Helper()
@@ -170,6 +263,104 @@ func (mr *MockCustomerRepositoryMockRecorder) FindByPhoneNumber(ctx, phoneNumber
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPhoneNumber", reflect.TypeOf((*MockCustomerRepository)(nil).FindByPhoneNumber), ctx, phoneNumber)
 }
 
+// FindCustomers mocks base method.
+func (m *MockCustomerRepository) FindCustomers(ctx context.Context, filter *entity.CustomerFilter) ([]*entity.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCustomers", ctx, filter)
+	ret0, _ := ret[0].([]*entity.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCustomers indicates an expected call of FindCustomers.
+func (mr *MockCustomerRepositoryMockRecorder) FindCustomers(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCustomers", reflect.TypeOf((*MockCustomerRepository)(nil).FindCustomers), ctx, filter)
+}
+
+// FindCustomersAfter mocks base method.
+func (m *MockCustomerRepository) FindCustomersAfter(ctx context.Context, afterID uint, limit int) ([]*entity.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCustomersAfter", ctx, afterID, limit)
+	ret0, _ := ret[0].([]*entity.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCustomersAfter indicates an expected call of FindCustomersAfter.
+func (mr *MockCustomerRepositoryMockRecorder) FindCustomersAfter(ctx, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCustomersAfter", reflect.TypeOf((*MockCustomerRepository)(nil).FindCustomersAfter), ctx, afterID, limit)
+}
+
+// FindCustomersWithSimilarPhoneNumbers mocks base method.
+func (m *MockCustomerRepository) FindCustomersWithSimilarPhoneNumbers(ctx context.Context) ([]*entity.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCustomersWithSimilarPhoneNumbers", ctx)
+	ret0, _ := ret[0].([]*entity.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCustomersWithSimilarPhoneNumbers indicates an expected call of FindCustomersWithSimilarPhoneNumbers.
+func (mr *MockCustomerRepositoryMockRecorder) FindCustomersWithSimilarPhoneNumbers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCustomersWithSimilarPhoneNumbers", reflect.TypeOf((*MockCustomerRepository)(nil).FindCustomersWithSimilarPhoneNumbers), ctx)
+}
+
+// UpdateCustomer mocks base method.
+func (m *MockCustomerRepository) UpdateCustomer(ctx context.Context, customer *entity.Customer) (*entity.Customer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomer", ctx, customer)
+	ret0, _ := ret[0].(*entity.Customer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomer indicates an expected call of UpdateCustomer.
+func (mr *MockCustomerRepositoryMockRecorder) UpdateCustomer(ctx, customer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomer", reflect.TypeOf((*MockCustomerRepository)(nil).UpdateCustomer), ctx, customer)
+}
+
+// MockCustomerHistoryRepository is a mock of CustomerHistoryRepository interface.
+type MockCustomerHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCustomerHistoryRepositoryMockRecorder
+}
+
+// MockCustomerHistoryRepositoryMockRecorder is the mock recorder for MockCustomerHistoryRepository.
+type MockCustomerHistoryRepositoryMockRecorder struct {
+	mock *MockCustomerHistoryRepository
+}
+
+// NewMockCustomerHistoryRepository creates a new mock instance.
+func NewMockCustomerHistoryRepository(ctrl *gomock.Controller) *MockCustomerHistoryRepository {
+	mock := &MockCustomerHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockCustomerHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCustomerHistoryRepository) EXPECT() *MockCustomerHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateCustomerHistory mocks base method.
+func (m *MockCustomerHistoryRepository) CreateCustomerHistory(ctx context.Context, history *entity.CustomerHistory) (*entity.CustomerHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCustomerHistory", ctx, history)
+	ret0, _ := ret[0].(*entity.CustomerHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCustomerHistory indicates an expected call of CreateCustomerHistory.
+func (mr *MockCustomerHistoryRepositoryMockRecorder) CreateCustomerHistory(ctx, history interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomerHistory", reflect.TypeOf((*MockCustomerHistoryRepository)(nil).CreateCustomerHistory), ctx, history)
+}
+
 // MockCustomerIdentityRepository is a mock of CustomerIdentityRepository interface.
 type MockCustomerIdentityRepository struct {
 	ctrl     *gomock.Controller
@@ -208,6 +399,21 @@ func (mr *MockCustomerIdentityRepositoryMockRecorder) CreateCustomerIdentity(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCustomerIdentity", reflect.TypeOf((*MockCustomerIdentityRepository)(nil).CreateCustomerIdentity), ctx, customerIdentity)
 }
 
+// FindByCustomerID mocks base method.
+func (m *MockCustomerIdentityRepository) FindByCustomerID(ctx context.Context, customerID uint) ([]*entity.CustomerIdentity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCustomerID", ctx, customerID)
+	ret0, _ := ret[0].([]*entity.CustomerIdentity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCustomerID indicates an expected call of FindByCustomerID.
+func (mr *MockCustomerIdentityRepositoryMockRecorder) FindByCustomerID(ctx, customerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCustomerID", reflect.TypeOf((*MockCustomerIdentityRepository)(nil).FindByCustomerID), ctx, customerID)
+}
+
 // FindByIdentity mocks base method.
 func (m *MockCustomerIdentityRepository) FindByIdentity(ctx context.Context, identityType entity.CustomerIdentityType, identityNumber string) (*entity.CustomerIdentity, error) {
 	m.ctrl.T.Helper()
@@ -260,3 +466,1008 @@ func (mr *MockTransactionRepositoryMockRecorder) CreateTransaction(ctx, transact
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransaction", reflect.TypeOf((*MockTransactionRepository)(nil).CreateTransaction), ctx, transaction)
 }
+
+// FindBalanceAt mocks base method.
+func (m *MockTransactionRepository) FindBalanceAt(ctx context.Context, accountID uint, at time.Time) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBalanceAt", ctx, accountID, at)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBalanceAt indicates an expected call of FindBalanceAt.
+func (mr *MockTransactionRepositoryMockRecorder) FindBalanceAt(ctx, accountID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBalanceAt", reflect.TypeOf((*MockTransactionRepository)(nil).FindBalanceAt), ctx, accountID, at)
+}
+
+// FindTransactionByID mocks base method.
+func (m *MockTransactionRepository) FindTransactionByID(ctx context.Context, id uint) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTransactionByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTransactionByID indicates an expected call of FindTransactionByID.
+func (mr *MockTransactionRepositoryMockRecorder) FindTransactionByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTransactionByID", reflect.TypeOf((*MockTransactionRepository)(nil).FindTransactionByID), ctx, id)
+}
+
+// FindTransactions mocks base method.
+func (m *MockTransactionRepository) FindTransactions(ctx context.Context, filter *entity.TransactionFilter) ([]*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTransactions", ctx, filter)
+	ret0, _ := ret[0].([]*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTransactions indicates an expected call of FindTransactions.
+func (mr *MockTransactionRepositoryMockRecorder) FindTransactions(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTransactions", reflect.TypeOf((*MockTransactionRepository)(nil).FindTransactions), ctx, filter)
+}
+
+// FindTransactionsBetween mocks base method.
+func (m *MockTransactionRepository) FindTransactionsBetween(ctx context.Context, accountID uint, start, end time.Time, afterID uint, limit int) ([]*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTransactionsBetween", ctx, accountID, start, end, afterID, limit)
+	ret0, _ := ret[0].([]*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTransactionsBetween indicates an expected call of FindTransactionsBetween.
+func (mr *MockTransactionRepositoryMockRecorder) FindTransactionsBetween(ctx, accountID, start, end, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTransactionsBetween", reflect.TypeOf((*MockTransactionRepository)(nil).FindTransactionsBetween), ctx, accountID, start, end, afterID, limit)
+}
+
+// FindTransactionsByAccountID mocks base method.
+func (m *MockTransactionRepository) FindTransactionsByAccountID(ctx context.Context, accountID, afterID uint, limit int) ([]*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTransactionsByAccountID", ctx, accountID, afterID, limit)
+	ret0, _ := ret[0].([]*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTransactionsByAccountID indicates an expected call of FindTransactionsByAccountID.
+func (mr *MockTransactionRepositoryMockRecorder) FindTransactionsByAccountID(ctx, accountID, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTransactionsByAccountID", reflect.TypeOf((*MockTransactionRepository)(nil).FindTransactionsByAccountID), ctx, accountID, afterID, limit)
+}
+
+// FindWithdrawalUsage mocks base method.
+func (m *MockTransactionRepository) FindWithdrawalUsage(ctx context.Context, accountID uint, dayStart, monthStart time.Time) (*entity.WithdrawalUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWithdrawalUsage", ctx, accountID, dayStart, monthStart)
+	ret0, _ := ret[0].(*entity.WithdrawalUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWithdrawalUsage indicates an expected call of FindWithdrawalUsage.
+func (mr *MockTransactionRepositoryMockRecorder) FindWithdrawalUsage(ctx, accountID, dayStart, monthStart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWithdrawalUsage", reflect.TypeOf((*MockTransactionRepository)(nil).FindWithdrawalUsage), ctx, accountID, dayStart, monthStart)
+}
+
+// UpdateTransaction mocks base method.
+func (m *MockTransactionRepository) UpdateTransaction(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransaction", ctx, transaction)
+	ret0, _ := ret[0].(*entity.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTransaction indicates an expected call of UpdateTransaction.
+func (mr *MockTransactionRepositoryMockRecorder) UpdateTransaction(ctx, transaction interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransaction", reflect.TypeOf((*MockTransactionRepository)(nil).UpdateTransaction), ctx, transaction)
+}
+
+// MockIdempotencyKeyRepository is a mock of IdempotencyKeyRepository interface.
+type MockIdempotencyKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyKeyRepositoryMockRecorder
+}
+
+// MockIdempotencyKeyRepositoryMockRecorder is the mock recorder for MockIdempotencyKeyRepository.
+type MockIdempotencyKeyRepositoryMockRecorder struct {
+	mock *MockIdempotencyKeyRepository
+}
+
+// NewMockIdempotencyKeyRepository creates a new mock instance.
+func NewMockIdempotencyKeyRepository(ctrl *gomock.Controller) *MockIdempotencyKeyRepository {
+	mock := &MockIdempotencyKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyKeyRepository) EXPECT() *MockIdempotencyKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateIdempotencyKey mocks base method.
+func (m *MockIdempotencyKeyRepository) CreateIdempotencyKey(ctx context.Context, idempotencyKey *entity.IdempotencyKey) (*entity.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIdempotencyKey", ctx, idempotencyKey)
+	ret0, _ := ret[0].(*entity.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIdempotencyKey indicates an expected call of CreateIdempotencyKey.
+func (mr *MockIdempotencyKeyRepositoryMockRecorder) CreateIdempotencyKey(ctx, idempotencyKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIdempotencyKey", reflect.TypeOf((*MockIdempotencyKeyRepository)(nil).CreateIdempotencyKey), ctx, idempotencyKey)
+}
+
+// FindIdempotencyKey mocks base method.
+func (m *MockIdempotencyKeyRepository) FindIdempotencyKey(ctx context.Context, scope entity.IdempotencyScope, key string) (*entity.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIdempotencyKey", ctx, scope, key)
+	ret0, _ := ret[0].(*entity.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIdempotencyKey indicates an expected call of FindIdempotencyKey.
+func (mr *MockIdempotencyKeyRepositoryMockRecorder) FindIdempotencyKey(ctx, scope, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIdempotencyKey", reflect.TypeOf((*MockIdempotencyKeyRepository)(nil).FindIdempotencyKey), ctx, scope, key)
+}
+
+// UpdateIdempotencyKey mocks base method.
+func (m *MockIdempotencyKeyRepository) UpdateIdempotencyKey(ctx context.Context, idempotencyKey *entity.IdempotencyKey) (*entity.IdempotencyKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIdempotencyKey", ctx, idempotencyKey)
+	ret0, _ := ret[0].(*entity.IdempotencyKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIdempotencyKey indicates an expected call of UpdateIdempotencyKey.
+func (mr *MockIdempotencyKeyRepositoryMockRecorder) UpdateIdempotencyKey(ctx, idempotencyKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIdempotencyKey", reflect.TypeOf((*MockIdempotencyKeyRepository)(nil).UpdateIdempotencyKey), ctx, idempotencyKey)
+}
+
+// MockAccountStatusHistoryRepository is a mock of AccountStatusHistoryRepository interface.
+type MockAccountStatusHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccountStatusHistoryRepositoryMockRecorder
+}
+
+// MockAccountStatusHistoryRepositoryMockRecorder is the mock recorder for MockAccountStatusHistoryRepository.
+type MockAccountStatusHistoryRepositoryMockRecorder struct {
+	mock *MockAccountStatusHistoryRepository
+}
+
+// NewMockAccountStatusHistoryRepository creates a new mock instance.
+func NewMockAccountStatusHistoryRepository(ctrl *gomock.Controller) *MockAccountStatusHistoryRepository {
+	mock := &MockAccountStatusHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockAccountStatusHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccountStatusHistoryRepository) EXPECT() *MockAccountStatusHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAccountStatusHistory mocks base method.
+func (m *MockAccountStatusHistoryRepository) CreateAccountStatusHistory(ctx context.Context, history *entity.AccountStatusHistory) (*entity.AccountStatusHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccountStatusHistory", ctx, history)
+	ret0, _ := ret[0].(*entity.AccountStatusHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccountStatusHistory indicates an expected call of CreateAccountStatusHistory.
+func (mr *MockAccountStatusHistoryRepositoryMockRecorder) CreateAccountStatusHistory(ctx, history interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountStatusHistory", reflect.TypeOf((*MockAccountStatusHistoryRepository)(nil).CreateAccountStatusHistory), ctx, history)
+}
+
+// FindLastAccountStatusHistory mocks base method.
+func (m *MockAccountStatusHistoryRepository) FindLastAccountStatusHistory(ctx context.Context, accountID uint, toStatus entity.AccountStatus) (*entity.AccountStatusHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLastAccountStatusHistory", ctx, accountID, toStatus)
+	ret0, _ := ret[0].(*entity.AccountStatusHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLastAccountStatusHistory indicates an expected call of FindLastAccountStatusHistory.
+func (mr *MockAccountStatusHistoryRepositoryMockRecorder) FindLastAccountStatusHistory(ctx, accountID, toStatus interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastAccountStatusHistory", reflect.TypeOf((*MockAccountStatusHistoryRepository)(nil).FindLastAccountStatusHistory), ctx, accountID, toStatus)
+}
+
+// MockJournalRepository is a mock of JournalRepository interface.
+type MockJournalRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockJournalRepositoryMockRecorder
+}
+
+// MockJournalRepositoryMockRecorder is the mock recorder for MockJournalRepository.
+type MockJournalRepositoryMockRecorder struct {
+	mock *MockJournalRepository
+}
+
+// NewMockJournalRepository creates a new mock instance.
+func NewMockJournalRepository(ctrl *gomock.Controller) *MockJournalRepository {
+	mock := &MockJournalRepository{ctrl: ctrl}
+	mock.recorder = &MockJournalRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJournalRepository) EXPECT() *MockJournalRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateJournal mocks base method.
+func (m *MockJournalRepository) CreateJournal(ctx context.Context, journal *entity.Journal) (*entity.Journal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJournal", ctx, journal)
+	ret0, _ := ret[0].(*entity.Journal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJournal indicates an expected call of CreateJournal.
+func (mr *MockJournalRepositoryMockRecorder) CreateJournal(ctx, journal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJournal", reflect.TypeOf((*MockJournalRepository)(nil).CreateJournal), ctx, journal)
+}
+
+// FindTrialBalance mocks base method.
+func (m *MockJournalRepository) FindTrialBalance(ctx context.Context) ([]*entity.TrialBalanceLine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTrialBalance", ctx)
+	ret0, _ := ret[0].([]*entity.TrialBalanceLine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTrialBalance indicates an expected call of FindTrialBalance.
+func (mr *MockJournalRepositoryMockRecorder) FindTrialBalance(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTrialBalance", reflect.TypeOf((*MockJournalRepository)(nil).FindTrialBalance), ctx)
+}
+
+// MockOutboxRepository is a mock of OutboxRepository interface.
+type MockOutboxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRepositoryMockRecorder
+}
+
+// MockOutboxRepositoryMockRecorder is the mock recorder for MockOutboxRepository.
+type MockOutboxRepositoryMockRecorder struct {
+	mock *MockOutboxRepository
+}
+
+// NewMockOutboxRepository creates a new mock instance.
+func NewMockOutboxRepository(ctrl *gomock.Controller) *MockOutboxRepository {
+	mock := &MockOutboxRepository{ctrl: ctrl}
+	mock.recorder = &MockOutboxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRepository) EXPECT() *MockOutboxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateOutboxEvent mocks base method.
+func (m *MockOutboxRepository) CreateOutboxEvent(ctx context.Context, event *entity.OutboxEvent) (*entity.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOutboxEvent", ctx, event)
+	ret0, _ := ret[0].(*entity.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOutboxEvent indicates an expected call of CreateOutboxEvent.
+func (mr *MockOutboxRepositoryMockRecorder) CreateOutboxEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOutboxEvent", reflect.TypeOf((*MockOutboxRepository)(nil).CreateOutboxEvent), ctx, event)
+}
+
+// FindPendingOutboxEvents mocks base method.
+func (m *MockOutboxRepository) FindPendingOutboxEvents(ctx context.Context, now time.Time, limit int) ([]*entity.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingOutboxEvents", ctx, now, limit)
+	ret0, _ := ret[0].([]*entity.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingOutboxEvents indicates an expected call of FindPendingOutboxEvents.
+func (mr *MockOutboxRepositoryMockRecorder) FindPendingOutboxEvents(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingOutboxEvents", reflect.TypeOf((*MockOutboxRepository)(nil).FindPendingOutboxEvents), ctx, now, limit)
+}
+
+// UpdateOutboxEvent mocks base method.
+func (m *MockOutboxRepository) UpdateOutboxEvent(ctx context.Context, event *entity.OutboxEvent) (*entity.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOutboxEvent", ctx, event)
+	ret0, _ := ret[0].(*entity.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOutboxEvent indicates an expected call of UpdateOutboxEvent.
+func (mr *MockOutboxRepositoryMockRecorder) UpdateOutboxEvent(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOutboxEvent", reflect.TypeOf((*MockOutboxRepository)(nil).UpdateOutboxEvent), ctx, event)
+}
+
+// MockEventPublisher is a mock of EventPublisher interface.
+type MockEventPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventPublisherMockRecorder
+}
+
+// MockEventPublisherMockRecorder is the mock recorder for MockEventPublisher.
+type MockEventPublisherMockRecorder struct {
+	mock *MockEventPublisher
+}
+
+// NewMockEventPublisher creates a new mock instance.
+func NewMockEventPublisher(ctrl *gomock.Controller) *MockEventPublisher {
+	mock := &MockEventPublisher{ctrl: ctrl}
+	mock.recorder = &MockEventPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventPublisher) EXPECT() *MockEventPublisherMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockEventPublisher) Publish(ctx context.Context, event *entity.OutboxEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockEventPublisherMockRecorder) Publish(ctx, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockEventPublisher)(nil).Publish), ctx, event)
+}
+
+// MockStatementRenderer is a mock of StatementRenderer interface.
+type MockStatementRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatementRendererMockRecorder
+}
+
+// MockStatementRendererMockRecorder is the mock recorder for MockStatementRenderer.
+type MockStatementRendererMockRecorder struct {
+	mock *MockStatementRenderer
+}
+
+// NewMockStatementRenderer creates a new mock instance.
+func NewMockStatementRenderer(ctrl *gomock.Controller) *MockStatementRenderer {
+	mock := &MockStatementRenderer{ctrl: ctrl}
+	mock.recorder = &MockStatementRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatementRenderer) EXPECT() *MockStatementRendererMockRecorder {
+	return m.recorder
+}
+
+// Format mocks base method.
+func (m *MockStatementRenderer) Format() entity.StatementFormat {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Format")
+	ret0, _ := ret[0].(entity.StatementFormat)
+	return ret0
+}
+
+// Format indicates an expected call of Format.
+func (mr *MockStatementRendererMockRecorder) Format() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Format", reflect.TypeOf((*MockStatementRenderer)(nil).Format))
+}
+
+// Render mocks base method.
+func (m *MockStatementRenderer) Render(statement *entity.Statement, writer io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", statement, writer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockStatementRendererMockRecorder) Render(statement, writer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockStatementRenderer)(nil).Render), statement, writer)
+}
+
+// MockStatementStore is a mock of StatementStore interface.
+type MockStatementStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatementStoreMockRecorder
+}
+
+// MockStatementStoreMockRecorder is the mock recorder for MockStatementStore.
+type MockStatementStoreMockRecorder struct {
+	mock *MockStatementStore
+}
+
+// NewMockStatementStore creates a new mock instance.
+func NewMockStatementStore(ctrl *gomock.Controller) *MockStatementStore {
+	mock := &MockStatementStore{ctrl: ctrl}
+	mock.recorder = &MockStatementStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatementStore) EXPECT() *MockStatementStoreMockRecorder {
+	return m.recorder
+}
+
+// Save mocks base method.
+func (m *MockStatementStore) Save(ctx context.Context, document *entity.StatementDocument) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, document)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockStatementStoreMockRecorder) Save(ctx, document interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockStatementStore)(nil).Save), ctx, document)
+}
+
+// MockExchangeRateRepository is a mock of ExchangeRateRepository interface.
+type MockExchangeRateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRateRepositoryMockRecorder
+}
+
+// MockExchangeRateRepositoryMockRecorder is the mock recorder for MockExchangeRateRepository.
+type MockExchangeRateRepositoryMockRecorder struct {
+	mock *MockExchangeRateRepository
+}
+
+// NewMockExchangeRateRepository creates a new mock instance.
+func NewMockExchangeRateRepository(ctrl *gomock.Controller) *MockExchangeRateRepository {
+	mock := &MockExchangeRateRepository{ctrl: ctrl}
+	mock.recorder = &MockExchangeRateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRateRepository) EXPECT() *MockExchangeRateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateExchangeRates mocks base method.
+func (m *MockExchangeRateRepository) CreateExchangeRates(ctx context.Context, exchangeRates []*entity.ExchangeRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExchangeRates", ctx, exchangeRates)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateExchangeRates indicates an expected call of CreateExchangeRates.
+func (mr *MockExchangeRateRepositoryMockRecorder) CreateExchangeRates(ctx, exchangeRates interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExchangeRates", reflect.TypeOf((*MockExchangeRateRepository)(nil).CreateExchangeRates), ctx, exchangeRates)
+}
+
+// FindExchangeRate mocks base method.
+func (m *MockExchangeRateRepository) FindExchangeRate(ctx context.Context, baseCurrency, quoteCurrency entity.Currency, at time.Time) (*entity.ExchangeRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExchangeRate", ctx, baseCurrency, quoteCurrency, at)
+	ret0, _ := ret[0].(*entity.ExchangeRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExchangeRate indicates an expected call of FindExchangeRate.
+func (mr *MockExchangeRateRepositoryMockRecorder) FindExchangeRate(ctx, baseCurrency, quoteCurrency, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExchangeRate", reflect.TypeOf((*MockExchangeRateRepository)(nil).FindExchangeRate), ctx, baseCurrency, quoteCurrency, at)
+}
+
+// MockTimeDepositRepository is a mock of TimeDepositRepository interface.
+type MockTimeDepositRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTimeDepositRepositoryMockRecorder
+}
+
+// MockTimeDepositRepositoryMockRecorder is the mock recorder for MockTimeDepositRepository.
+type MockTimeDepositRepositoryMockRecorder struct {
+	mock *MockTimeDepositRepository
+}
+
+// NewMockTimeDepositRepository creates a new mock instance.
+func NewMockTimeDepositRepository(ctrl *gomock.Controller) *MockTimeDepositRepository {
+	mock := &MockTimeDepositRepository{ctrl: ctrl}
+	mock.recorder = &MockTimeDepositRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTimeDepositRepository) EXPECT() *MockTimeDepositRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateTimeDeposit mocks base method.
+func (m *MockTimeDepositRepository) CreateTimeDeposit(ctx context.Context, deposit *entity.TimeDeposit) (*entity.TimeDeposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTimeDeposit", ctx, deposit)
+	ret0, _ := ret[0].(*entity.TimeDeposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTimeDeposit indicates an expected call of CreateTimeDeposit.
+func (mr *MockTimeDepositRepositoryMockRecorder) CreateTimeDeposit(ctx, deposit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTimeDeposit", reflect.TypeOf((*MockTimeDepositRepository)(nil).CreateTimeDeposit), ctx, deposit)
+}
+
+// FindMaturedTimeDeposits mocks base method.
+func (m *MockTimeDepositRepository) FindMaturedTimeDeposits(ctx context.Context, now time.Time, afterID uint, limit int) ([]*entity.TimeDeposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMaturedTimeDeposits", ctx, now, afterID, limit)
+	ret0, _ := ret[0].([]*entity.TimeDeposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMaturedTimeDeposits indicates an expected call of FindMaturedTimeDeposits.
+func (mr *MockTimeDepositRepositoryMockRecorder) FindMaturedTimeDeposits(ctx, now, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMaturedTimeDeposits", reflect.TypeOf((*MockTimeDepositRepository)(nil).FindMaturedTimeDeposits), ctx, now, afterID, limit)
+}
+
+// FindTimeDepositByID mocks base method.
+func (m *MockTimeDepositRepository) FindTimeDepositByID(ctx context.Context, id uint, lock bool) (*entity.TimeDeposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTimeDepositByID", ctx, id, lock)
+	ret0, _ := ret[0].(*entity.TimeDeposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTimeDepositByID indicates an expected call of FindTimeDepositByID.
+func (mr *MockTimeDepositRepositoryMockRecorder) FindTimeDepositByID(ctx, id, lock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTimeDepositByID", reflect.TypeOf((*MockTimeDepositRepository)(nil).FindTimeDepositByID), ctx, id, lock)
+}
+
+// FindTimeDepositRate mocks base method.
+func (m *MockTimeDepositRepository) FindTimeDepositRate(ctx context.Context, currency entity.Currency, termMonths int) (decimal.Decimal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTimeDepositRate", ctx, currency, termMonths)
+	ret0, _ := ret[0].(decimal.Decimal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTimeDepositRate indicates an expected call of FindTimeDepositRate.
+func (mr *MockTimeDepositRepositoryMockRecorder) FindTimeDepositRate(ctx, currency, termMonths interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTimeDepositRate", reflect.TypeOf((*MockTimeDepositRepository)(nil).FindTimeDepositRate), ctx, currency, termMonths)
+}
+
+// UpdateTimeDeposit mocks base method.
+func (m *MockTimeDepositRepository) UpdateTimeDeposit(ctx context.Context, deposit *entity.TimeDeposit) (*entity.TimeDeposit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimeDeposit", ctx, deposit)
+	ret0, _ := ret[0].(*entity.TimeDeposit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTimeDeposit indicates an expected call of UpdateTimeDeposit.
+func (mr *MockTimeDepositRepositoryMockRecorder) UpdateTimeDeposit(ctx, deposit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimeDeposit", reflect.TypeOf((*MockTimeDepositRepository)(nil).UpdateTimeDeposit), ctx, deposit)
+}
+
+// MockFeeRuleRepository is a mock of FeeRuleRepository interface.
+type MockFeeRuleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeRuleRepositoryMockRecorder
+}
+
+// MockFeeRuleRepositoryMockRecorder is the mock recorder for MockFeeRuleRepository.
+type MockFeeRuleRepositoryMockRecorder struct {
+	mock *MockFeeRuleRepository
+}
+
+// NewMockFeeRuleRepository creates a new mock instance.
+func NewMockFeeRuleRepository(ctrl *gomock.Controller) *MockFeeRuleRepository {
+	mock := &MockFeeRuleRepository{ctrl: ctrl}
+	mock.recorder = &MockFeeRuleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeRuleRepository) EXPECT() *MockFeeRuleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindFeeRules mocks base method.
+func (m *MockFeeRuleRepository) FindFeeRules(ctx context.Context, accountType entity.AccountType, operation entity.FeeOperation, channel entity.Channel, currency entity.Currency) ([]*entity.FeeRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFeeRules", ctx, accountType, operation, channel, currency)
+	ret0, _ := ret[0].([]*entity.FeeRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFeeRules indicates an expected call of FindFeeRules.
+func (mr *MockFeeRuleRepositoryMockRecorder) FindFeeRules(ctx, accountType, operation, channel, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFeeRules", reflect.TypeOf((*MockFeeRuleRepository)(nil).FindFeeRules), ctx, accountType, operation, channel, currency)
+}
+
+// MockWithdrawalLimitRepository is a mock of WithdrawalLimitRepository interface.
+type MockWithdrawalLimitRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWithdrawalLimitRepositoryMockRecorder
+}
+
+// MockWithdrawalLimitRepositoryMockRecorder is the mock recorder for MockWithdrawalLimitRepository.
+type MockWithdrawalLimitRepositoryMockRecorder struct {
+	mock *MockWithdrawalLimitRepository
+}
+
+// NewMockWithdrawalLimitRepository creates a new mock instance.
+func NewMockWithdrawalLimitRepository(ctrl *gomock.Controller) *MockWithdrawalLimitRepository {
+	mock := &MockWithdrawalLimitRepository{ctrl: ctrl}
+	mock.recorder = &MockWithdrawalLimitRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWithdrawalLimitRepository) EXPECT() *MockWithdrawalLimitRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAccountWithdrawalLimit mocks base method.
+func (m *MockWithdrawalLimitRepository) CreateAccountWithdrawalLimit(ctx context.Context, limit *entity.WithdrawalLimit) (*entity.WithdrawalLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccountWithdrawalLimit", ctx, limit)
+	ret0, _ := ret[0].(*entity.WithdrawalLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccountWithdrawalLimit indicates an expected call of CreateAccountWithdrawalLimit.
+func (mr *MockWithdrawalLimitRepositoryMockRecorder) CreateAccountWithdrawalLimit(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountWithdrawalLimit", reflect.TypeOf((*MockWithdrawalLimitRepository)(nil).CreateAccountWithdrawalLimit), ctx, limit)
+}
+
+// DeleteAccountWithdrawalLimit mocks base method.
+func (m *MockWithdrawalLimitRepository) DeleteAccountWithdrawalLimit(ctx context.Context, limit *entity.WithdrawalLimit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccountWithdrawalLimit", ctx, limit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccountWithdrawalLimit indicates an expected call of DeleteAccountWithdrawalLimit.
+func (mr *MockWithdrawalLimitRepositoryMockRecorder) DeleteAccountWithdrawalLimit(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountWithdrawalLimit", reflect.TypeOf((*MockWithdrawalLimitRepository)(nil).DeleteAccountWithdrawalLimit), ctx, limit)
+}
+
+// FindAccountWithdrawalLimit mocks base method.
+func (m *MockWithdrawalLimitRepository) FindAccountWithdrawalLimit(ctx context.Context, accountID uint) (*entity.WithdrawalLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAccountWithdrawalLimit", ctx, accountID)
+	ret0, _ := ret[0].(*entity.WithdrawalLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAccountWithdrawalLimit indicates an expected call of FindAccountWithdrawalLimit.
+func (mr *MockWithdrawalLimitRepositoryMockRecorder) FindAccountWithdrawalLimit(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAccountWithdrawalLimit", reflect.TypeOf((*MockWithdrawalLimitRepository)(nil).FindAccountWithdrawalLimit), ctx, accountID)
+}
+
+// FindWithdrawalLimit mocks base method.
+func (m *MockWithdrawalLimitRepository) FindWithdrawalLimit(ctx context.Context, accountType entity.AccountType, customerTier entity.CustomerTier, currency entity.Currency) (*entity.WithdrawalLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWithdrawalLimit", ctx, accountType, customerTier, currency)
+	ret0, _ := ret[0].(*entity.WithdrawalLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWithdrawalLimit indicates an expected call of FindWithdrawalLimit.
+func (mr *MockWithdrawalLimitRepositoryMockRecorder) FindWithdrawalLimit(ctx, accountType, customerTier, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWithdrawalLimit", reflect.TypeOf((*MockWithdrawalLimitRepository)(nil).FindWithdrawalLimit), ctx, accountType, customerTier, currency)
+}
+
+// UpdateAccountWithdrawalLimit mocks base method.
+func (m *MockWithdrawalLimitRepository) UpdateAccountWithdrawalLimit(ctx context.Context, limit *entity.WithdrawalLimit) (*entity.WithdrawalLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccountWithdrawalLimit", ctx, limit)
+	ret0, _ := ret[0].(*entity.WithdrawalLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccountWithdrawalLimit indicates an expected call of UpdateAccountWithdrawalLimit.
+func (mr *MockWithdrawalLimitRepositoryMockRecorder) UpdateAccountWithdrawalLimit(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountWithdrawalLimit", reflect.TypeOf((*MockWithdrawalLimitRepository)(nil).UpdateAccountWithdrawalLimit), ctx, limit)
+}
+
+// MockTransactionReversalRepository is a mock of TransactionReversalRepository interface.
+type MockTransactionReversalRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionReversalRepositoryMockRecorder
+}
+
+// MockTransactionReversalRepositoryMockRecorder is the mock recorder for MockTransactionReversalRepository.
+type MockTransactionReversalRepositoryMockRecorder struct {
+	mock *MockTransactionReversalRepository
+}
+
+// NewMockTransactionReversalRepository creates a new mock instance.
+func NewMockTransactionReversalRepository(ctrl *gomock.Controller) *MockTransactionReversalRepository {
+	mock := &MockTransactionReversalRepository{ctrl: ctrl}
+	mock.recorder = &MockTransactionReversalRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionReversalRepository) EXPECT() *MockTransactionReversalRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateTransactionReversal mocks base method.
+func (m *MockTransactionReversalRepository) CreateTransactionReversal(ctx context.Context, reversal *entity.TransactionReversal) (*entity.TransactionReversal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransactionReversal", ctx, reversal)
+	ret0, _ := ret[0].(*entity.TransactionReversal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransactionReversal indicates an expected call of CreateTransactionReversal.
+func (mr *MockTransactionReversalRepositoryMockRecorder) CreateTransactionReversal(ctx, reversal interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionReversal", reflect.TypeOf((*MockTransactionReversalRepository)(nil).CreateTransactionReversal), ctx, reversal)
+}
+
+// FindTransactionReversal mocks base method.
+func (m *MockTransactionReversalRepository) FindTransactionReversal(ctx context.Context, transactionID uint) (*entity.TransactionReversal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTransactionReversal", ctx, transactionID)
+	ret0, _ := ret[0].(*entity.TransactionReversal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTransactionReversal indicates an expected call of FindTransactionReversal.
+func (mr *MockTransactionReversalRepositoryMockRecorder) FindTransactionReversal(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTransactionReversal", reflect.TypeOf((*MockTransactionReversalRepository)(nil).FindTransactionReversal), ctx, transactionID)
+}
+
+// MockHoldRepository is a mock of HoldRepository interface.
+type MockHoldRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHoldRepositoryMockRecorder
+}
+
+// MockHoldRepositoryMockRecorder is the mock recorder for MockHoldRepository.
+type MockHoldRepositoryMockRecorder struct {
+	mock *MockHoldRepository
+}
+
+// NewMockHoldRepository creates a new mock instance.
+func NewMockHoldRepository(ctrl *gomock.Controller) *MockHoldRepository {
+	mock := &MockHoldRepository{ctrl: ctrl}
+	mock.recorder = &MockHoldRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHoldRepository) EXPECT() *MockHoldRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateHold mocks base method.
+func (m *MockHoldRepository) CreateHold(ctx context.Context, hold *entity.Hold) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHold", ctx, hold)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHold indicates an expected call of CreateHold.
+func (mr *MockHoldRepositoryMockRecorder) CreateHold(ctx, hold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHold", reflect.TypeOf((*MockHoldRepository)(nil).CreateHold), ctx, hold)
+}
+
+// FindExpiredHolds mocks base method.
+func (m *MockHoldRepository) FindExpiredHolds(ctx context.Context, now time.Time, afterID uint, limit int) ([]*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindExpiredHolds", ctx, now, afterID, limit)
+	ret0, _ := ret[0].([]*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindExpiredHolds indicates an expected call of FindExpiredHolds.
+func (mr *MockHoldRepositoryMockRecorder) FindExpiredHolds(ctx, now, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindExpiredHolds", reflect.TypeOf((*MockHoldRepository)(nil).FindExpiredHolds), ctx, now, afterID, limit)
+}
+
+// FindHoldByID mocks base method.
+func (m *MockHoldRepository) FindHoldByID(ctx context.Context, id uint, lock bool) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindHoldByID", ctx, id, lock)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindHoldByID indicates an expected call of FindHoldByID.
+func (mr *MockHoldRepositoryMockRecorder) FindHoldByID(ctx, id, lock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHoldByID", reflect.TypeOf((*MockHoldRepository)(nil).FindHoldByID), ctx, id, lock)
+}
+
+// FindHoldByTransactionID mocks base method.
+func (m *MockHoldRepository) FindHoldByTransactionID(ctx context.Context, transactionID uint) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindHoldByTransactionID", ctx, transactionID)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindHoldByTransactionID indicates an expected call of FindHoldByTransactionID.
+func (mr *MockHoldRepositoryMockRecorder) FindHoldByTransactionID(ctx, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHoldByTransactionID", reflect.TypeOf((*MockHoldRepository)(nil).FindHoldByTransactionID), ctx, transactionID)
+}
+
+// UpdateHold mocks base method.
+func (m *MockHoldRepository) UpdateHold(ctx context.Context, hold *entity.Hold) (*entity.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHold", ctx, hold)
+	ret0, _ := ret[0].(*entity.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHold indicates an expected call of UpdateHold.
+func (mr *MockHoldRepositoryMockRecorder) UpdateHold(ctx, hold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHold", reflect.TypeOf((*MockHoldRepository)(nil).UpdateHold), ctx, hold)
+}
+
+// MockInterestRepository is a mock of InterestRepository interface.
+type MockInterestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInterestRepositoryMockRecorder
+}
+
+// MockInterestRepositoryMockRecorder is the mock recorder for MockInterestRepository.
+type MockInterestRepositoryMockRecorder struct {
+	mock *MockInterestRepository
+}
+
+// NewMockInterestRepository creates a new mock instance.
+func NewMockInterestRepository(ctrl *gomock.Controller) *MockInterestRepository {
+	mock := &MockInterestRepository{ctrl: ctrl}
+	mock.recorder = &MockInterestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInterestRepository) EXPECT() *MockInterestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateInterestAccrual mocks base method.
+func (m *MockInterestRepository) CreateInterestAccrual(ctx context.Context, accrual *entity.InterestAccrual) (*entity.InterestAccrual, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInterestAccrual", ctx, accrual)
+	ret0, _ := ret[0].(*entity.InterestAccrual)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInterestAccrual indicates an expected call of CreateInterestAccrual.
+func (mr *MockInterestRepositoryMockRecorder) CreateInterestAccrual(ctx, accrual interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInterestAccrual", reflect.TypeOf((*MockInterestRepository)(nil).CreateInterestAccrual), ctx, accrual)
+}
+
+// FindInterestRateTiers mocks base method.
+func (m *MockInterestRepository) FindInterestRateTiers(ctx context.Context) ([]*entity.InterestRateTier, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindInterestRateTiers", ctx)
+	ret0, _ := ret[0].([]*entity.InterestRateTier)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindInterestRateTiers indicates an expected call of FindInterestRateTiers.
+func (mr *MockInterestRepositoryMockRecorder) FindInterestRateTiers(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInterestRateTiers", reflect.TypeOf((*MockInterestRepository)(nil).FindInterestRateTiers), ctx)
+}
+
+// FindUnpostedInterestAccountIDs mocks base method.
+func (m *MockInterestRepository) FindUnpostedInterestAccountIDs(ctx context.Context, before time.Time, afterID uint, limit int) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUnpostedInterestAccountIDs", ctx, before, afterID, limit)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUnpostedInterestAccountIDs indicates an expected call of FindUnpostedInterestAccountIDs.
+func (mr *MockInterestRepositoryMockRecorder) FindUnpostedInterestAccountIDs(ctx, before, afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUnpostedInterestAccountIDs", reflect.TypeOf((*MockInterestRepository)(nil).FindUnpostedInterestAccountIDs), ctx, before, afterID, limit)
+}
+
+// FindUnpostedInterestAccruals mocks base method.
+func (m *MockInterestRepository) FindUnpostedInterestAccruals(ctx context.Context, accountID uint, before time.Time, lock bool) ([]*entity.InterestAccrual, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUnpostedInterestAccruals", ctx, accountID, before, lock)
+	ret0, _ := ret[0].([]*entity.InterestAccrual)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUnpostedInterestAccruals indicates an expected call of FindUnpostedInterestAccruals.
+func (mr *MockInterestRepositoryMockRecorder) FindUnpostedInterestAccruals(ctx, accountID, before, lock interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUnpostedInterestAccruals", reflect.TypeOf((*MockInterestRepository)(nil).FindUnpostedInterestAccruals), ctx, accountID, before, lock)
+}
+
+// MarkInterestAccrualsPosted mocks base method.
+func (m *MockInterestRepository) MarkInterestAccrualsPosted(ctx context.Context, accrualIDs []uint, transactionID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkInterestAccrualsPosted", ctx, accrualIDs, transactionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkInterestAccrualsPosted indicates an expected call of MarkInterestAccrualsPosted.
+func (mr *MockInterestRepositoryMockRecorder) MarkInterestAccrualsPosted(ctx, accrualIDs, transactionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkInterestAccrualsPosted", reflect.TypeOf((*MockInterestRepository)(nil).MarkInterestAccrualsPosted), ctx, accrualIDs, transactionID)
+}
@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// DefaultNormalizePhoneNumbersBatchSize is the number of customers read per query while normalizing the phone numbers
+const DefaultNormalizePhoneNumbersBatchSize = 500
+
+type normalizePhoneNumbersUsecase struct {
+	customerRepository        CustomerRepository
+	customerHistoryRepository CustomerHistoryRepository
+	transactionManager        TransactionManager
+	logger                    util.Logger
+}
+
+func NewNormalizePhoneNumbersUsecase(
+	customerRepository CustomerRepository,
+	customerHistoryRepository CustomerHistoryRepository,
+	transactionManager TransactionManager,
+	logger util.Logger,
+) *normalizePhoneNumbersUsecase {
+	return &normalizePhoneNumbersUsecase{
+		customerRepository:        customerRepository,
+		customerHistoryRepository: customerHistoryRepository,
+		transactionManager:        transactionManager,
+		logger:                    logger,
+	}
+}
+
+// NormalizePhoneNumbers rewrites the phone numbers of the customers registered before the normalization to E.164
+// A number shared by several customers once normalized is reported as a collision and left unchanged,
+// so the duplicates can be resolved manually. Every rewrite is recorded in the customer histories.
+// The customers are read in batches, only the customers with similar numbers are kept to find the collisions
+func (n normalizePhoneNumbersUsecase) NormalizePhoneNumbers(ctx context.Context, dryRun bool) (*entity.PhoneNumberNormalizationReport, error) {
+	var (
+		err     error
+		afterID uint
+		report  = &entity.PhoneNumberNormalizationReport{DryRun: dryRun}
+		logger  = n.logger.WithDuration(
+			ctx,
+			"normalizePhoneNumbersUsecase.NormalizePhoneNumbers",
+			map[string]interface{}{
+				"dry_run": dryRun,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	// A collision can be with a customer checked in a later batch, so the candidates are grouped first
+	var customersByNumber map[string][]uint
+	customersByNumber, err = n.findSimilarPhoneNumbers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		var customers []*entity.Customer
+		customers, err = n.customerRepository.FindCustomersAfter(ctx, afterID, DefaultNormalizePhoneNumbersBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		report.CheckedCustomers += len(customers)
+
+		for _, customer := range customers {
+			afterID = customer.ID
+
+			normalizedNumber, normalizeErr := util.NormalizePhoneNumber(customer.PhoneNumber)
+			if normalizeErr != nil {
+				report.Normalizations = append(report.Normalizations, &entity.PhoneNumberNormalization{
+					CustomerID:  customer.ID,
+					PhoneNumber: customer.PhoneNumber,
+					Status:      entity.PhoneNumberInvalid,
+				})
+				continue
+			}
+
+			if normalizedNumber == customer.PhoneNumber {
+				continue
+			}
+
+			normalization := &entity.PhoneNumberNormalization{
+				CustomerID:            customer.ID,
+				PhoneNumber:           customer.PhoneNumber,
+				NormalizedPhoneNumber: normalizedNumber,
+				Status:                entity.PhoneNumberNormalized,
+			}
+			report.Normalizations = append(report.Normalizations, normalization)
+
+			for _, customerID := range customersByNumber[normalizedNumber] {
+				if customerID != customer.ID {
+					normalization.CollidingCustomerIDs = append(normalization.CollidingCustomerIDs, customerID)
+				}
+			}
+
+			if len(normalization.CollidingCustomerIDs) > 0 {
+				normalization.Status = entity.PhoneNumberCollision
+				continue
+			}
+
+			if dryRun {
+				continue
+			}
+
+			err = n.normalizePhoneNumber(ctx, normalization)
+			if errors.Is(err, entity.ErrPhoneNumberAlreadyExists) {
+				// A customer registered the normalized number meanwhile
+				normalization.Status = entity.PhoneNumberCollision
+				err = nil
+			} else if err != nil {
+				return nil, err
+			}
+		}
+
+		if len(customers) < DefaultNormalizePhoneNumbersBatchSize {
+			break
+		}
+	}
+
+	return report, nil
+}
+
+// findSimilarPhoneNumbers groups the customers with similar phone numbers by their normalized number
+// The grouped query only tells which numbers look alike, they collide when they're normalized to the same number
+func (n normalizePhoneNumbersUsecase) findSimilarPhoneNumbers(ctx context.Context) (map[string][]uint, error) {
+	customers, err := n.customerRepository.FindCustomersWithSimilarPhoneNumbers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	customersByNumber := make(map[string][]uint, len(customers))
+	for _, customer := range customers {
+		normalizedNumber, err := util.NormalizePhoneNumber(customer.PhoneNumber)
+		if err != nil {
+			continue
+		}
+
+		customersByNumber[normalizedNumber] = append(customersByNumber[normalizedNumber], customer.ID)
+	}
+
+	return customersByNumber, nil
+}
+
+// normalizePhoneNumber rewrites the phone number of a single customer and records the change
+func (n normalizePhoneNumbersUsecase) normalizePhoneNumber(ctx context.Context, normalization *entity.PhoneNumberNormalization) error {
+	applyLock := true
+
+	return n.transactionManager.WithTransaction(ctx, func(ctx context.Context) error {
+		customer, err := n.customerRepository.FindByID(ctx, normalization.CustomerID, applyLock)
+		if err != nil {
+			return err
+		}
+
+		// The customer changed their phone number meanwhile, the new number is already normalized
+		if customer.PhoneNumber != normalization.PhoneNumber {
+			return nil
+		}
+
+		customer.PhoneNumber = normalization.NormalizedPhoneNumber
+		if _, err := n.customerRepository.UpdateCustomer(ctx, customer); err != nil {
+			return err
+		}
+
+		_, err = n.customerHistoryRepository.CreateCustomerHistory(ctx, &entity.CustomerHistory{
+			CustomerID: customer.ID,
+			Field:      entity.CustomerFieldPhoneNumber,
+			OldValue:   normalization.PhoneNumber,
+			NewValue:   normalization.NormalizedPhoneNumber,
+		})
+		return err
+	})
+}
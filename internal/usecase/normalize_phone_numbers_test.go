@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	repositorymock "imansohibul.my.id/account-domain-service/internal/usecase/mock"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestNormalizePhoneNumbers(t *testing.T) {
+	var (
+		ctrl                      = gomock.NewController(t)
+		customerRepository        = repositorymock.NewMockCustomerRepository(ctrl)
+		customerHistoryRepository = repositorymock.NewMockCustomerHistoryRepository(ctrl)
+		transactionManager        = repositorymock.NewMockTransactionManager(ctrl)
+
+		canonical = &entity.Customer{ID: 1, PhoneNumber: "+6281234567890"}
+		local     = &entity.Customer{ID: 501, PhoneNumber: "0812-1111-2222"}
+		duplicate = &entity.Customer{ID: 502, PhoneNumber: "081234567890"}
+		invalid   = &entity.Customer{ID: 503, PhoneNumber: "021555"}
+	)
+
+	// The collision of the duplicate is with a customer of the batch before
+	customerRepository.EXPECT().FindCustomersWithSimilarPhoneNumbers(gomock.Any()).Return([]*entity.Customer{canonical, duplicate}, nil)
+	customerRepository.EXPECT().FindCustomersAfter(gomock.Any(), uint(0), DefaultNormalizePhoneNumbersBatchSize).
+		Return(customerBatch(canonical, DefaultNormalizePhoneNumbersBatchSize), nil)
+	customerRepository.EXPECT().FindCustomersAfter(gomock.Any(), uint(DefaultNormalizePhoneNumbersBatchSize), DefaultNormalizePhoneNumbersBatchSize).
+		Return([]*entity.Customer{local, duplicate, invalid}, nil)
+
+	// Only the number without a collision is rewritten
+	transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction)
+	customerRepository.EXPECT().FindByID(gomock.Any(), local.ID, true).Return(&entity.Customer{ID: 501, PhoneNumber: "0812-1111-2222"}, nil)
+	customerRepository.EXPECT().UpdateCustomer(gomock.Any(), &entity.Customer{ID: 501, PhoneNumber: "+6281211112222"}).
+		DoAndReturn(func(ctx context.Context, customer *entity.Customer) (*entity.Customer, error) {
+			return customer, nil
+		})
+	customerHistoryRepository.EXPECT().CreateCustomerHistory(gomock.Any(), &entity.CustomerHistory{
+		CustomerID: 501,
+		Field:      entity.CustomerFieldPhoneNumber,
+		OldValue:   "0812-1111-2222",
+		NewValue:   "+6281211112222",
+	}).Return(&entity.CustomerHistory{}, nil)
+
+	normalizePhoneNumbersUsecase := NewNormalizePhoneNumbersUsecase(customerRepository, customerHistoryRepository, transactionManager, util.GetZapLogger())
+	report, err := normalizePhoneNumbersUsecase.NormalizePhoneNumbers(context.Background(), false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultNormalizePhoneNumbersBatchSize+3, report.CheckedCustomers)
+	assert.Equal(t, []*entity.PhoneNumberNormalization{
+		{CustomerID: 501, PhoneNumber: "0812-1111-2222", NormalizedPhoneNumber: "+6281211112222", Status: entity.PhoneNumberNormalized},
+		{CustomerID: 502, PhoneNumber: "081234567890", NormalizedPhoneNumber: "+6281234567890", Status: entity.PhoneNumberCollision, CollidingCustomerIDs: []uint{1}},
+		{CustomerID: 503, PhoneNumber: "021555", Status: entity.PhoneNumberInvalid},
+	}, report.Normalizations)
+}
+
+// customerBatch returns a full batch of customers starting with the given customer,
+// the other customers already have a normalized number
+func customerBatch(first *entity.Customer, size int) []*entity.Customer {
+	customers := []*entity.Customer{first}
+	for id := first.ID + 1; len(customers) < size; id++ {
+		customers = append(customers, &entity.Customer{ID: id, PhoneNumber: fmt.Sprintf("+62812%08d", id)})
+	}
+
+	return customers
+}
+
+func TestNormalizePhoneNumbersDryRun(t *testing.T) {
+	var (
+		ctrl               = gomock.NewController(t)
+		customerRepository = repositorymock.NewMockCustomerRepository(ctrl)
+	)
+
+	customerRepository.EXPECT().FindCustomersWithSimilarPhoneNumbers(gomock.Any()).Return(nil, nil)
+	customerRepository.EXPECT().FindCustomersAfter(gomock.Any(), uint(0), DefaultNormalizePhoneNumbersBatchSize).
+		Return([]*entity.Customer{{ID: 2, PhoneNumber: "6281211112222"}}, nil)
+
+	normalizePhoneNumbersUsecase := NewNormalizePhoneNumbersUsecase(customerRepository, nil, nil, util.GetZapLogger())
+	report, err := normalizePhoneNumbersUsecase.NormalizePhoneNumbers(context.Background(), true)
+
+	assert.NoError(t, err)
+	assert.True(t, report.DryRun)
+	assert.Equal(t, []*entity.PhoneNumberNormalization{
+		{CustomerID: 2, PhoneNumber: "6281211112222", NormalizedPhoneNumber: "+6281211112222", Status: entity.PhoneNumberNormalized},
+	}, report.Normalizations)
+}
@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// openAccountUsecase opens additional accounts for customers that are already registered
+type openAccountUsecase struct {
+	accountRepository          AccountRepository
+	customerRepository         CustomerRepository
+	customerIdentityRepository CustomerIdentityRepository
+	idempotencyGuard           idempotencyGuard
+	outbox                     outbox
+	accountNumberGenerator     accountNumberGenerator
+	logger                     util.Logger
+}
+
+func NewOpenAccountUsecase(
+	accountRepository AccountRepository,
+	transactionManager TransactionManager,
+	customerRepository CustomerRepository,
+	customerIdentityRepository CustomerIdentityRepository,
+	idempotencyKeyRepository IdempotencyKeyRepository,
+	outboxRepository OutboxRepository,
+	accountNumberScheme util.AccountNumberScheme,
+	logger util.Logger,
+) *openAccountUsecase {
+	return &openAccountUsecase{
+		accountRepository:          accountRepository,
+		customerRepository:         customerRepository,
+		customerIdentityRepository: customerIdentityRepository,
+		idempotencyGuard:           newIdempotencyGuard(idempotencyKeyRepository, transactionManager),
+		outbox:                     newOutbox(outboxRepository),
+		accountNumberGenerator:     newAccountNumberGenerator(accountNumberScheme, accountRepository),
+		logger:                     logger,
+	}
+}
+
+func (o openAccountUsecase) OpenAccount(ctx context.Context, params *entity.OpenAccountParams) (*entity.Account, error) {
+	var (
+		err    error
+		logger = o.logger.WithDuration(
+			ctx,
+			"openAccountUsecase.OpenAccount",
+			map[string]interface{}{
+				"customer_id":     params.CustomerID,
+				"identity_number": params.IdentityNumber,
+				"account_type":    params.AccountType,
+				"currency":        params.Currency,
+				"idempotency_key": params.IdempotencyKey,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	if !params.AccountType.IsOpenable() {
+		err = entity.ErrUnsupportedAccountType
+		return nil, err
+	}
+
+	if !params.Currency.IsSupported() {
+		err = entity.ErrUnsupportedCurrency
+		return nil, err
+	}
+
+	var (
+		account     = new(entity.Account)
+		requestHash = hashRequest(
+			strconv.FormatUint(uint64(params.CustomerID), 10),
+			params.IdentityNumber,
+			strconv.Itoa(int(params.AccountType)),
+			string(params.Currency),
+		)
+	)
+
+	err = o.idempotencyGuard.Run(ctx, entity.IdempotencyScopeOpenAccount, params.IdempotencyKey, requestHash, &account, func(ctx context.Context) error {
+		customerID, err := o.findCustomerID(ctx, params)
+		if err != nil {
+			return err
+		}
+
+		account, err = createAccountWithRetry(ctx, o.accountNumberGenerator, o.accountRepository, o.logger, &entity.Account{
+			CustomerID:  customerID,
+			AccountType: params.AccountType,
+			Status:      entity.AccountStatusActive,
+			Balance:     decimal.Zero,
+			Currency:    params.Currency,
+		}, DefaultMaxRetries)
+		if err != nil {
+			return err
+		}
+
+		return o.outbox.RecordAccountCreated(ctx, account)
+	})
+
+	return account, err
+}
+
+// findCustomerID finds the customer by its ID, or by its NIK when the ID is not given
+func (o openAccountUsecase) findCustomerID(ctx context.Context, params *entity.OpenAccountParams) (uint, error) {
+	if params.CustomerID != 0 {
+		applyLock := false
+		customer, err := o.customerRepository.FindByID(ctx, params.CustomerID, applyLock)
+		if err != nil {
+			return 0, err
+		}
+
+		return customer.ID, nil
+	}
+
+	customerIdentity, err := o.customerIdentityRepository.FindByIdentity(ctx, entity.IdentityTypeNIK, params.IdentityNumber)
+	if err == entity.ErrCustomerIdentityNotFound {
+		return 0, entity.ErrCustomerNotFound
+	} else if err != nil {
+		return 0, err
+	}
+
+	return customerIdentity.CustomerID, nil
+}
@@ -0,0 +1,232 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// openTimeDepositUsecase opens time deposits with the funds of a saving account
+type openTimeDepositUsecase struct {
+	accountRepository      AccountRepository
+	transactionRepository  TransactionRepository
+	timeDepositRepository  TimeDepositRepository
+	idempotencyGuard       idempotencyGuard
+	ledger                 ledger
+	outbox                 outbox
+	accountNumberGenerator accountNumberGenerator
+	logger                 util.Logger
+}
+
+func NewOpenTimeDepositUsecase(
+	accountRepository AccountRepository,
+	transactionRepository TransactionRepository,
+	timeDepositRepository TimeDepositRepository,
+	transactionManager TransactionManager,
+	idempotencyKeyRepository IdempotencyKeyRepository,
+	journalRepository JournalRepository,
+	outboxRepository OutboxRepository,
+	accountNumberScheme util.AccountNumberScheme,
+	logger util.Logger,
+) *openTimeDepositUsecase {
+	return &openTimeDepositUsecase{
+		accountRepository:      accountRepository,
+		transactionRepository:  transactionRepository,
+		timeDepositRepository:  timeDepositRepository,
+		idempotencyGuard:       newIdempotencyGuard(idempotencyKeyRepository, transactionManager),
+		ledger:                 newLedger(accountRepository, journalRepository),
+		outbox:                 newOutbox(outboxRepository),
+		accountNumberGenerator: newAccountNumberGenerator(accountNumberScheme, accountRepository),
+		logger:                 logger,
+	}
+}
+
+// OpenTimeDeposit opens a time deposit account of the customer of the funding account
+// and moves the principal from the funding account to the new account.
+// The term starts today and ends on the same day of the month after the term,
+// the interest rate is the rate of the term and the currency in the rate table
+func (o openTimeDepositUsecase) OpenTimeDeposit(ctx context.Context, params *entity.OpenTimeDepositParams) (*entity.TimeDepositAccount, error) {
+	var (
+		applyLock = true
+		err       error
+		logger    = o.logger.WithDuration(
+			ctx,
+			"openTimeDepositUsecase.OpenTimeDeposit",
+			map[string]interface{}{
+				"funding_account_number": params.FundingAccountNumber,
+				"principal":              params.Principal,
+				"currency":               params.Currency,
+				"term_months":            params.TermMonths,
+				"maturity_instruction":   params.MaturityInstruction,
+				"idempotency_key":        params.IdempotencyKey,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	if !entity.IsSupportedTimeDepositTerm(params.TermMonths) {
+		err = entity.ErrUnsupportedTimeDepositTerm
+		return nil, err
+	}
+
+	var (
+		timeDepositAccount = new(entity.TimeDepositAccount)
+		requestHash        = hashRequest(
+			params.FundingAccountNumber,
+			params.Principal.String(),
+			string(params.Currency),
+			strconv.Itoa(params.TermMonths),
+			strconv.Itoa(int(params.MaturityInstruction)),
+		)
+	)
+
+	err = o.idempotencyGuard.Run(ctx, entity.IdempotencyScopeOpenTimeDeposit, params.IdempotencyKey, requestHash, &timeDepositAccount, func(ctx context.Context) error {
+		// Lock the funding account so its balance can't change while the principal is moved
+		fundingAccount, err := o.accountRepository.FindByAccountNumber(ctx, params.FundingAccountNumber, applyLock)
+		if err != nil {
+			return err
+		}
+
+		if fundingAccount.AccountType != entity.AccountTypeSaving {
+			return entity.ErrInvalidTimeDepositFundingAccount
+		}
+
+		if err := fundingAccount.ValidateDebit(); err != nil {
+			return err
+		}
+
+		if err := fundingAccount.ValidateAmount(params.Principal, params.Currency); err != nil {
+			return err
+		}
+
+		if err := fundingAccount.Currency.ValidateDepositAmount(params.Principal); err != nil {
+			return err
+		}
+
+		if fundingAccount.AvailableBalance().LessThan(params.Principal) {
+			return entity.ErrInsufficientBalance
+		}
+
+		// The rate is the rate of the product, the customer can't choose it
+		interestRate, err := o.timeDepositRepository.FindTimeDepositRate(ctx, fundingAccount.Currency, params.TermMonths)
+		if err != nil {
+			return err
+		}
+
+		account, err := createAccountWithRetry(ctx, o.accountNumberGenerator, o.accountRepository, o.logger, &entity.Account{
+			CustomerID:  fundingAccount.CustomerID,
+			AccountType: entity.AccountTypeTimeDeposit,
+			Status:      entity.AccountStatusActive,
+			Balance:     decimal.Zero,
+			Currency:    fundingAccount.Currency,
+		}, DefaultMaxRetries)
+		if err != nil {
+			return err
+		}
+
+		if err := o.outbox.RecordAccountCreated(ctx, account); err != nil {
+			return err
+		}
+
+		if err := o.placePrincipal(ctx, fundingAccount, account, params.Principal); err != nil {
+			return err
+		}
+
+		startDate := startOfDay(time.Now())
+		timeDeposit, err := o.timeDepositRepository.CreateTimeDeposit(ctx, &entity.TimeDeposit{
+			AccountID:           account.ID,
+			FundingAccountID:    fundingAccount.ID,
+			Principal:           params.Principal,
+			InterestRate:        interestRate,
+			TermMonths:          params.TermMonths,
+			MaturityInstruction: params.MaturityInstruction,
+			Status:              entity.TimeDepositStatusActive,
+			OpenDate:            startDate,
+			StartDate:           startDate,
+			MaturityDate:        entity.TimeDepositMaturityDate(startDate, params.TermMonths),
+		})
+		if err != nil {
+			return err
+		}
+
+		timeDepositAccount.Account = account
+		timeDepositAccount.FundingAccount = fundingAccount
+		timeDepositAccount.TimeDeposit = timeDeposit
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return timeDepositAccount, nil
+}
+
+// placePrincipal moves the principal from the funding account to the time deposit account
+func (o openTimeDepositUsecase) placePrincipal(ctx context.Context, fundingAccount, account *entity.Account, principal decimal.Decimal) error {
+	debit, err := o.transactionRepository.CreateTransaction(ctx, &entity.Transaction{
+		AccountID:      fundingAccount.ID,
+		Type:           entity.TransactionTypeDebit,
+		Amount:         principal,
+		InitialBalance: fundingAccount.Balance,
+		FinalBalance:   fundingAccount.Balance.Sub(principal),
+		Currency:       fundingAccount.Currency,
+	})
+	if err != nil {
+		return err
+	}
+
+	credit, err := o.transactionRepository.CreateTransaction(ctx, &entity.Transaction{
+		AccountID:           account.ID,
+		Type:                entity.TransactionTypeCredit,
+		Amount:              principal,
+		InitialBalance:      account.Balance,
+		FinalBalance:        account.Balance.Add(principal),
+		Currency:            account.Currency,
+		LinkedTransactionID: debit.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	debit.LinkedTransactionID = credit.ID
+	debit, err = o.transactionRepository.UpdateTransaction(ctx, debit)
+	if err != nil {
+		return err
+	}
+
+	fundingAccount.Balance = fundingAccount.Balance.Sub(principal)
+	if _, err := o.accountRepository.UpdateAccount(ctx, fundingAccount); err != nil {
+		return err
+	}
+
+	account.Balance = account.Balance.Add(principal)
+	if _, err := o.accountRepository.UpdateAccount(ctx, account); err != nil {
+		return err
+	}
+
+	journal := entity.NewJournal("Penempatan deposito").
+		Debit(fundingAccount.ID, debit.ID, principal, fundingAccount.Currency).
+		Credit(account.ID, credit.ID, principal, account.Currency)
+
+	if err := o.ledger.Post(ctx, journal); err != nil {
+		return err
+	}
+
+	if err := o.outbox.RecordBalanceChanged(ctx, fundingAccount, debit); err != nil {
+		return err
+	}
+
+	return o.outbox.RecordBalanceChanged(ctx, account, credit)
+}
+
+// startOfDay truncates the time to the start of its day in UTC, the dates of the time deposits have no time
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// outbox records the domain events of a change in the database transaction of the change
+type outbox struct {
+	outboxRepository OutboxRepository
+}
+
+func newOutbox(outboxRepository OutboxRepository) outbox {
+	return outbox{outboxRepository: outboxRepository}
+}
+
+// RecordAccountCreated records the AccountCreated event of a new account
+func (o outbox) RecordAccountCreated(ctx context.Context, account *entity.Account) error {
+	event, err := entity.NewOutboxEvent(entity.EventTypeAccountCreated, account.ID, entity.NewAccountCreatedPayload(account))
+	if err != nil {
+		return err
+	}
+
+	_, err = o.outboxRepository.CreateOutboxEvent(ctx, event)
+	return err
+}
+
+// RecordBalanceChanged records the BalanceCredited or BalanceDebited event of a stored transaction
+func (o outbox) RecordBalanceChanged(ctx context.Context, account *entity.Account, transaction *entity.Transaction) error {
+	event, err := entity.NewBalanceChangedEvent(account, transaction)
+	if err != nil {
+		return err
+	}
+
+	_, err = o.outboxRepository.CreateOutboxEvent(ctx, event)
+	return err
+}
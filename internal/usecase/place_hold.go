@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+type placeHoldUsecase struct {
+	accountRepository AccountRepository
+	holdRepository    HoldRepository
+	idempotencyGuard  idempotencyGuard
+	logger            util.Logger
+}
+
+func NewPlaceHoldUsecase(
+	accountRepository AccountRepository,
+	holdRepository HoldRepository,
+	transactionManager TransactionManager,
+	idempotencyKeyRepository IdempotencyKeyRepository,
+	logger util.Logger,
+) *placeHoldUsecase {
+	return &placeHoldUsecase{
+		accountRepository: accountRepository,
+		holdRepository:    holdRepository,
+		idempotencyGuard:  newIdempotencyGuard(idempotencyKeyRepository, transactionManager),
+		logger:            logger,
+	}
+}
+
+// PlaceHold reserves an amount of an account until it's captured, released or expired.
+// The amount is taken from the available balance, the ledger balance doesn't change until the hold is captured
+func (p placeHoldUsecase) PlaceHold(ctx context.Context, params *entity.PlaceHoldParams) (*entity.Hold, error) {
+	var (
+		applyLock = true
+		err       error
+		logger    = p.logger.WithDuration(
+			ctx,
+			"placeHoldUsecase.PlaceHold",
+			map[string]interface{}{
+				"account_number":  params.AccountNumber,
+				"amount":          params.Amount,
+				"currency":        params.Currency,
+				"duration":        params.Duration.String(),
+				"reference":       params.Reference,
+				"idempotency_key": params.IdempotencyKey,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	duration := params.Duration
+	if duration == 0 {
+		duration = entity.DefaultHoldDuration
+	}
+
+	if duration < 0 || duration > entity.MaxHoldDuration {
+		err = entity.ErrInvalidHoldDuration
+		return nil, err
+	}
+
+	var (
+		hold        = new(entity.Hold)
+		requestHash = hashRequest(params.AccountNumber, params.Amount.String(), string(params.Currency), duration.String(), params.Reference)
+	)
+
+	err = p.idempotencyGuard.Run(ctx, entity.IdempotencyScopePlaceHold, params.IdempotencyKey, requestHash, hold, func(ctx context.Context) error {
+		// Lock the account so its available balance can't be spent twice
+		account, err := p.accountRepository.FindByAccountNumber(ctx, params.AccountNumber, applyLock)
+		if err != nil {
+			return err
+		}
+
+		if err := account.ValidateDebit(); err != nil {
+			return err
+		}
+
+		if err := account.ValidateAmount(params.Amount, params.Currency); err != nil {
+			return err
+		}
+
+		if account.AvailableBalance().LessThan(params.Amount) {
+			return entity.ErrInsufficientBalance
+		}
+
+		newHold, err := p.holdRepository.CreateHold(ctx, &entity.Hold{
+			AccountID: account.ID,
+			Amount:    params.Amount,
+			Currency:  account.Currency,
+			Status:    entity.HoldStatusActive,
+			Reference: params.Reference,
+			ExpiresAt: time.Now().Add(duration),
+		})
+		if err != nil {
+			return err
+		}
+
+		account.HeldAmount = account.HeldAmount.Add(params.Amount)
+		if _, err := p.accountRepository.UpdateAccount(ctx, account); err != nil {
+			return err
+		}
+
+		*hold = *newHold
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
@@ -0,0 +1,264 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// DefaultPostInterestBatchSize is the number of accounts read per query while posting the interest
+const DefaultPostInterestBatchSize = 100
+
+type postInterestUsecase struct {
+	accountRepository     AccountRepository
+	transactionRepository TransactionRepository
+	interestRepository    InterestRepository
+	transactionManager    TransactionManager
+	ledger                ledger
+	outbox                outbox
+	taxPolicy             entity.InterestTaxPolicy
+	logger                util.Logger
+}
+
+func NewPostInterestUsecase(
+	accountRepository AccountRepository,
+	transactionRepository TransactionRepository,
+	interestRepository InterestRepository,
+	transactionManager TransactionManager,
+	journalRepository JournalRepository,
+	outboxRepository OutboxRepository,
+	taxPolicy entity.InterestTaxPolicy,
+	logger util.Logger,
+) *postInterestUsecase {
+	return &postInterestUsecase{
+		accountRepository:     accountRepository,
+		transactionRepository: transactionRepository,
+		interestRepository:    interestRepository,
+		transactionManager:    transactionManager,
+		ledger:                newLedger(accountRepository, journalRepository),
+		outbox:                newOutbox(outboxRepository),
+		taxPolicy:             taxPolicy,
+		logger:                logger,
+	}
+}
+
+// PostInterest posts the interest accrued up to the end of the month of the given date:
+// the accruals of every account are summed, rounded down to the minor unit of the currency
+// and credited from the interest expense system account as an interest transaction,
+// the remainder is carried over as an accrual on the first day of the next month,
+// then the withholding tax is debited to the withholding tax system account as a separate transaction.
+// Every account is posted in its own database transaction, the accruals left unposted
+// (e.g. the account is closed) are posted by the next run
+func (p postInterestUsecase) PostInterest(ctx context.Context, month time.Time) (*entity.InterestPostingReport, error) {
+	var (
+		err     error
+		afterID uint
+		report  = &entity.InterestPostingReport{Month: startOfMonth(month)}
+		before  = report.Month.AddDate(0, 1, 0)
+		logger  = p.logger.WithDuration(
+			ctx,
+			"postInterestUsecase.PostInterest",
+			map[string]interface{}{
+				"month": report.Month,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	for {
+		var accountIDs []uint
+		accountIDs, err = p.interestRepository.FindUnpostedInterestAccountIDs(ctx, before, afterID, DefaultPostInterestBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, accountID := range accountIDs {
+			posting := &entity.InterestPosting{AccountID: accountID}
+			report.Postings = append(report.Postings, posting)
+
+			err = p.postAccountInterest(ctx, accountID, before, posting)
+
+			var domainError *entity.DomainError
+			if errors.As(err, &domainError) {
+				posting.Status = entity.InterestFailed
+				posting.Reason = domainError.Code
+				err = nil
+			} else if err != nil {
+				return nil, err
+			}
+
+			afterID = accountID
+		}
+
+		if len(accountIDs) < DefaultPostInterestBatchSize {
+			break
+		}
+	}
+
+	return report, nil
+}
+
+// postAccountInterest posts the unposted accruals of a single account before the given date
+func (p postInterestUsecase) postAccountInterest(ctx context.Context, accountID uint, before time.Time, posting *entity.InterestPosting) error {
+	applyLock := true
+
+	return p.transactionManager.WithTransaction(ctx, func(ctx context.Context) error {
+		// Lock the account so its balance can't change while the interest is posted
+		account, err := p.accountRepository.FindByID(ctx, accountID, applyLock)
+		if err != nil {
+			return err
+		}
+
+		posting.AccountNumber = account.AccountNumber
+		posting.Currency = account.Currency
+
+		// Lock the accruals so concurrent runs of the job can't post them twice
+		accruals, err := p.interestRepository.FindUnpostedInterestAccruals(ctx, account.ID, before, applyLock)
+		if err != nil {
+			return err
+		}
+
+		accrued := decimal.Zero
+		accrualIDs := make([]uint, 0, len(accruals))
+		for _, accrual := range accruals {
+			accrued = accrued.Add(accrual.Amount)
+			accrualIDs = append(accrualIDs, accrual.ID)
+		}
+
+		interest := accrued.Truncate(account.Currency.MinorUnits())
+		posting.Interest = interest
+		posting.Tax = decimal.Zero
+		posting.Residual = decimal.Zero
+		posting.Accruals = len(accruals)
+
+		// A small balance may accrue less than the minor unit in a month,
+		// the accruals are kept so they add up with the next month
+		if !interest.IsPositive() {
+			posting.Status = entity.InterestCarriedOver
+			return nil
+		}
+
+		// Interest is still paid to blocked and dormant accounts, only a closed account can't receive it
+		if account.Status == entity.AccountStatusClosed {
+			return entity.ErrAccountClosed
+		}
+
+		// The tax-free threshold applies to the end-of-day balance of the last accrued day
+		tax := p.taxPolicy.WithholdingTax(interest, accruals[len(accruals)-1].Balance, account.Currency)
+		posting.Tax = tax
+
+		interestTransaction, err := p.creditInterest(ctx, account, interest, tax)
+		if err != nil {
+			return err
+		}
+
+		if err := p.interestRepository.MarkInterestAccrualsPosted(ctx, accrualIDs, interestTransaction.ID); err != nil {
+			return err
+		}
+
+		// The remainder below the minor unit is carried over to the first day of the next month,
+		// so it's posted with the interest of that month
+		if residual := accrued.Sub(interest); residual.IsPositive() {
+			lastAccrual := accruals[len(accruals)-1]
+			_, err := p.interestRepository.CreateInterestAccrual(ctx, &entity.InterestAccrual{
+				AccountID:   account.ID,
+				AccrualDate: before,
+				Balance:     lastAccrual.Balance,
+				AnnualRate:  decimal.Zero,
+				Amount:      residual,
+				CarriedOver: true,
+			})
+			if err != nil {
+				return err
+			}
+
+			posting.Residual = residual
+		}
+
+		posting.Status = entity.InterestPosted
+		return nil
+	})
+}
+
+// creditInterest credits the interest to the account and debits the withholding tax from it
+func (p postInterestUsecase) creditInterest(ctx context.Context, account *entity.Account, interest, tax decimal.Decimal) (*entity.Transaction, error) {
+	interestExpense, err := p.ledger.SystemAccount(ctx, entity.SystemAccountInterestExpense)
+	if err != nil {
+		return nil, err
+	}
+
+	interestTransaction, err := p.transactionRepository.CreateTransaction(ctx, &entity.Transaction{
+		AccountID:      account.ID,
+		Type:           entity.TransactionTypeInterest,
+		Amount:         interest,
+		InitialBalance: account.Balance,
+		FinalBalance:   account.Balance.Add(interest),
+		Currency:       account.Currency,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	account.Balance = account.Balance.Add(interest)
+
+	journal := entity.NewJournal("Bunga tabungan").
+		Debit(interestExpense.ID, 0, interest, account.Currency).
+		Credit(account.ID, interestTransaction.ID, interest, account.Currency)
+
+	var taxTransaction *entity.Transaction
+	if tax.IsPositive() {
+		withholdingTax, err := p.ledger.SystemAccount(ctx, entity.SystemAccountWithholdingTax)
+		if err != nil {
+			return nil, err
+		}
+
+		taxTransaction, err = p.transactionRepository.CreateTransaction(ctx, &entity.Transaction{
+			AccountID:      account.ID,
+			Type:           entity.TransactionTypeWithholdingTax,
+			Amount:         tax,
+			InitialBalance: account.Balance,
+			FinalBalance:   account.Balance.Sub(tax),
+			Currency:       account.Currency,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		account.Balance = account.Balance.Sub(tax)
+
+		journal.
+			Debit(account.ID, taxTransaction.ID, tax, account.Currency).
+			Credit(withholdingTax.ID, 0, tax, account.Currency)
+	}
+
+	if _, err := p.accountRepository.UpdateAccount(ctx, account); err != nil {
+		return nil, err
+	}
+
+	if err := p.ledger.Post(ctx, journal); err != nil {
+		return nil, err
+	}
+
+	if err := p.outbox.RecordBalanceChanged(ctx, account, interestTransaction); err != nil {
+		return nil, err
+	}
+
+	if taxTransaction != nil {
+		if err := p.outbox.RecordBalanceChanged(ctx, account, taxTransaction); err != nil {
+			return nil, err
+		}
+	}
+
+	return interestTransaction, nil
+}
+
+// startOfMonth truncates the time to the first day of its month in UTC
+func startOfMonth(t time.Time) time.Time {
+	year, month, _ := t.UTC().Date()
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
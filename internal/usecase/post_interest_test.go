@@ -0,0 +1,279 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	repositorymock "imansohibul.my.id/account-domain-service/internal/usecase/mock"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestPostInterest(t *testing.T) {
+	var (
+		month  = time.Date(2025, time.May, 17, 0, 0, 0, 0, time.UTC)
+		before = time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	tests := []struct {
+		name                 string
+		accountStatus        entity.AccountStatus
+		accountBalance       decimal.Decimal
+		accruals             []*entity.InterestAccrual
+		expectedStatus       entity.InterestPostingStatus
+		expectedReason       string
+		expectedInterest     string
+		expectedTax          string
+		expectedResidual     string
+		expectedBalance      string
+		expectedTransactions []entity.TransactionType
+	}{
+		{
+			name:           "Posted - Withholding Tax Deducted",
+			accountStatus:  entity.AccountStatusActive,
+			accountBalance: decimal.NewFromInt(50000000),
+			accruals: []*entity.InterestAccrual{
+				{ID: 1, Balance: decimal.NewFromInt(50000000), Amount: decimal.RequireFromString("1027.3972602739")},
+				{ID: 2, Balance: decimal.NewFromInt(50000000), Amount: decimal.RequireFromString("1027.3972602739")},
+				{ID: 3, Balance: decimal.NewFromInt(50000000), Amount: decimal.RequireFromString("1027.3972602739")},
+			},
+			expectedStatus:       entity.InterestPosted,
+			expectedInterest:     "3082.19",
+			expectedTax:          "616.43",
+			expectedResidual:     "0.0017808217",
+			expectedBalance:      "50002465.76",
+			expectedTransactions: []entity.TransactionType{entity.TransactionTypeInterest, entity.TransactionTypeWithholdingTax},
+		},
+		{
+			name:           "Posted - Balance Below The Tax-Free Threshold",
+			accountStatus:  entity.AccountStatusDormant,
+			accountBalance: decimal.NewFromInt(5000000),
+			accruals: []*entity.InterestAccrual{
+				{ID: 1, Balance: decimal.NewFromInt(9000000), Amount: decimal.RequireFromString("123.2876712328")},
+				{ID: 2, Balance: decimal.NewFromInt(5000000), Amount: decimal.RequireFromString("68.4931506849")},
+			},
+			expectedStatus:       entity.InterestPosted,
+			expectedInterest:     "191.78",
+			expectedTax:          "0",
+			expectedResidual:     "0.0008219177",
+			expectedBalance:      "5000191.78",
+			expectedTransactions: []entity.TransactionType{entity.TransactionTypeInterest},
+		},
+		{
+			name:           "Carried Over - Interest Below The Minor Unit",
+			accountStatus:  entity.AccountStatusActive,
+			accountBalance: decimal.NewFromInt(10),
+			accruals: []*entity.InterestAccrual{
+				{ID: 1, Balance: decimal.NewFromInt(10), Amount: decimal.RequireFromString("0.0041095890")},
+			},
+			expectedStatus:   entity.InterestCarriedOver,
+			expectedInterest: "0",
+			expectedTax:      "0",
+			expectedResidual: "0",
+			expectedBalance:  "10",
+		},
+		{
+			name:           "Failed - Account Closed",
+			accountStatus:  entity.AccountStatusClosed,
+			accountBalance: decimal.Zero,
+			accruals: []*entity.InterestAccrual{
+				{ID: 1, Balance: decimal.NewFromInt(50000000), Amount: decimal.RequireFromString("1027.3972602739")},
+			},
+			expectedStatus:   entity.InterestFailed,
+			expectedReason:   entity.ErrAccountClosed.Code,
+			expectedInterest: "1027.39",
+			expectedTax:      "0",
+			expectedResidual: "0",
+			expectedBalance:  "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctrl                  = gomock.NewController(t)
+				accountRepository     = repositorymock.NewMockAccountRepository(ctrl)
+				transactionRepository = repositorymock.NewMockTransactionRepository(ctrl)
+				interestRepository    = repositorymock.NewMockInterestRepository(ctrl)
+				transactionManager    = repositorymock.NewMockTransactionManager(ctrl)
+				journalRepository     = repositorymock.NewMockJournalRepository(ctrl)
+				outboxRepository      = repositorymock.NewMockOutboxRepository(ctrl)
+
+				account         = &entity.Account{ID: 10, AccountNumber: "1234567897", AccountType: entity.AccountTypeSaving, Status: tt.accountStatus, Currency: entity.CurrencyIDR, Balance: tt.accountBalance}
+				interestExpense = &entity.Account{ID: 5, AccountNumber: entity.SystemAccountInterestExpense, AccountType: entity.AccountTypeInternal}
+				withholdingTax  = &entity.Account{ID: 6, AccountNumber: entity.SystemAccountWithholdingTax, AccountType: entity.AccountTypeInternal}
+				transactions    []*entity.Transaction
+				postedIDs       []uint
+				carriedOver     []*entity.InterestAccrual
+			)
+
+			transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction)
+			interestRepository.EXPECT().FindUnpostedInterestAccountIDs(gomock.Any(), before, uint(0), DefaultPostInterestBatchSize).Return([]uint{account.ID}, nil)
+			accountRepository.EXPECT().FindByID(gomock.Any(), account.ID, true).Return(account, nil)
+			interestRepository.EXPECT().FindUnpostedInterestAccruals(gomock.Any(), account.ID, before, true).Return(tt.accruals, nil)
+			accountRepository.EXPECT().FindSystemAccount(gomock.Any(), entity.SystemAccountInterestExpense).Return(interestExpense, nil).AnyTimes()
+			accountRepository.EXPECT().FindSystemAccount(gomock.Any(), entity.SystemAccountWithholdingTax).Return(withholdingTax, nil).AnyTimes()
+			accountRepository.EXPECT().UpdateAccount(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, a *entity.Account) (*entity.Account, error) {
+					return a, nil
+				}).AnyTimes()
+			transactionRepository.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error) {
+					transaction.ID = uint(len(transactions) + 100)
+					transactions = append(transactions, transaction)
+					return transaction, nil
+				}).AnyTimes()
+			journalRepository.EXPECT().CreateJournal(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, journal *entity.Journal) (*entity.Journal, error) {
+					assert.NoError(t, journal.Validate())
+					return journal, nil
+				}).AnyTimes()
+			outboxRepository.EXPECT().CreateOutboxEvent(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, event *entity.OutboxEvent) (*entity.OutboxEvent, error) {
+					return event, nil
+				}).AnyTimes()
+			interestRepository.EXPECT().MarkInterestAccrualsPosted(gomock.Any(), gomock.Any(), uint(100)).
+				DoAndReturn(func(ctx context.Context, accrualIDs []uint, transactionID uint) error {
+					postedIDs = accrualIDs
+					return nil
+				}).AnyTimes()
+			interestRepository.EXPECT().CreateInterestAccrual(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, accrual *entity.InterestAccrual) (*entity.InterestAccrual, error) {
+					carriedOver = append(carriedOver, accrual)
+					return accrual, nil
+				}).AnyTimes()
+
+			postInterestUsecase := NewPostInterestUsecase(
+				accountRepository,
+				transactionRepository,
+				interestRepository,
+				transactionManager,
+				journalRepository,
+				outboxRepository,
+				entity.InterestTaxPolicy{Rate: decimal.NewFromInt(20), TaxFreeBalance: decimal.NewFromInt(7500000)},
+				util.GetZapLogger(),
+			)
+
+			report, err := postInterestUsecase.PostInterest(context.Background(), month)
+
+			assert.NoError(t, err)
+			assert.Equal(t, time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC), report.Month)
+			assert.Len(t, report.Postings, 1)
+			assert.Equal(t, tt.expectedStatus, report.Postings[0].Status)
+			assert.Equal(t, tt.expectedReason, report.Postings[0].Reason)
+			assert.Equal(t, tt.expectedInterest, report.Postings[0].Interest.String())
+			assert.Equal(t, tt.expectedTax, report.Postings[0].Tax.String())
+			assert.Equal(t, tt.expectedResidual, report.Postings[0].Residual.String())
+			assert.Equal(t, tt.expectedBalance, account.Balance.String())
+			assert.Len(t, transactions, len(tt.expectedTransactions))
+
+			for i, transaction := range transactions {
+				assert.Equal(t, tt.expectedTransactions[i], transaction.Type)
+			}
+
+			if tt.expectedStatus == entity.InterestPosted {
+				assert.Len(t, postedIDs, len(tt.accruals))
+				assert.Equal(t, tt.expectedBalance, transactions[len(transactions)-1].FinalBalance.String())
+
+				// The remainder is carried over to the first day of the next month
+				assert.Len(t, carriedOver, 1)
+				assert.True(t, carriedOver[0].CarriedOver)
+				assert.Equal(t, before, carriedOver[0].AccrualDate)
+				assert.Equal(t, tt.expectedResidual, carriedOver[0].Amount.String())
+			} else {
+				assert.Nil(t, postedIDs)
+				assert.Nil(t, carriedOver)
+			}
+		})
+	}
+}
+
+func TestPostInterestCarriesResidualOver(t *testing.T) {
+	var (
+		ctrl                  = gomock.NewController(t)
+		accountRepository     = repositorymock.NewMockAccountRepository(ctrl)
+		transactionRepository = repositorymock.NewMockTransactionRepository(ctrl)
+		interestRepository    = repositorymock.NewMockInterestRepository(ctrl)
+		transactionManager    = repositorymock.NewMockTransactionManager(ctrl)
+		journalRepository     = repositorymock.NewMockJournalRepository(ctrl)
+		outboxRepository      = repositorymock.NewMockOutboxRepository(ctrl)
+
+		account         = &entity.Account{ID: 10, AccountNumber: "1234567897", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, Currency: entity.CurrencyIDR, Balance: decimal.NewFromInt(5000000)}
+		interestExpense = &entity.Account{ID: 5, AccountNumber: entity.SystemAccountInterestExpense, AccountType: entity.AccountTypeInternal}
+		june            = time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+		july            = time.Date(2025, time.July, 1, 0, 0, 0, 0, time.UTC)
+		residual        *entity.InterestAccrual
+	)
+
+	transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction).Times(2)
+	accountRepository.EXPECT().FindByID(gomock.Any(), account.ID, true).Return(account, nil).Times(2)
+	accountRepository.EXPECT().FindSystemAccount(gomock.Any(), entity.SystemAccountInterestExpense).Return(interestExpense, nil).Times(2)
+	accountRepository.EXPECT().UpdateAccount(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, a *entity.Account) (*entity.Account, error) {
+			return a, nil
+		}).Times(2)
+	transactionRepository.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error) {
+			transaction.ID = 100
+			return transaction, nil
+		}).Times(2)
+	journalRepository.EXPECT().CreateJournal(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, journal *entity.Journal) (*entity.Journal, error) {
+			return journal, nil
+		}).Times(2)
+	outboxRepository.EXPECT().CreateOutboxEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, event *entity.OutboxEvent) (*entity.OutboxEvent, error) {
+			return event, nil
+		}).Times(2)
+	interestRepository.EXPECT().MarkInterestAccrualsPosted(gomock.Any(), gomock.Any(), uint(100)).Return(nil).Times(2)
+
+	// May accrued 1234.5678, the 0.0078 below the minor unit is carried over to the 1st of June
+	interestRepository.EXPECT().FindUnpostedInterestAccountIDs(gomock.Any(), june, uint(0), DefaultPostInterestBatchSize).Return([]uint{account.ID}, nil)
+	interestRepository.EXPECT().FindUnpostedInterestAccruals(gomock.Any(), account.ID, june, true).Return([]*entity.InterestAccrual{
+		{ID: 1, Balance: decimal.NewFromInt(5000000), Amount: decimal.RequireFromString("1234.5678")},
+	}, nil)
+	interestRepository.EXPECT().CreateInterestAccrual(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, accrual *entity.InterestAccrual) (*entity.InterestAccrual, error) {
+			accrual.ID = 2
+			residual = accrual
+			return accrual, nil
+		})
+
+	// June adds the carried over remainder to its own accruals
+	interestRepository.EXPECT().FindUnpostedInterestAccountIDs(gomock.Any(), july, uint(0), DefaultPostInterestBatchSize).Return([]uint{account.ID}, nil)
+	interestRepository.EXPECT().FindUnpostedInterestAccruals(gomock.Any(), account.ID, july, true).
+		DoAndReturn(func(ctx context.Context, accountID uint, before time.Time, lock bool) ([]*entity.InterestAccrual, error) {
+			return []*entity.InterestAccrual{residual, {ID: 3, Balance: decimal.NewFromInt(5000000), Amount: decimal.RequireFromString("1000.0022")}}, nil
+		})
+
+	postInterestUsecase := NewPostInterestUsecase(
+		accountRepository,
+		transactionRepository,
+		interestRepository,
+		transactionManager,
+		journalRepository,
+		outboxRepository,
+		entity.InterestTaxPolicy{Rate: decimal.NewFromInt(20), TaxFreeBalance: decimal.NewFromInt(7500000)},
+		util.GetZapLogger(),
+	)
+
+	mayReport, err := postInterestUsecase.PostInterest(context.Background(), time.Date(2025, time.May, 31, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, "1234.56", mayReport.Postings[0].Interest.String())
+	assert.Equal(t, "0.0078", mayReport.Postings[0].Residual.String())
+	assert.Equal(t, june, residual.AccrualDate)
+	assert.True(t, residual.CarriedOver)
+
+	// 0.0078 + 1000.0022 is posted in full, nothing is left to carry over
+	juneReport, err := postInterestUsecase.PostInterest(context.Background(), june)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.InterestPosted, juneReport.Postings[0].Status)
+	assert.Equal(t, "1000.01", juneReport.Postings[0].Interest.String())
+	assert.Equal(t, "0", juneReport.Postings[0].Residual.String())
+	assert.Equal(t, 2, juneReport.Postings[0].Accruals)
+	assert.Equal(t, "5002234.57", account.Balance.String())
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+type quoteFeeUsecase struct {
+	accountRepository AccountRepository
+	feeSchedule       feeSchedule
+	logger            util.Logger
+}
+
+func NewQuoteFeeUsecase(
+	accountRepository AccountRepository,
+	feeRuleRepository FeeRuleRepository,
+	logger util.Logger,
+) *quoteFeeUsecase {
+	return &quoteFeeUsecase{
+		accountRepository: accountRepository,
+		feeSchedule:       feeSchedule{feeRuleRepository: feeRuleRepository}, // only computes fees, never charges them
+		logger:            logger,
+	}
+}
+
+// QuoteFee computes the fee a withdrawal or a transfer would be charged, so it can be shown before it's confirmed
+// The fee schedule may change before the operation is made, the operation always charges the fee in effect
+func (q quoteFeeUsecase) QuoteFee(ctx context.Context, params *entity.FeeQuoteParams) (*entity.FeeQuote, error) {
+	var (
+		err       error
+		applyLock = false
+		logger    = q.logger.WithDuration(
+			ctx,
+			"quoteFeeUsecase.QuoteFee",
+			map[string]interface{}{
+				"account_number": params.AccountNumber,
+				"operation":      params.Operation,
+				"channel":        params.Channel,
+				"amount":         params.Amount,
+				"currency":       params.Currency,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	account, err := q.accountRepository.FindByAccountNumber(ctx, params.AccountNumber, applyLock)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = account.ValidateAmount(params.Amount, params.Currency); err != nil {
+		return nil, err
+	}
+
+	fee, err := q.feeSchedule.Fee(ctx, account, params.Operation, params.Channel, params.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.FeeQuote{
+		Amount:   params.Amount,
+		Fee:      fee,
+		Total:    params.Amount.Add(fee),
+		Currency: account.Currency,
+	}, nil
+}
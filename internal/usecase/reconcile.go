@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// DefaultReconcileBatchSize is the number of accounts or transactions read per query while reconciling
+const DefaultReconcileBatchSize = 500
+
+type reconcileUsecase struct {
+	accountRepository     AccountRepository
+	transactionRepository TransactionRepository
+	transactionManager    TransactionManager
+	logger                util.Logger
+}
+
+func NewReconcileUsecase(
+	accountRepository AccountRepository,
+	transactionRepository TransactionRepository,
+	transactionManager TransactionManager,
+	logger util.Logger,
+) *reconcileUsecase {
+	return &reconcileUsecase{
+		accountRepository:     accountRepository,
+		transactionRepository: transactionRepository,
+		transactionManager:    transactionManager,
+		logger:                logger,
+	}
+}
+
+// Reconcile replays the transactions of every account and reports where
+// the transaction chain or the stored balance drifted
+func (r reconcileUsecase) Reconcile(ctx context.Context) (*entity.ReconciliationReport, error) {
+	var (
+		err     error
+		afterID uint
+		report  = new(entity.ReconciliationReport)
+		logger  = r.logger.WithDuration(
+			ctx,
+			"reconcileUsecase.Reconcile",
+			map[string]interface{}{},
+		)
+	)
+
+	defer logger(&err)
+
+	for {
+		var accounts []*entity.Account
+		accounts, err = r.accountRepository.FindAccounts(ctx, afterID, DefaultReconcileBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, account := range accounts {
+			err = r.reconcileAccount(ctx, account, report)
+			if err != nil {
+				return nil, err
+			}
+
+			afterID = account.ID
+		}
+
+		if len(accounts) < DefaultReconcileBatchSize {
+			break
+		}
+	}
+
+	return report, nil
+}
+
+// reconcileAccount replays the transactions of a single account.
+// The account is locked while it's replayed, so a concurrent deposit or withdrawal
+// can't be reported as a drift between the balance and the transactions.
+func (r reconcileUsecase) reconcileAccount(ctx context.Context, account *entity.Account, report *entity.ReconciliationReport) error {
+	applyLock := true
+
+	return r.transactionManager.WithTransaction(ctx, func(ctx context.Context) error {
+		account, err := r.accountRepository.FindByAccountNumber(ctx, account.AccountNumber, applyLock)
+		if err != nil {
+			return err
+		}
+
+		var (
+			// Every account is opened with a zero balance
+			expectedBalance   = decimal.Zero
+			lastTransactionID uint
+		)
+
+		for {
+			transactions, err := r.transactionRepository.FindTransactionsByAccountID(ctx, account.ID, lastTransactionID, DefaultReconcileBatchSize)
+			if err != nil {
+				return err
+			}
+
+			for _, transaction := range transactions {
+				if !transaction.InitialBalance.Equal(expectedBalance) {
+					report.Mismatches = append(report.Mismatches, &entity.ReconciliationMismatch{
+						AccountID:     account.ID,
+						AccountNumber: account.AccountNumber,
+						TransactionID: transaction.ID,
+						Type:          entity.ReconciliationBrokenChain,
+						Expected:      expectedBalance,
+						Actual:        transaction.InitialBalance,
+					})
+				}
+
+				finalBalance := transaction.InitialBalance.Add(transaction.Amount)
+				if transaction.Type.IsDebit() {
+					finalBalance = transaction.InitialBalance.Sub(transaction.Amount)
+				}
+
+				if !transaction.FinalBalance.Equal(finalBalance) {
+					report.Mismatches = append(report.Mismatches, &entity.ReconciliationMismatch{
+						AccountID:     account.ID,
+						AccountNumber: account.AccountNumber,
+						TransactionID: transaction.ID,
+						Type:          entity.ReconciliationInvalidAmount,
+						Expected:      finalBalance,
+						Actual:        transaction.FinalBalance,
+					})
+				}
+
+				// Continue the chain from the stored balance so a single drift is reported once
+				expectedBalance = transaction.FinalBalance
+				lastTransactionID = transaction.ID
+				report.CheckedTransactions++
+			}
+
+			if len(transactions) < DefaultReconcileBatchSize {
+				break
+			}
+		}
+
+		if !account.Balance.Equal(expectedBalance) {
+			report.Mismatches = append(report.Mismatches, &entity.ReconciliationMismatch{
+				AccountID:     account.ID,
+				AccountNumber: account.AccountNumber,
+				TransactionID: lastTransactionID,
+				Type:          entity.ReconciliationBalanceMismatch,
+				Expected:      expectedBalance,
+				Actual:        account.Balance,
+			})
+		}
+
+		report.CheckedAccounts++
+		return nil
+	})
+}
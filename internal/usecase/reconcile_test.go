@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	repositorymock "imansohibul.my.id/account-domain-service/internal/usecase/mock"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestReconcile(t *testing.T) {
+	var (
+		ctrl                  = gomock.NewController(t)
+		accountRepository     = repositorymock.NewMockAccountRepository(ctrl)
+		transactionRepository = repositorymock.NewMockTransactionRepository(ctrl)
+		transactionManager    = repositorymock.NewMockTransactionManager(ctrl)
+
+		healthy = &entity.Account{ID: 1, AccountNumber: "1111111111", AccountType: entity.AccountTypeSaving, Balance: decimal.NewFromInt(70000)}
+		drifted = &entity.Account{ID: 2, AccountNumber: "2222222222", AccountType: entity.AccountTypeSaving, Balance: decimal.NewFromInt(90000)}
+	)
+
+	accountRepository.EXPECT().FindAccounts(gomock.Any(), uint(0), DefaultReconcileBatchSize).Return([]*entity.Account{healthy, drifted}, nil)
+	accountRepository.EXPECT().FindByAccountNumber(gomock.Any(), healthy.AccountNumber, true).Return(healthy, nil)
+	accountRepository.EXPECT().FindByAccountNumber(gomock.Any(), drifted.AccountNumber, true).Return(drifted, nil)
+	transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction).Times(2)
+
+	transactionRepository.EXPECT().FindTransactionsByAccountID(gomock.Any(), healthy.ID, uint(0), DefaultReconcileBatchSize).Return([]*entity.Transaction{
+		{ID: 10, Type: entity.TransactionTypeCredit, Amount: decimal.NewFromInt(100000), InitialBalance: decimal.Zero, FinalBalance: decimal.NewFromInt(100000)},
+		{ID: 11, Type: entity.TransactionTypeDebit, Amount: decimal.NewFromInt(30000), InitialBalance: decimal.NewFromInt(100000), FinalBalance: decimal.NewFromInt(70000)},
+	}, nil)
+
+	// The second transaction doesn't start from the final balance of the first one
+	// and the stored balance was updated manually
+	transactionRepository.EXPECT().FindTransactionsByAccountID(gomock.Any(), drifted.ID, uint(0), DefaultReconcileBatchSize).Return([]*entity.Transaction{
+		{ID: 20, Type: entity.TransactionTypeCredit, Amount: decimal.NewFromInt(50000), InitialBalance: decimal.Zero, FinalBalance: decimal.NewFromInt(50000)},
+		{ID: 21, Type: entity.TransactionTypeCredit, Amount: decimal.NewFromInt(10000), InitialBalance: decimal.NewFromInt(60000), FinalBalance: decimal.NewFromInt(70000)},
+	}, nil)
+
+	reconcileUsecase := NewReconcileUsecase(accountRepository, transactionRepository, transactionManager, util.GetZapLogger())
+	report, err := reconcileUsecase.Reconcile(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, report.CheckedAccounts)
+	assert.Equal(t, 4, report.CheckedTransactions)
+	if assert.Len(t, report.Mismatches, 2) {
+		assert.Equal(t, entity.ReconciliationBrokenChain, report.Mismatches[0].Type)
+		assert.Equal(t, uint(21), report.Mismatches[0].TransactionID)
+		assert.Equal(t, entity.ReconciliationBalanceMismatch, report.Mismatches[1].Type)
+		assert.True(t, decimal.NewFromInt(70000).Equal(report.Mismatches[1].Expected))
+		assert.True(t, decimal.NewFromInt(90000).Equal(report.Mismatches[1].Actual))
+	}
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+// DefaultRelayBatchSize is the number of outbox events claimed and published per relay
+const DefaultRelayBatchSize = 100
+
+type relayUsecase struct {
+	outboxRepository   OutboxRepository
+	transactionManager TransactionManager
+	publisher          EventPublisher
+	logger             util.Logger
+}
+
+func NewRelayUsecase(
+	outboxRepository OutboxRepository,
+	transactionManager TransactionManager,
+	publisher EventPublisher,
+	logger util.Logger,
+) *relayUsecase {
+	return &relayUsecase{
+		outboxRepository:   outboxRepository,
+		transactionManager: transactionManager,
+		publisher:          publisher,
+		logger:             logger,
+	}
+}
+
+// Relay publishes a batch of due pending outbox events.
+// A failed event is retried by a later relay with an exponential backoff,
+// so an unavailable publisher never blocks the other events of the batch.
+func (r relayUsecase) Relay(ctx context.Context, batchSize int) (*entity.RelayReport, error) {
+	var (
+		err    error
+		report = new(entity.RelayReport)
+		logger = r.logger.WithDuration(
+			ctx,
+			"relayUsecase.Relay",
+			map[string]interface{}{
+				"batch_size": batchSize,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	if batchSize <= 0 {
+		batchSize = DefaultRelayBatchSize
+	}
+
+	// The events are claimed in a short database transaction, so the rows aren't kept locked
+	// while they are published and a concurrent relay skips them until the lease expired
+	var events []*entity.OutboxEvent
+	err = r.transactionManager.WithTransaction(ctx, func(ctx context.Context) error {
+		pendingEvents, err := r.outboxRepository.FindPendingOutboxEvents(ctx, time.Now(), batchSize)
+		if err != nil {
+			return err
+		}
+
+		events = pendingEvents
+		for _, event := range events {
+			event.Claim(time.Now())
+			if _, err := r.outboxRepository.UpdateOutboxEvent(ctx, event); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		if publishErr := r.publisher.Publish(ctx, event); publishErr != nil {
+			event.MarkFailed(publishErr, time.Now())
+
+			r.logger.Warn(ctx, "Failed to publish outbox event", map[string]interface{}{
+				"event_id":   event.ID,
+				"event_type": event.EventType,
+				"attempts":   event.Attempts,
+				"error":      publishErr.Error(),
+			})
+		} else {
+			event.MarkDelivered(time.Now())
+		}
+
+		// An event published but not marked is published again once its lease expired
+		if _, err = r.outboxRepository.UpdateOutboxEvent(ctx, event); err != nil {
+			return nil, err
+		}
+
+		switch event.Status {
+		case entity.OutboxStatusDelivered:
+			report.Delivered++
+		case entity.OutboxStatusFailed:
+			report.Failed++
+		default:
+			report.Retried++
+		}
+	}
+
+	return report, nil
+}
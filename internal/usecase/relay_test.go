@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	repositorymock "imansohibul.my.id/account-domain-service/internal/usecase/mock"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestRelay(t *testing.T) {
+	var (
+		ctrl               = gomock.NewController(t)
+		outboxRepository   = repositorymock.NewMockOutboxRepository(ctrl)
+		transactionManager = repositorymock.NewMockTransactionManager(ctrl)
+		publisher          = repositorymock.NewMockEventPublisher(ctrl)
+
+		delivered = &entity.OutboxEvent{ID: 1, EventType: entity.EventTypeAccountCreated, Status: entity.OutboxStatusPending}
+		retried   = &entity.OutboxEvent{ID: 2, EventType: entity.EventTypeBalanceCredited, Status: entity.OutboxStatusPending, Attempts: 2}
+		failed    = &entity.OutboxEvent{ID: 3, EventType: entity.EventTypeBalanceDebited, Status: entity.OutboxStatusPending, Attempts: entity.MaxOutboxAttempts - 1}
+	)
+
+	// The events are claimed inside the database transaction and published after it was committed
+	var inTransaction bool
+	transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			inTransaction = true
+			defer func() { inTransaction = false }()
+			return fn(ctx)
+		})
+	outboxRepository.EXPECT().FindPendingOutboxEvents(gomock.Any(), gomock.Any(), 10).Return([]*entity.OutboxEvent{delivered, retried, failed}, nil)
+
+	var claimed []entity.OutboxEvent
+	outboxRepository.EXPECT().UpdateOutboxEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, event *entity.OutboxEvent) (*entity.OutboxEvent, error) {
+			if inTransaction {
+				claimed = append(claimed, *event)
+			}
+			return event, nil
+		}).Times(6)
+
+	publish := func(err error) func(ctx context.Context, event *entity.OutboxEvent) error {
+		return func(ctx context.Context, event *entity.OutboxEvent) error {
+			assert.False(t, inTransaction)
+			return err
+		}
+	}
+	publisher.EXPECT().Publish(gomock.Any(), delivered).DoAndReturn(publish(nil))
+	publisher.EXPECT().Publish(gomock.Any(), retried).DoAndReturn(publish(errors.New("connection refused")))
+	publisher.EXPECT().Publish(gomock.Any(), failed).DoAndReturn(publish(errors.New("connection refused")))
+
+	relayUsecase := NewRelayUsecase(outboxRepository, transactionManager, publisher, util.GetZapLogger())
+	report, err := relayUsecase.Relay(context.Background(), 10)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &entity.RelayReport{Delivered: 1, Retried: 1, Failed: 1}, report)
+
+	// The claimed events are hidden from the other relays until the lease expired
+	assert.Len(t, claimed, 3)
+	for _, event := range claimed {
+		assert.Equal(t, entity.OutboxStatusPending, event.Status)
+		assert.WithinDuration(t, time.Now().Add(entity.OutboxClaimLease), event.NextAttemptAt, time.Second)
+	}
+
+	assert.Equal(t, entity.OutboxStatusDelivered, delivered.Status)
+	assert.False(t, delivered.DeliveredAt.IsZero())
+
+	// The backoff is doubled after every failed attempt, the third one waits 4 times the initial backoff
+	assert.Equal(t, entity.OutboxStatusPending, retried.Status)
+	assert.Equal(t, 3, retried.Attempts)
+	assert.Equal(t, "connection refused", retried.LastError)
+	assert.WithinDuration(t, time.Now().Add(4*entity.OutboxInitialBackoff), retried.NextAttemptAt, time.Second)
+
+	assert.Equal(t, entity.OutboxStatusFailed, failed.Status)
+	assert.Equal(t, entity.MaxOutboxAttempts, failed.Attempts)
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+type releaseHoldUsecase struct {
+	accountRepository AccountRepository
+	holdRepository    HoldRepository
+	idempotencyGuard  idempotencyGuard
+	logger            util.Logger
+}
+
+func NewReleaseHoldUsecase(
+	accountRepository AccountRepository,
+	holdRepository HoldRepository,
+	transactionManager TransactionManager,
+	idempotencyKeyRepository IdempotencyKeyRepository,
+	logger util.Logger,
+) *releaseHoldUsecase {
+	return &releaseHoldUsecase{
+		accountRepository: accountRepository,
+		holdRepository:    holdRepository,
+		idempotencyGuard:  newIdempotencyGuard(idempotencyKeyRepository, transactionManager),
+		logger:            logger,
+	}
+}
+
+// ReleaseHold cancels an active hold, its amount becomes available again and nothing is debited
+// An expired hold not yet processed by the expiry job can still be released
+func (r releaseHoldUsecase) ReleaseHold(ctx context.Context, params *entity.ReleaseHoldParams) (*entity.Hold, error) {
+	var (
+		err      error
+		released = new(entity.Hold)
+		logger   = r.logger.WithDuration(
+			ctx,
+			"releaseHoldUsecase.ReleaseHold",
+			map[string]interface{}{
+				"hold_id":         params.HoldID,
+				"idempotency_key": params.IdempotencyKey,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	requestHash := hashRequest(strconv.FormatUint(uint64(params.HoldID), 10))
+
+	err = r.idempotencyGuard.Run(ctx, entity.IdempotencyScopeReleaseHold, params.IdempotencyKey, requestHash, released, func(ctx context.Context) error {
+		account, hold, err := lockHold(ctx, r.accountRepository, r.holdRepository, params.HoldID)
+		if err != nil {
+			return err
+		}
+
+		if hold.Status != entity.HoldStatusActive {
+			return entity.ErrHoldNotActive
+		}
+
+		account.HeldAmount = account.HeldAmount.Sub(hold.Amount)
+		if _, err := r.accountRepository.UpdateAccount(ctx, account); err != nil {
+			return err
+		}
+
+		hold.Status = entity.HoldStatusReleased
+		updatedHold, err := r.holdRepository.UpdateHold(ctx, hold)
+		if err != nil {
+			return err
+		}
+
+		*released = *updatedHold
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return released, nil
+}
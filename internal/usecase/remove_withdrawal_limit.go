@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+type removeWithdrawalLimitUsecase struct {
+	accountRepository         AccountRepository
+	withdrawalLimitRepository WithdrawalLimitRepository
+	transactionManager        TransactionManager
+	logger                    util.Logger
+}
+
+func NewRemoveWithdrawalLimitUsecase(
+	accountRepository AccountRepository,
+	withdrawalLimitRepository WithdrawalLimitRepository,
+	transactionManager TransactionManager,
+	logger util.Logger,
+) *removeWithdrawalLimitUsecase {
+	return &removeWithdrawalLimitUsecase{
+		accountRepository:         accountRepository,
+		withdrawalLimitRepository: withdrawalLimitRepository,
+		transactionManager:        transactionManager,
+		logger:                    logger,
+	}
+}
+
+// RemoveWithdrawalLimit removes the override of the withdrawal limits of an account,
+// so the account is limited by its product and the tier of its customer again
+func (r removeWithdrawalLimitUsecase) RemoveWithdrawalLimit(ctx context.Context, accountNumber string) error {
+	var (
+		applyLock = true
+		err       error
+		logger    = r.logger.WithDuration(
+			ctx,
+			"removeWithdrawalLimitUsecase.RemoveWithdrawalLimit",
+			map[string]interface{}{
+				"account_number": accountNumber,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	err = r.transactionManager.WithTransaction(ctx, func(ctx context.Context) error {
+		account, err := r.accountRepository.FindByAccountNumber(ctx, accountNumber, applyLock)
+		if err != nil {
+			return err
+		}
+
+		limit, err := r.withdrawalLimitRepository.FindAccountWithdrawalLimit(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+
+		return r.withdrawalLimitRepository.DeleteAccountWithdrawalLimit(ctx, limit)
+	})
+
+	return err
+}
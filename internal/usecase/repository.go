@@ -2,7 +2,10 @@ package usecase
 
 import (
 	"context"
+	"io"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"imansohibul.my.id/account-domain-service/entity"
 )
 
@@ -13,21 +16,127 @@ type TransactionManager interface {
 }
 
 type AccountRepository interface {
-	FindByAccountNumber(ctx context.Context, accountType entity.AccountType, accountNumber string, lock bool) (*entity.Account, error)
+	FindByAccountNumber(ctx context.Context, accountNumber string, lock bool) (*entity.Account, error)
+	FindByID(ctx context.Context, id uint, lock bool) (*entity.Account, error)
+	FindSystemAccount(ctx context.Context, accountNumber string) (*entity.Account, error)
 	CreateAccount(ctx context.Context, account *entity.Account) (*entity.Account, error)
 	UpdateAccount(ctx context.Context, account *entity.Account) (*entity.Account, error)
+	FindAccounts(ctx context.Context, afterID uint, limit int) ([]*entity.Account, error)
+	NextAccountNumberSequence(ctx context.Context) (int64, error)
+	FindByCustomerID(ctx context.Context, customerID uint) ([]*entity.Account, error)
 }
 
 type CustomerRepository interface {
 	CreateCustomer(ctx context.Context, customer *entity.Customer) (*entity.Customer, error)
 	FindByPhoneNumber(ctx context.Context, phoneNumber string) (*entity.Customer, error)
+	FindByID(ctx context.Context, id uint, lock bool) (*entity.Customer, error)
+	FindCustomers(ctx context.Context, filter *entity.CustomerFilter) ([]*entity.Customer, error)
+	FindCustomersAfter(ctx context.Context, afterID uint, limit int) ([]*entity.Customer, error)
+	FindCustomersWithSimilarPhoneNumbers(ctx context.Context) ([]*entity.Customer, error)
+	UpdateCustomer(ctx context.Context, customer *entity.Customer) (*entity.Customer, error)
+}
+
+type CustomerHistoryRepository interface {
+	CreateCustomerHistory(ctx context.Context, history *entity.CustomerHistory) (*entity.CustomerHistory, error)
 }
 
 type CustomerIdentityRepository interface {
 	CreateCustomerIdentity(ctx context.Context, customerIdentity *entity.CustomerIdentity) (*entity.CustomerIdentity, error)
 	FindByIdentity(ctx context.Context, identityType entity.CustomerIdentityType, identityNumber string) (*entity.CustomerIdentity, error)
+	FindByCustomerID(ctx context.Context, customerID uint) ([]*entity.CustomerIdentity, error)
 }
 
 type TransactionRepository interface {
 	CreateTransaction(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error)
+	UpdateTransaction(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error)
+	FindTransactionByID(ctx context.Context, id uint) (*entity.Transaction, error)
+	FindTransactions(ctx context.Context, filter *entity.TransactionFilter) ([]*entity.Transaction, error)
+	FindTransactionsByAccountID(ctx context.Context, accountID, afterID uint, limit int) ([]*entity.Transaction, error)
+	FindBalanceAt(ctx context.Context, accountID uint, at time.Time) (decimal.Decimal, error)
+	FindTransactionsBetween(ctx context.Context, accountID uint, start, end time.Time, afterID uint, limit int) ([]*entity.Transaction, error)
+	FindWithdrawalUsage(ctx context.Context, accountID uint, dayStart, monthStart time.Time) (*entity.WithdrawalUsage, error)
+}
+
+type IdempotencyKeyRepository interface {
+	CreateIdempotencyKey(ctx context.Context, idempotencyKey *entity.IdempotencyKey) (*entity.IdempotencyKey, error)
+	FindIdempotencyKey(ctx context.Context, scope entity.IdempotencyScope, key string) (*entity.IdempotencyKey, error)
+	UpdateIdempotencyKey(ctx context.Context, idempotencyKey *entity.IdempotencyKey) (*entity.IdempotencyKey, error)
+}
+
+type AccountStatusHistoryRepository interface {
+	CreateAccountStatusHistory(ctx context.Context, history *entity.AccountStatusHistory) (*entity.AccountStatusHistory, error)
+	FindLastAccountStatusHistory(ctx context.Context, accountID uint, toStatus entity.AccountStatus) (*entity.AccountStatusHistory, error)
+}
+
+type JournalRepository interface {
+	CreateJournal(ctx context.Context, journal *entity.Journal) (*entity.Journal, error)
+	FindTrialBalance(ctx context.Context) ([]*entity.TrialBalanceLine, error)
+}
+
+type OutboxRepository interface {
+	CreateOutboxEvent(ctx context.Context, event *entity.OutboxEvent) (*entity.OutboxEvent, error)
+	FindPendingOutboxEvents(ctx context.Context, now time.Time, limit int) ([]*entity.OutboxEvent, error)
+	UpdateOutboxEvent(ctx context.Context, event *entity.OutboxEvent) (*entity.OutboxEvent, error)
+}
+
+// EventPublisher publishes outbox events to the downstream services
+type EventPublisher interface {
+	Publish(ctx context.Context, event *entity.OutboxEvent) error
+}
+
+// StatementRenderer renders a statement as a document format e.g. CSV or PDF
+type StatementRenderer interface {
+	Format() entity.StatementFormat
+	Render(statement *entity.Statement, writer io.Writer) error
+}
+
+// StatementStore stores the statements generated by the month-end job e.g. in a local directory
+type StatementStore interface {
+	Save(ctx context.Context, document *entity.StatementDocument) error
+}
+
+type ExchangeRateRepository interface {
+	CreateExchangeRates(ctx context.Context, exchangeRates []*entity.ExchangeRate) error
+	FindExchangeRate(ctx context.Context, baseCurrency, quoteCurrency entity.Currency, at time.Time) (*entity.ExchangeRate, error)
+}
+
+type TimeDepositRepository interface {
+	CreateTimeDeposit(ctx context.Context, deposit *entity.TimeDeposit) (*entity.TimeDeposit, error)
+	FindTimeDepositByID(ctx context.Context, id uint, lock bool) (*entity.TimeDeposit, error)
+	FindTimeDepositRate(ctx context.Context, currency entity.Currency, termMonths int) (decimal.Decimal, error)
+	FindMaturedTimeDeposits(ctx context.Context, now time.Time, afterID uint, limit int) ([]*entity.TimeDeposit, error)
+	UpdateTimeDeposit(ctx context.Context, deposit *entity.TimeDeposit) (*entity.TimeDeposit, error)
+}
+
+type FeeRuleRepository interface {
+	FindFeeRules(ctx context.Context, accountType entity.AccountType, operation entity.FeeOperation, channel entity.Channel, currency entity.Currency) ([]*entity.FeeRule, error)
+}
+
+type WithdrawalLimitRepository interface {
+	FindWithdrawalLimit(ctx context.Context, accountType entity.AccountType, customerTier entity.CustomerTier, currency entity.Currency) (*entity.WithdrawalLimit, error)
+	FindAccountWithdrawalLimit(ctx context.Context, accountID uint) (*entity.WithdrawalLimit, error)
+	CreateAccountWithdrawalLimit(ctx context.Context, limit *entity.WithdrawalLimit) (*entity.WithdrawalLimit, error)
+	UpdateAccountWithdrawalLimit(ctx context.Context, limit *entity.WithdrawalLimit) (*entity.WithdrawalLimit, error)
+	DeleteAccountWithdrawalLimit(ctx context.Context, limit *entity.WithdrawalLimit) error
+}
+
+type TransactionReversalRepository interface {
+	CreateTransactionReversal(ctx context.Context, reversal *entity.TransactionReversal) (*entity.TransactionReversal, error)
+	FindTransactionReversal(ctx context.Context, transactionID uint) (*entity.TransactionReversal, error)
+}
+
+type HoldRepository interface {
+	CreateHold(ctx context.Context, hold *entity.Hold) (*entity.Hold, error)
+	FindHoldByID(ctx context.Context, id uint, lock bool) (*entity.Hold, error)
+	FindHoldByTransactionID(ctx context.Context, transactionID uint) (*entity.Hold, error)
+	FindExpiredHolds(ctx context.Context, now time.Time, afterID uint, limit int) ([]*entity.Hold, error)
+	UpdateHold(ctx context.Context, hold *entity.Hold) (*entity.Hold, error)
+}
+
+type InterestRepository interface {
+	FindInterestRateTiers(ctx context.Context) ([]*entity.InterestRateTier, error)
+	CreateInterestAccrual(ctx context.Context, accrual *entity.InterestAccrual) (*entity.InterestAccrual, error)
+	FindUnpostedInterestAccountIDs(ctx context.Context, before time.Time, afterID uint, limit int) ([]uint, error)
+	FindUnpostedInterestAccruals(ctx context.Context, accountID uint, before time.Time, lock bool) ([]*entity.InterestAccrual, error)
+	MarkInterestAccrualsPosted(ctx context.Context, accrualIDs []uint, transactionID uint) error
 }
@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+type reverseTransactionUsecase struct {
+	accountRepository             AccountRepository
+	transactionRepository         TransactionRepository
+	transactionReversalRepository TransactionReversalRepository
+	holdRepository                HoldRepository
+	transactionManager            TransactionManager
+	ledger                        ledger
+	outbox                        outbox
+	policy                        entity.ReversalPolicy
+	logger                        util.Logger
+}
+
+func NewReverseTransactionUsecase(
+	accountRepository AccountRepository,
+	transactionRepository TransactionRepository,
+	transactionReversalRepository TransactionReversalRepository,
+	holdRepository HoldRepository,
+	transactionManager TransactionManager,
+	journalRepository JournalRepository,
+	outboxRepository OutboxRepository,
+	policy entity.ReversalPolicy,
+	logger util.Logger,
+) *reverseTransactionUsecase {
+	return &reverseTransactionUsecase{
+		accountRepository:             accountRepository,
+		transactionRepository:         transactionRepository,
+		transactionReversalRepository: transactionReversalRepository,
+		holdRepository:                holdRepository,
+		transactionManager:            transactionManager,
+		ledger:                        newLedger(accountRepository, journalRepository),
+		outbox:                        newOutbox(outboxRepository),
+		policy:                        policy,
+		logger:                        logger,
+	}
+}
+
+// ReverseTransaction posts a transaction in the opposite direction of a transaction posted by mistake,
+// so the balance chain of the account stays intact. The other side is posted to the correction system account.
+// Only the deposits and the withdrawals made through a channel are reversed, a transaction is reversed once.
+// When reversing a credit would make the balance negative, the reversal is rejected with ErrInsufficientBalance
+// unless the operator forces it and the reversal policy allows it
+func (r reverseTransactionUsecase) ReverseTransaction(ctx context.Context, params *entity.ReverseTransactionParams) (*entity.Reversal, error) {
+	var (
+		err      error
+		reversal = new(entity.Reversal)
+		logger   = r.logger.WithDuration(
+			ctx,
+			"reverseTransactionUsecase.ReverseTransaction",
+			map[string]interface{}{
+				"transaction_id": params.TransactionID,
+				"operator":       params.Operator,
+				"force":          params.Force,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	err = r.transactionManager.WithTransaction(ctx, func(ctx context.Context) error {
+		original, err := r.transactionRepository.FindTransactionByID(ctx, params.TransactionID)
+		if err != nil {
+			return err
+		}
+
+		if !original.IsReversible() {
+			return entity.ErrTransactionNotReversible
+		}
+
+		// The debit of a captured hold is settled with the payment partner, it isn't a withdrawal
+		if original.Type.IsDebit() {
+			_, err := r.holdRepository.FindHoldByTransactionID(ctx, original.ID)
+			if err == nil {
+				return entity.ErrTransactionNotReversible
+			} else if !errors.Is(err, entity.ErrHoldNotFound) {
+				return err
+			}
+		}
+
+		// The account is locked before checking the reversal, so concurrent reversals of the transaction are serialized
+		account, err := r.accountRepository.FindByID(ctx, original.AccountID, true)
+		if err != nil {
+			return err
+		}
+
+		if account.AccountType == entity.AccountTypeTimeDeposit {
+			return entity.ErrTimeDepositLocked
+		}
+
+		if account.Status == entity.AccountStatusClosed {
+			return entity.ErrAccountClosed
+		}
+
+		_, err = r.transactionReversalRepository.FindTransactionReversal(ctx, original.ID)
+		if err == nil {
+			return entity.ErrTransactionAlreadyReversed
+		} else if !errors.Is(err, entity.ErrTransactionReversalNotFound) {
+			return err
+		}
+
+		reversalType := original.Type.ReversalType()
+		finalBalance := account.Balance.Add(original.Amount)
+		forced := false
+		if reversalType.IsDebit() {
+			finalBalance = account.Balance.Sub(original.Amount)
+			if account.AvailableBalance().LessThan(original.Amount) {
+				if !params.Force {
+					return entity.ErrInsufficientBalance
+				}
+
+				if !r.policy.AllowForce {
+					return entity.ErrReversalForceNotAllowed
+				}
+
+				forced = true
+			}
+		}
+
+		transaction, err := r.transactionRepository.CreateTransaction(ctx, &entity.Transaction{
+			AccountID:           account.ID,
+			Type:                reversalType,
+			Amount:              original.Amount,
+			InitialBalance:      account.Balance,
+			FinalBalance:        finalBalance,
+			Currency:            account.Currency,
+			LinkedTransactionID: original.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		account.Balance = finalBalance
+		if _, err := r.accountRepository.UpdateAccount(ctx, account); err != nil {
+			return err
+		}
+
+		correction, err := r.ledger.SystemAccount(ctx, entity.SystemAccountCorrection)
+		if err != nil {
+			return err
+		}
+
+		journal := entity.NewJournal("Koreksi transaksi")
+		if reversalType.IsDebit() {
+			journal = journal.
+				Debit(account.ID, transaction.ID, original.Amount, account.Currency).
+				Credit(correction.ID, 0, original.Amount, account.Currency)
+		} else {
+			journal = journal.
+				Debit(correction.ID, 0, original.Amount, account.Currency).
+				Credit(account.ID, transaction.ID, original.Amount, account.Currency)
+		}
+
+		if err := r.ledger.Post(ctx, journal); err != nil {
+			return err
+		}
+
+		record, err := r.transactionReversalRepository.CreateTransactionReversal(ctx, &entity.TransactionReversal{
+			TransactionID:         original.ID,
+			ReversalTransactionID: transaction.ID,
+			Operator:              params.Operator,
+			Reason:                params.Reason,
+			Forced:                forced,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := r.outbox.RecordBalanceChanged(ctx, account, transaction); err != nil {
+			return err
+		}
+
+		reversal.Original = original
+		reversal.Reversal = transaction
+		reversal.Record = record
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return reversal, nil
+}
@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	repositorymock "imansohibul.my.id/account-domain-service/internal/usecase/mock"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestReverseTransaction(t *testing.T) {
+	tests := []struct {
+		name            string
+		originalType    entity.TransactionType
+		originalAmount  decimal.Decimal
+		postedByService bool
+		linkedID        uint
+		capturedHold    bool
+		alreadyReversed bool
+		force           bool
+		allowForce      bool
+		expectedErr     error
+		expectedType    entity.TransactionType
+		expectedBalance string
+		expectedForced  bool
+	}{
+		{
+			name:            "Reverse Credit",
+			originalType:    entity.TransactionTypeCredit,
+			originalAmount:  decimal.NewFromInt(50000),
+			expectedType:    entity.TransactionTypeReversalDebit,
+			expectedBalance: "50000",
+		},
+		{
+			name:            "Reverse Debit",
+			originalType:    entity.TransactionTypeDebit,
+			originalAmount:  decimal.NewFromInt(50000),
+			expectedType:    entity.TransactionTypeReversalCredit,
+			expectedBalance: "150000",
+		},
+		{
+			name:            "Already Reversed",
+			originalType:    entity.TransactionTypeCredit,
+			originalAmount:  decimal.NewFromInt(50000),
+			alreadyReversed: true,
+			expectedErr:     entity.ErrTransactionAlreadyReversed,
+		},
+		{
+			name:           "Reversal Is Not Reversible",
+			originalType:   entity.TransactionTypeReversalDebit,
+			originalAmount: decimal.NewFromInt(50000),
+			expectedErr:    entity.ErrTransactionNotReversible,
+		},
+		{
+			name:           "Transfer Leg Is Not Reversible",
+			originalType:   entity.TransactionTypeDebit,
+			originalAmount: decimal.NewFromInt(50000),
+			linkedID:       8,
+			expectedErr:    entity.ErrTransactionNotReversible,
+		},
+		{
+			name:           "Fee Is Not Reversible",
+			originalType:   entity.TransactionTypeFee,
+			originalAmount: decimal.NewFromInt(2500),
+			linkedID:       6,
+			expectedErr:    entity.ErrTransactionNotReversible,
+		},
+		{
+			name:            "Posted By The Service Is Not Reversible",
+			originalType:    entity.TransactionTypeCredit,
+			originalAmount:  decimal.NewFromInt(50000),
+			postedByService: true,
+			expectedErr:     entity.ErrTransactionNotReversible,
+		},
+		{
+			name:           "Hold Capture Is Not Reversible",
+			originalType:   entity.TransactionTypeDebit,
+			originalAmount: decimal.NewFromInt(50000),
+			capturedHold:   true,
+			expectedErr:    entity.ErrTransactionNotReversible,
+		},
+		{
+			name:           "Negative Balance - Rejected",
+			originalType:   entity.TransactionTypeCredit,
+			originalAmount: decimal.NewFromInt(150000),
+			expectedErr:    entity.ErrInsufficientBalance,
+		},
+		{
+			name:           "Negative Balance - Force Not Allowed",
+			originalType:   entity.TransactionTypeCredit,
+			originalAmount: decimal.NewFromInt(150000),
+			force:          true,
+			expectedErr:    entity.ErrReversalForceNotAllowed,
+		},
+		{
+			name:            "Negative Balance - Forced",
+			originalType:    entity.TransactionTypeCredit,
+			originalAmount:  decimal.NewFromInt(150000),
+			force:           true,
+			allowForce:      true,
+			expectedType:    entity.TransactionTypeReversalDebit,
+			expectedBalance: "-50000",
+			expectedForced:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctrl                          = gomock.NewController(t)
+				accountRepository             = repositorymock.NewMockAccountRepository(ctrl)
+				transactionRepository         = repositorymock.NewMockTransactionRepository(ctrl)
+				transactionReversalRepository = repositorymock.NewMockTransactionReversalRepository(ctrl)
+				holdRepository                = repositorymock.NewMockHoldRepository(ctrl)
+				transactionManager            = repositorymock.NewMockTransactionManager(ctrl)
+				journalRepository             = repositorymock.NewMockJournalRepository(ctrl)
+				outboxRepository              = repositorymock.NewMockOutboxRepository(ctrl)
+
+				account    = &entity.Account{ID: 1, AccountNumber: "1111111111", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, Currency: entity.CurrencyIDR, Balance: decimal.NewFromInt(100000)}
+				correction = &entity.Account{ID: 9, AccountNumber: entity.SystemAccountCorrection, AccountType: entity.AccountTypeInternal}
+				original   = &entity.Transaction{ID: 7, AccountID: account.ID, Type: tt.originalType, Amount: tt.originalAmount, Currency: entity.CurrencyIDR, Channel: entity.ChannelTeller, LinkedTransactionID: tt.linkedID}
+				journal    *entity.Journal
+				record     *entity.TransactionReversal
+			)
+
+			if tt.postedByService {
+				original.Channel = entity.ChannelUnspecified
+			}
+
+			transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction)
+			if tt.capturedHold {
+				holdRepository.EXPECT().FindHoldByTransactionID(gomock.Any(), original.ID).
+					Return(&entity.Hold{ID: 5, Status: entity.HoldStatusCaptured, TransactionID: original.ID}, nil)
+			} else {
+				holdRepository.EXPECT().FindHoldByTransactionID(gomock.Any(), original.ID).Return(nil, entity.ErrHoldNotFound).AnyTimes()
+			}
+			transactionRepository.EXPECT().FindTransactionByID(gomock.Any(), original.ID).Return(original, nil)
+			accountRepository.EXPECT().FindByID(gomock.Any(), account.ID, true).Return(account, nil).AnyTimes()
+			if tt.alreadyReversed {
+				transactionReversalRepository.EXPECT().FindTransactionReversal(gomock.Any(), original.ID).
+					Return(&entity.TransactionReversal{ID: 3, TransactionID: original.ID}, nil)
+			} else {
+				transactionReversalRepository.EXPECT().FindTransactionReversal(gomock.Any(), original.ID).
+					Return(nil, entity.ErrTransactionReversalNotFound).AnyTimes()
+			}
+			transactionReversalRepository.EXPECT().CreateTransactionReversal(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, r *entity.TransactionReversal) (*entity.TransactionReversal, error) {
+					record = r
+					return r, nil
+				}).AnyTimes()
+			accountRepository.EXPECT().FindSystemAccount(gomock.Any(), entity.SystemAccountCorrection).Return(correction, nil).AnyTimes()
+			accountRepository.EXPECT().UpdateAccount(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, a *entity.Account) (*entity.Account, error) {
+					return a, nil
+				}).AnyTimes()
+			transactionRepository.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error) {
+					transaction.ID = 42
+					return transaction, nil
+				}).AnyTimes()
+			journalRepository.EXPECT().CreateJournal(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, j *entity.Journal) (*entity.Journal, error) {
+					journal = j
+					return j, nil
+				}).AnyTimes()
+			outboxRepository.EXPECT().CreateOutboxEvent(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, event *entity.OutboxEvent) (*entity.OutboxEvent, error) {
+					return event, nil
+				}).AnyTimes()
+
+			reverseTransactionUsecase := NewReverseTransactionUsecase(
+				accountRepository,
+				transactionRepository,
+				transactionReversalRepository,
+				holdRepository,
+				transactionManager,
+				journalRepository,
+				outboxRepository,
+				entity.ReversalPolicy{AllowForce: tt.allowForce},
+				util.GetZapLogger(),
+			)
+
+			reversal, err := reverseTransactionUsecase.ReverseTransaction(context.Background(), &entity.ReverseTransactionParams{
+				TransactionID: original.ID,
+				Operator:      "teller01",
+				Reason:        "Salah input",
+				Force:         tt.force,
+			})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, record)
+				assert.True(t, decimal.NewFromInt(100000).Equal(account.Balance))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedType, reversal.Reversal.Type)
+			assert.Equal(t, original.ID, reversal.Reversal.LinkedTransactionID)
+			assert.Equal(t, "100000", reversal.Reversal.InitialBalance.String())
+			assert.Equal(t, tt.expectedBalance, reversal.Reversal.FinalBalance.String())
+			assert.Equal(t, tt.expectedBalance, account.Balance.String())
+			assert.Equal(t, "teller01", record.Operator)
+			assert.Equal(t, reversal.Reversal.ID, record.ReversalTransactionID)
+			assert.Equal(t, tt.expectedForced, record.Forced)
+
+			// The other side of the reversal is posted to the correction account
+			assert.Len(t, journal.Entries, 2)
+			assert.Contains(t, []uint{journal.Entries[0].AccountID, journal.Entries[1].AccountID}, correction.ID)
+			assert.NoError(t, journal.Validate())
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+type searchCustomersUsecase struct {
+	customerRepository         CustomerRepository
+	customerIdentityRepository CustomerIdentityRepository
+	logger                     util.Logger
+}
+
+func NewSearchCustomersUsecase(
+	customerRepository CustomerRepository,
+	customerIdentityRepository CustomerIdentityRepository,
+	logger util.Logger,
+) *searchCustomersUsecase {
+	return &searchCustomersUsecase{
+		customerRepository:         customerRepository,
+		customerIdentityRepository: customerIdentityRepository,
+		logger:                     logger,
+	}
+}
+
+func (s searchCustomersUsecase) SearchCustomers(ctx context.Context, params *entity.SearchCustomersParams) ([]*entity.Customer, error) {
+	var (
+		err    error
+		logger = s.logger.WithDuration(
+			ctx,
+			"searchCustomersUsecase.SearchCustomers",
+			map[string]interface{}{
+				"name_prefix":     params.NamePrefix,
+				"phone_number":    params.PhoneNumber,
+				"identity_number": params.IdentityNumber,
+				"limit":           params.Limit,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	// Listing every customer is not allowed, at least one criterion must be given
+	if params.NamePrefix == "" && params.PhoneNumber == "" && params.IdentityNumber == "" {
+		err = entity.ErrInvalidRequest
+		return nil, err
+	}
+
+	filter := &entity.CustomerFilter{
+		NamePrefix: params.NamePrefix,
+		Limit:      params.Limit,
+	}
+
+	if params.PhoneNumber != "" {
+		filter.PhoneNumber, err = util.NormalizePhoneNumber(params.PhoneNumber)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = entity.DefaultCustomerSearchLimit
+	} else if filter.Limit > entity.MaxCustomerSearchLimit {
+		filter.Limit = entity.MaxCustomerSearchLimit
+	}
+
+	if params.IdentityNumber != "" {
+		var customerIdentity *entity.CustomerIdentity
+		customerIdentity, err = s.customerIdentityRepository.FindByIdentity(ctx, entity.IdentityTypeNIK, params.IdentityNumber)
+		if err == entity.ErrCustomerIdentityNotFound {
+			err = nil
+			return []*entity.Customer{}, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		filter.ID = customerIdentity.CustomerID
+	}
+
+	customers, err := s.customerRepository.FindCustomers(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return customers, nil
+}
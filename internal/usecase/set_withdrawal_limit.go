@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+type setWithdrawalLimitUsecase struct {
+	accountRepository         AccountRepository
+	withdrawalLimitRepository WithdrawalLimitRepository
+	transactionManager        TransactionManager
+	logger                    util.Logger
+}
+
+func NewSetWithdrawalLimitUsecase(
+	accountRepository AccountRepository,
+	withdrawalLimitRepository WithdrawalLimitRepository,
+	transactionManager TransactionManager,
+	logger util.Logger,
+) *setWithdrawalLimitUsecase {
+	return &setWithdrawalLimitUsecase{
+		accountRepository:         accountRepository,
+		withdrawalLimitRepository: withdrawalLimitRepository,
+		transactionManager:        transactionManager,
+		logger:                    logger,
+	}
+}
+
+// SetWithdrawalLimit overrides the withdrawal limits of the product of an account with the limits of the request,
+// the limits of an account that is already overridden are replaced
+func (s setWithdrawalLimitUsecase) SetWithdrawalLimit(ctx context.Context, params *entity.SetWithdrawalLimitParams) (*entity.WithdrawalLimit, error) {
+	var (
+		applyLock = true
+		err       error
+		logger    = s.logger.WithDuration(
+			ctx,
+			"setWithdrawalLimitUsecase.SetWithdrawalLimit",
+			map[string]interface{}{
+				"account_number":      params.AccountNumber,
+				"max_per_transaction": params.MaxPerTransaction,
+				"max_daily":           params.MaxDaily,
+				"max_monthly":         params.MaxMonthly,
+				"max_daily_count":     params.MaxDailyCount,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	limit := new(entity.WithdrawalLimit)
+
+	err = s.transactionManager.WithTransaction(ctx, func(ctx context.Context) error {
+		// Lock the account so the limits can't change while a withdrawal is checked against them
+		account, err := s.accountRepository.FindByAccountNumber(ctx, params.AccountNumber, applyLock)
+		if err != nil {
+			return err
+		}
+
+		limit, err = s.withdrawalLimitRepository.FindAccountWithdrawalLimit(ctx, account.ID)
+		if err != nil && !errors.Is(err, entity.ErrWithdrawalLimitNotFound) {
+			return err
+		}
+
+		exists := err == nil
+		if !exists {
+			limit = &entity.WithdrawalLimit{AccountID: account.ID}
+		}
+
+		limit.MaxPerTransaction = params.MaxPerTransaction
+		limit.MaxDaily = params.MaxDaily
+		limit.MaxMonthly = params.MaxMonthly
+		limit.MaxDailyCount = params.MaxDailyCount
+
+		if exists {
+			limit, err = s.withdrawalLimitRepository.UpdateAccountWithdrawalLimit(ctx, limit)
+		} else {
+			limit, err = s.withdrawalLimitRepository.CreateAccountWithdrawalLimit(ctx, limit)
+		}
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return limit, nil
+}
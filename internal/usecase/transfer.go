@@ -0,0 +1,265 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+type transferUsecase struct {
+	accountRepository     AccountRepository
+	transactionRepository TransactionRepository
+	transactionManager    TransactionManager
+	idempotencyGuard      idempotencyGuard
+	ledger                ledger
+	exchange              exchange
+	outbox                outbox
+	feeSchedule           feeSchedule
+	logger                util.Logger
+}
+
+func NewTransferUsecase(
+	accountRepository AccountRepository,
+	transactionRepository TransactionRepository,
+	transactionManager TransactionManager,
+	idempotencyKeyRepository IdempotencyKeyRepository,
+	journalRepository JournalRepository,
+	outboxRepository OutboxRepository,
+	exchangeRateRepository ExchangeRateRepository,
+	feeRuleRepository FeeRuleRepository,
+	logger util.Logger,
+) *transferUsecase {
+	ledger := newLedger(accountRepository, journalRepository)
+
+	return &transferUsecase{
+		accountRepository:     accountRepository,
+		transactionRepository: transactionRepository,
+		transactionManager:    transactionManager,
+		idempotencyGuard:      newIdempotencyGuard(idempotencyKeyRepository, transactionManager),
+		ledger:                ledger,
+		exchange:              newExchange(exchangeRateRepository),
+		outbox:                newOutbox(outboxRepository),
+		feeSchedule:           newFeeSchedule(feeRuleRepository, transactionRepository, ledger),
+		logger:                logger,
+	}
+}
+
+func (t transferUsecase) Transfer(ctx context.Context, params *entity.TransferParams) (*entity.Transfer, error) {
+	var (
+		err    error
+		logger = t.logger.WithDuration(
+			ctx,
+			"transferUsecase.Transfer",
+			map[string]interface{}{
+				"source_account_number":      params.SourceAccountNumber,
+				"destination_account_number": params.DestinationAccountNumber,
+				"amount":                     params.Amount,
+				"currency":                   params.Currency,
+				"channel":                    params.Channel,
+				"idempotency_key":            params.IdempotencyKey,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	if params.SourceAccountNumber == params.DestinationAccountNumber {
+		err = entity.ErrTransferToSameAccount
+		return nil, err
+	}
+
+	var (
+		transfer    = new(entity.Transfer)
+		requestHash = hashRequest(
+			params.SourceAccountNumber,
+			params.DestinationAccountNumber,
+			params.Amount.String(),
+			string(params.Currency),
+			strconv.Itoa(int(params.Channel)),
+		)
+	)
+
+	err = t.idempotencyGuard.Run(ctx, entity.IdempotencyScopeTransfer, params.IdempotencyKey, requestHash, transfer, func(ctx context.Context) error {
+		source, destination, err := t.lockAccounts(ctx, params.SourceAccountNumber, params.DestinationAccountNumber)
+		if err != nil {
+			return err
+		}
+
+		if err := source.ValidateDebit(); err != nil {
+			return err
+		}
+
+		if err := destination.ValidateCredit(); err != nil {
+			return err
+		}
+
+		// The amount is debited in the currency of the source account
+		if err := source.ValidateAmount(params.Amount, params.Currency); err != nil {
+			return err
+		}
+
+		if err := source.Currency.ValidateTransferAmount(params.Amount); err != nil {
+			return err
+		}
+
+		// The destination is credited in its own currency, converted with the rate effective now
+		var (
+			creditAmount = params.Amount
+			conversion   *entity.CurrencyConversion
+		)
+
+		if destination.Currency != source.Currency {
+			conversion, err = t.exchange.Convert(ctx, params.Amount, source.Currency, destination.Currency)
+			if err != nil {
+				return err
+			}
+
+			creditAmount = conversion.TargetAmount
+		}
+
+		// The fee is charged to the source account in its currency
+		fee, err := t.feeSchedule.Fee(ctx, source, entity.FeeOperationTransfer, params.Channel, params.Amount)
+		if err != nil {
+			return err
+		}
+
+		// The funds reserved by the active holds of the source can't be transferred
+		if source.AvailableBalance().LessThan(params.Amount.Add(fee)) {
+			return entity.ErrInsufficientBalance
+		}
+
+		debit, err := t.transactionRepository.CreateTransaction(ctx, &entity.Transaction{
+			AccountID:      source.ID,
+			Type:           entity.TransactionTypeDebit,
+			Amount:         params.Amount,
+			InitialBalance: source.Balance,
+			FinalBalance:   source.Balance.Sub(params.Amount),
+			Currency:       source.Currency,
+			Conversion:     conversion,
+			Channel:        params.Channel,
+		})
+		if err != nil {
+			return err
+		}
+
+		credit, err := t.transactionRepository.CreateTransaction(ctx, &entity.Transaction{
+			AccountID:           destination.ID,
+			Type:                entity.TransactionTypeCredit,
+			Amount:              creditAmount,
+			InitialBalance:      destination.Balance,
+			FinalBalance:        destination.Balance.Add(creditAmount),
+			Currency:            destination.Currency,
+			LinkedTransactionID: debit.ID,
+			Conversion:          conversion,
+			Channel:             params.Channel,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Link the debit back to its credit so both legs can be traced from either side
+		debit.LinkedTransactionID = credit.ID
+		debit, err = t.transactionRepository.UpdateTransaction(ctx, debit)
+		if err != nil {
+			return err
+		}
+
+		journal, err := t.transferJournal(ctx, source, destination, debit, credit)
+		if err != nil {
+			return err
+		}
+
+		source.Balance = source.Balance.Sub(params.Amount)
+		feeTransaction, err := t.feeSchedule.Charge(ctx, source, fee, debit, journal)
+		if err != nil {
+			return err
+		}
+
+		if _, err := t.accountRepository.UpdateAccount(ctx, source); err != nil {
+			return err
+		}
+
+		destination.Balance = destination.Balance.Add(creditAmount)
+		if _, err := t.accountRepository.UpdateAccount(ctx, destination); err != nil {
+			return err
+		}
+
+		if err := t.ledger.Post(ctx, journal); err != nil {
+			return err
+		}
+
+		if err := t.outbox.RecordBalanceChanged(ctx, source, debit); err != nil {
+			return err
+		}
+
+		if err := t.outbox.RecordBalanceChanged(ctx, destination, credit); err != nil {
+			return err
+		}
+
+		if feeTransaction != nil {
+			if err := t.outbox.RecordBalanceChanged(ctx, source, feeTransaction); err != nil {
+				return err
+			}
+		}
+
+		transfer.Debit = debit
+		transfer.Credit = credit
+		transfer.Fee = feeTransaction
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+// transferJournal builds the journal of the transfer
+// A cross-currency transfer goes through the FX position system account, so every currency stays balanced
+func (t transferUsecase) transferJournal(ctx context.Context, source, destination *entity.Account, debit, credit *entity.Transaction) (*entity.Journal, error) {
+	if debit.Conversion == nil {
+		return entity.NewJournal("Transfer antar rekening").
+			Debit(source.ID, debit.ID, debit.Amount, debit.Currency).
+			Credit(destination.ID, credit.ID, credit.Amount, credit.Currency), nil
+	}
+
+	fxPosition, err := t.ledger.SystemAccount(ctx, entity.SystemAccountFXPosition)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.NewJournal("Transfer antar rekening valas").
+		Debit(source.ID, debit.ID, debit.Amount, debit.Currency).
+		Credit(fxPosition.ID, 0, debit.Amount, debit.Currency).
+		Debit(fxPosition.ID, 0, credit.Amount, credit.Currency).
+		Credit(destination.ID, credit.ID, credit.Amount, credit.Currency), nil
+}
+
+// lockAccounts finds and locks both accounts for update.
+// The rows are always locked in ascending account number order, so two opposite
+// transfers between the same accounts can never wait on each other (deadlock).
+func (t transferUsecase) lockAccounts(ctx context.Context, sourceAccountNumber, destinationAccountNumber string) (*entity.Account, *entity.Account, error) {
+	var (
+		applyLock      = true
+		lockOrder      = []string{sourceAccountNumber, destinationAccountNumber}
+		lockedAccounts = make(map[string]*entity.Account, len(lockOrder))
+	)
+
+	if destinationAccountNumber < sourceAccountNumber {
+		lockOrder[0], lockOrder[1] = lockOrder[1], lockOrder[0]
+	}
+
+	for _, accountNumber := range lockOrder {
+		account, err := t.accountRepository.FindByAccountNumber(ctx, accountNumber, applyLock)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		lockedAccounts[accountNumber] = account
+	}
+
+	return lockedAccounts[sourceAccountNumber], lockedAccounts[destinationAccountNumber], nil
+}
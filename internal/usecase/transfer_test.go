@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	repositorymock "imansohibul.my.id/account-domain-service/internal/usecase/mock"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestTransferWithConversion(t *testing.T) {
+	var (
+		ctrl                   = gomock.NewController(t)
+		accountRepository      = repositorymock.NewMockAccountRepository(ctrl)
+		transactionRepository  = repositorymock.NewMockTransactionRepository(ctrl)
+		transactionManager     = repositorymock.NewMockTransactionManager(ctrl)
+		journalRepository      = repositorymock.NewMockJournalRepository(ctrl)
+		outboxRepository       = repositorymock.NewMockOutboxRepository(ctrl)
+		exchangeRateRepository = repositorymock.NewMockExchangeRateRepository(ctrl)
+		feeRuleRepository      = repositorymock.NewMockFeeRuleRepository(ctrl)
+
+		source      = &entity.Account{ID: 1, AccountNumber: "1111111111", Status: entity.AccountStatusActive, Currency: entity.CurrencyIDR, Balance: decimal.NewFromInt(5000000)}
+		destination = &entity.Account{ID: 2, AccountNumber: "2222222222", Status: entity.AccountStatusActive, Currency: entity.CurrencyUSD, Balance: decimal.NewFromInt(10)}
+		fxPosition  = &entity.Account{ID: 4, AccountNumber: entity.SystemAccountFXPosition}
+		rate        = &entity.ExchangeRate{ID: 7, BaseCurrency: entity.CurrencyUSD, QuoteCurrency: entity.CurrencyIDR, BuyRate: decimal.NewFromInt(16000), SellRate: decimal.NewFromInt(16500)}
+		journal     *entity.Journal
+	)
+
+	transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction)
+	accountRepository.EXPECT().FindByAccountNumber(gomock.Any(), source.AccountNumber, true).Return(source, nil)
+	accountRepository.EXPECT().FindByAccountNumber(gomock.Any(), destination.AccountNumber, true).Return(destination, nil)
+	accountRepository.EXPECT().FindSystemAccount(gomock.Any(), entity.SystemAccountFXPosition).Return(fxPosition, nil)
+
+	// The rate is quoted as USD/IDR, so the IDR/USD lookup falls back to the inverse pair
+	exchangeRateRepository.EXPECT().FindExchangeRate(gomock.Any(), entity.CurrencyIDR, entity.CurrencyUSD, gomock.Any()).Return(nil, entity.ErrExchangeRateNotFound)
+	exchangeRateRepository.EXPECT().FindExchangeRate(gomock.Any(), entity.CurrencyUSD, entity.CurrencyIDR, gomock.Any()).Return(rate, nil)
+
+	// Transfers through the teller are free
+	feeRuleRepository.EXPECT().FindFeeRules(gomock.Any(), source.AccountType, entity.FeeOperationTransfer, entity.ChannelTeller, entity.CurrencyIDR).Return(nil, nil)
+
+	var transactionID uint
+	transactionRepository.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error) {
+			transactionID++
+			transaction.ID = transactionID
+			return transaction, nil
+		}).Times(2)
+	transactionRepository.EXPECT().UpdateTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error) {
+			return transaction, nil
+		})
+	accountRepository.EXPECT().UpdateAccount(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, account *entity.Account) (*entity.Account, error) {
+			return account, nil
+		}).Times(2)
+	journalRepository.EXPECT().CreateJournal(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, j *entity.Journal) (*entity.Journal, error) {
+			journal = j
+			return j, nil
+		})
+	outboxRepository.EXPECT().CreateOutboxEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, event *entity.OutboxEvent) (*entity.OutboxEvent, error) {
+			return event, nil
+		}).Times(2)
+
+	transferUsecase := NewTransferUsecase(
+		accountRepository,
+		transactionRepository,
+		transactionManager,
+		repositorymock.NewMockIdempotencyKeyRepository(ctrl),
+		journalRepository,
+		outboxRepository,
+		exchangeRateRepository,
+		feeRuleRepository,
+		util.GetZapLogger(),
+	)
+
+	transfer, err := transferUsecase.Transfer(context.Background(), &entity.TransferParams{
+		SourceAccountNumber:      source.AccountNumber,
+		DestinationAccountNumber: destination.AccountNumber,
+		Amount:                   decimal.NewFromInt(1000000),
+		Currency:                 entity.CurrencyIDR,
+	})
+
+	assert.NoError(t, err)
+
+	// IDR is converted to USD at the sell rate and rounded down to cents: 1.000.000 / 16.500 = 60,60
+	assert.True(t, decimal.NewFromInt(1000000).Equal(transfer.Debit.Amount))
+	assert.Equal(t, entity.CurrencyIDR, transfer.Debit.Currency)
+	assert.True(t, decimal.RequireFromString("60.60").Equal(transfer.Credit.Amount))
+	assert.Equal(t, entity.CurrencyUSD, transfer.Credit.Currency)
+	assert.True(t, decimal.RequireFromString("70.60").Equal(destination.Balance))
+	assert.True(t, decimal.NewFromInt(4000000).Equal(source.Balance))
+	assert.Nil(t, transfer.Fee)
+
+	assert.Equal(t, transfer.Debit.Conversion, transfer.Credit.Conversion)
+	assert.Equal(t, uint(7), transfer.Credit.Conversion.ExchangeRateID)
+	assert.True(t, decimal.RequireFromString("0.0000606061").Equal(transfer.Credit.Conversion.Rate))
+
+	// Both currencies are balanced through the FX position account
+	assert.Len(t, journal.Entries, 4)
+	assert.Equal(t, fxPosition.ID, journal.Entries[1].AccountID)
+	assert.Equal(t, fxPosition.ID, journal.Entries[2].AccountID)
+	assert.NoError(t, journal.Validate())
+}
+
+func TestTransferAmountExceedsMaximum(t *testing.T) {
+	testCases := []struct {
+		name     string
+		currency entity.Currency
+		amount   decimal.Decimal
+	}{
+		{
+			name:     "IDR Maximum",
+			currency: entity.CurrencyIDR,
+			amount:   decimal.NewFromInt(100000000),
+		},
+		{
+			name:     "USD Maximum",
+			currency: entity.CurrencyUSD,
+			amount:   decimal.NewFromInt(6500),
+		},
+		{
+			name:     "USD Above Maximum With Cents",
+			currency: entity.CurrencyUSD,
+			amount:   decimal.RequireFromString("6500.01"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				ctrl               = gomock.NewController(t)
+				accountRepository  = repositorymock.NewMockAccountRepository(ctrl)
+				transactionManager = repositorymock.NewMockTransactionManager(ctrl)
+
+				source      = &entity.Account{ID: 1, AccountNumber: "1111111111", Status: entity.AccountStatusActive, Currency: tc.currency, Balance: decimal.NewFromInt(1000000000)}
+				destination = &entity.Account{ID: 2, AccountNumber: "2222222222", Status: entity.AccountStatusActive, Currency: tc.currency}
+			)
+
+			transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction)
+			accountRepository.EXPECT().FindByAccountNumber(gomock.Any(), source.AccountNumber, true).Return(source, nil)
+			accountRepository.EXPECT().FindByAccountNumber(gomock.Any(), destination.AccountNumber, true).Return(destination, nil)
+
+			transferUsecase := NewTransferUsecase(
+				accountRepository,
+				repositorymock.NewMockTransactionRepository(ctrl),
+				transactionManager,
+				repositorymock.NewMockIdempotencyKeyRepository(ctrl),
+				repositorymock.NewMockJournalRepository(ctrl),
+				repositorymock.NewMockOutboxRepository(ctrl),
+				repositorymock.NewMockExchangeRateRepository(ctrl),
+				repositorymock.NewMockFeeRuleRepository(ctrl),
+				util.GetZapLogger(),
+			)
+
+			_, err := transferUsecase.Transfer(context.Background(), &entity.TransferParams{
+				SourceAccountNumber:      source.AccountNumber,
+				DestinationAccountNumber: destination.AccountNumber,
+				Amount:                   tc.amount,
+				Currency:                 tc.currency,
+			})
+
+			assert.ErrorIs(t, err, entity.ErrAmountExceedsMaximum)
+			assert.True(t, decimal.NewFromInt(1000000000).Equal(source.Balance))
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+type updateAccountStatusUsecase struct {
+	accountRepository              AccountRepository
+	accountStatusHistoryRepository AccountStatusHistoryRepository
+	transactionManager             TransactionManager
+	logger                         util.Logger
+}
+
+func NewUpdateAccountStatusUsecase(
+	accountRepository AccountRepository,
+	accountStatusHistoryRepository AccountStatusHistoryRepository,
+	transactionManager TransactionManager,
+	logger util.Logger,
+) *updateAccountStatusUsecase {
+	return &updateAccountStatusUsecase{
+		accountRepository:              accountRepository,
+		accountStatusHistoryRepository: accountStatusHistoryRepository,
+		transactionManager:             transactionManager,
+		logger:                         logger,
+	}
+}
+
+func (u updateAccountStatusUsecase) UpdateAccountStatus(ctx context.Context, params *entity.UpdateAccountStatusParams) (*entity.Account, error) {
+	var (
+		applyLock = true
+		err       error
+		logger    = u.logger.WithDuration(
+			ctx,
+			"updateAccountStatusUsecase.UpdateAccountStatus",
+			map[string]interface{}{
+				"account_number": params.AccountNumber,
+				"status":         params.Status,
+				"reason":         params.Reason,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	account := new(entity.Account)
+
+	err = u.transactionManager.WithTransaction(ctx, func(ctx context.Context) error {
+		// Lock the account so the status can't change while money is moving
+		account, err = u.accountRepository.FindByAccountNumber(ctx, params.AccountNumber, applyLock)
+		if err != nil {
+			return err
+		}
+
+		if !account.Status.CanTransitionTo(params.Status) {
+			return entity.ErrInvalidAccountStatusTransition
+		}
+
+		// An account can only be closed once the remaining balance has been paid out
+		if params.Status == entity.AccountStatusClosed && !account.Balance.IsZero() {
+			return entity.ErrAccountBalanceNotZero
+		}
+
+		history := &entity.AccountStatusHistory{
+			AccountID:  account.ID,
+			FromStatus: account.Status,
+			ToStatus:   params.Status,
+			Reason:     params.Reason,
+		}
+
+		account.Status = params.Status
+		account, err = u.accountRepository.UpdateAccount(ctx, account)
+		if err != nil {
+			return err
+		}
+
+		_, err = u.accountStatusHistoryRepository.CreateAccountStatusHistory(ctx, history)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
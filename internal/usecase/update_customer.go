@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+
+	"imansohibul.my.id/account-domain-service/entity"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+type updateCustomerUsecase struct {
+	customerRepository        CustomerRepository
+	customerHistoryRepository CustomerHistoryRepository
+	transactionManager        TransactionManager
+	logger                    util.Logger
+}
+
+func NewUpdateCustomerUsecase(
+	customerRepository CustomerRepository,
+	customerHistoryRepository CustomerHistoryRepository,
+	transactionManager TransactionManager,
+	logger util.Logger,
+) *updateCustomerUsecase {
+	return &updateCustomerUsecase{
+		customerRepository:        customerRepository,
+		customerHistoryRepository: customerHistoryRepository,
+		transactionManager:        transactionManager,
+		logger:                    logger,
+	}
+}
+
+func (u updateCustomerUsecase) UpdateCustomer(ctx context.Context, params *entity.UpdateCustomerParams) (*entity.Customer, error) {
+	var (
+		applyLock = true
+		err       error
+		logger    = u.logger.WithDuration(
+			ctx,
+			"updateCustomerUsecase.UpdateCustomer",
+			map[string]interface{}{
+				"customer_id":  params.CustomerID,
+				"fullname":     params.Fullname,
+				"phone_number": params.PhoneNumber,
+			},
+		)
+	)
+
+	defer logger(&err)
+
+	phoneNumber := params.PhoneNumber
+	if phoneNumber != "" {
+		phoneNumber, err = util.NormalizePhoneNumber(phoneNumber)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	customer := new(entity.Customer)
+
+	err = u.transactionManager.WithTransaction(ctx, func(ctx context.Context) error {
+		// Lock the customer so concurrent changes are recorded one after another
+		customer, err = u.customerRepository.FindByID(ctx, params.CustomerID, applyLock)
+		if err != nil {
+			return err
+		}
+
+		var histories []*entity.CustomerHistory
+
+		if params.Fullname != "" && params.Fullname != customer.Fullname {
+			histories = append(histories, &entity.CustomerHistory{
+				CustomerID: customer.ID,
+				Field:      entity.CustomerFieldFullname,
+				OldValue:   customer.Fullname,
+				NewValue:   params.Fullname,
+			})
+
+			customer.Fullname = params.Fullname
+		}
+
+		if phoneNumber != "" && phoneNumber != customer.PhoneNumber {
+			if err := u.validatePhoneNumber(ctx, phoneNumber); err != nil {
+				return err
+			}
+
+			histories = append(histories, &entity.CustomerHistory{
+				CustomerID: customer.ID,
+				Field:      entity.CustomerFieldPhoneNumber,
+				OldValue:   customer.PhoneNumber,
+				NewValue:   phoneNumber,
+			})
+
+			customer.PhoneNumber = phoneNumber
+		}
+
+		// Nothing to record when the profile is unchanged
+		if len(histories) == 0 {
+			return nil
+		}
+
+		customer, err = u.customerRepository.UpdateCustomer(ctx, customer)
+		if err != nil {
+			return err
+		}
+
+		for _, history := range histories {
+			if _, err := u.customerHistoryRepository.CreateCustomerHistory(ctx, history); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return customer, nil
+}
+
+// validatePhoneNumber checks if the phone number is already used by another customer
+// The unique constraint rejects the update as well when two customers take the same number concurrently
+func (u updateCustomerUsecase) validatePhoneNumber(ctx context.Context, phoneNumber string) error {
+	customer, err := u.customerRepository.FindByPhoneNumber(ctx, phoneNumber)
+	if err != nil && err != entity.ErrCustomerNotFound {
+		return err
+	}
+
+	if customer != nil {
+		return entity.ErrPhoneNumberAlreadyExists
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	repositorymock "imansohibul.my.id/account-domain-service/internal/usecase/mock"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestUpdateCustomer(t *testing.T) {
+	tests := []struct {
+		name              string
+		params            *entity.UpdateCustomerParams
+		mockSetup         func(*repositorymock.MockCustomerRepository, *repositorymock.MockCustomerHistoryRepository)
+		expectedCustomer  *entity.Customer
+		expectedHistories []*entity.CustomerHistory
+		expectedErr       error
+	}{
+		{
+			name:   "Both Fields Changed - Records Two Histories",
+			params: &entity.UpdateCustomerParams{CustomerID: 7, Fullname: "Budi Santoso", PhoneNumber: "+6281234567891"},
+			mockSetup: func(customerRepository *repositorymock.MockCustomerRepository, historyRepository *repositorymock.MockCustomerHistoryRepository) {
+				customerRepository.EXPECT().FindByPhoneNumber(gomock.Any(), "+6281234567891").Return(nil, entity.ErrCustomerNotFound)
+				customerRepository.EXPECT().UpdateCustomer(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, customer *entity.Customer) (*entity.Customer, error) {
+						return customer, nil
+					})
+			},
+			expectedCustomer: &entity.Customer{ID: 7, Fullname: "Budi Santoso", PhoneNumber: "+6281234567891"},
+			expectedHistories: []*entity.CustomerHistory{
+				{CustomerID: 7, Field: entity.CustomerFieldFullname, OldValue: "Budi", NewValue: "Budi Santoso"},
+				{CustomerID: 7, Field: entity.CustomerFieldPhoneNumber, OldValue: "+6281234567890", NewValue: "+6281234567891"},
+			},
+		},
+		{
+			name:   "Unchanged - Nothing Recorded",
+			params: &entity.UpdateCustomerParams{CustomerID: 7, Fullname: "Budi", PhoneNumber: "+6281234567890"},
+			mockSetup: func(customerRepository *repositorymock.MockCustomerRepository, historyRepository *repositorymock.MockCustomerHistoryRepository) {
+			},
+			expectedCustomer:  &entity.Customer{ID: 7, Fullname: "Budi", PhoneNumber: "+6281234567890"},
+			expectedHistories: nil,
+		},
+		{
+			name:   "Phone Number Of Another Customer - Rejected",
+			params: &entity.UpdateCustomerParams{CustomerID: 7, PhoneNumber: "+6281234567899"},
+			mockSetup: func(customerRepository *repositorymock.MockCustomerRepository, historyRepository *repositorymock.MockCustomerHistoryRepository) {
+				customerRepository.EXPECT().FindByPhoneNumber(gomock.Any(), "+6281234567899").Return(&entity.Customer{ID: 8}, nil)
+			},
+			expectedErr: entity.ErrPhoneNumberAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctrl                      = gomock.NewController(t)
+				customerRepository        = repositorymock.NewMockCustomerRepository(ctrl)
+				customerHistoryRepository = repositorymock.NewMockCustomerHistoryRepository(ctrl)
+				transactionManager        = repositorymock.NewMockTransactionManager(ctrl)
+				histories                 []*entity.CustomerHistory
+			)
+
+			transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction)
+			customerRepository.EXPECT().FindByID(gomock.Any(), uint(7), true).
+				Return(&entity.Customer{ID: 7, Fullname: "Budi", PhoneNumber: "+6281234567890"}, nil)
+			customerHistoryRepository.EXPECT().CreateCustomerHistory(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, history *entity.CustomerHistory) (*entity.CustomerHistory, error) {
+					histories = append(histories, history)
+					return history, nil
+				}).AnyTimes()
+			tt.mockSetup(customerRepository, customerHistoryRepository)
+
+			updateCustomerUsecase := NewUpdateCustomerUsecase(customerRepository, customerHistoryRepository, transactionManager, util.GetZapLogger())
+			customer, err := updateCustomerUsecase.UpdateCustomer(context.Background(), tt.params)
+
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedCustomer, customer)
+			assert.Equal(t, tt.expectedHistories, histories)
+		})
+	}
+}
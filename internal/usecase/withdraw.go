@@ -2,8 +2,9 @@ package usecase
 
 import (
 	"context"
+	"strconv"
+	"time"
 
-	"github.com/shopspring/decimal"
 	"imansohibul.my.id/account-domain-service/entity"
 	"imansohibul.my.id/account-domain-service/util"
 )
@@ -12,6 +13,11 @@ type withdrawUsecase struct {
 	accountRepository     AccountRepository
 	transactionRepository TransactionRepository
 	transactionManager    TransactionManager
+	idempotencyGuard      idempotencyGuard
+	ledger                ledger
+	outbox                outbox
+	feeSchedule           feeSchedule
+	withdrawalLimiter     withdrawalLimiter
 	logger                util.Logger
 }
 
@@ -19,17 +25,36 @@ func NewWithdrawUsecase(
 	accountRepository AccountRepository,
 	transactionRepository TransactionRepository,
 	transactionManager TransactionManager,
+	idempotencyKeyRepository IdempotencyKeyRepository,
+	journalRepository JournalRepository,
+	outboxRepository OutboxRepository,
+	feeRuleRepository FeeRuleRepository,
+	withdrawalLimitRepository WithdrawalLimitRepository,
+	customerRepository CustomerRepository,
+	location *time.Location,
 	logger util.Logger,
 ) *withdrawUsecase {
+	ledger := newLedger(accountRepository, journalRepository)
+
 	return &withdrawUsecase{
 		accountRepository:     accountRepository,
 		transactionRepository: transactionRepository,
 		transactionManager:    transactionManager,
+		idempotencyGuard:      newIdempotencyGuard(idempotencyKeyRepository, transactionManager),
+		ledger:                ledger,
+		outbox:                newOutbox(outboxRepository),
+		feeSchedule:           newFeeSchedule(feeRuleRepository, transactionRepository, ledger),
+		withdrawalLimiter:     newWithdrawalLimiter(withdrawalLimitRepository, customerRepository, transactionRepository, location),
 		logger:                logger,
 	}
 }
 
-func (w withdrawUsecase) Withdraw(ctx context.Context, accountNumber string, amount decimal.Decimal) (*entity.Transaction, error) {
+// Withdraw withdraws money from an account
+// The fee of the withdrawal is charged in the same database transaction as a separate fee transaction,
+// the available balance (net of the active holds) must cover both the amount and the fee.
+// The amount must stay within the withdrawal limits of the account, the fee doesn't count towards the limits.
+// The reference of the withdrawal must be unique per channel, a repeated reference returns ErrTransactionReferenceAlreadyExists
+func (w withdrawUsecase) Withdraw(ctx context.Context, params *entity.WithdrawParams) (*entity.Withdrawal, error) {
 	var (
 		applyLock = true
 		err       error
@@ -37,45 +62,110 @@ func (w withdrawUsecase) Withdraw(ctx context.Context, accountNumber string, amo
 			ctx,
 			"withdrawUsecase.Withdraw",
 			map[string]interface{}{
-				"account_number": accountNumber,
-				"amount":         amount,
+				"account_number":  params.AccountNumber,
+				"amount":          params.Amount,
+				"currency":        params.Currency,
+				"channel":         params.Channel,
+				"reference":       params.Reference,
+				"idempotency_key": params.IdempotencyKey,
 			},
 		)
 	)
 
 	defer logger(&err)
 
-	transaction := new(entity.Transaction)
+	var (
+		amount      = params.Amount
+		withdrawal  = new(entity.Withdrawal)
+		requestHash = hashRequest(params.AccountNumber, params.Amount.String(), string(params.Currency), strconv.Itoa(int(params.Channel)), params.Reference, params.Description, hashCounterparty(params.Counterparty))
+	)
 
-	err = w.transactionManager.WithTransaction(ctx, func(ctx context.Context) error {
+	err = w.idempotencyGuard.Run(ctx, entity.IdempotencyScopeWithdraw, params.IdempotencyKey, requestHash, withdrawal, func(ctx context.Context) error {
 		// Find account by account number and lock it for update
 		// to prevent concurrent access and update the balance
-		account, err := w.accountRepository.FindByAccountNumber(ctx, entity.AccountTypeSaving, accountNumber, applyLock)
+		account, err := w.accountRepository.FindByAccountNumber(ctx, params.AccountNumber, applyLock)
 		if err != nil {
 			return err
 		}
 
-		if account.Balance.LessThan(amount) {
-			return entity.ErrInsufficientBalance
+		if err := account.ValidateDebit(); err != nil {
+			return err
 		}
 
-		transaction.AccountID = account.ID
-		transaction.Amount = amount
-		transaction.Type = entity.TransactionTypeDebit
-		transaction.InitialBalance = account.Balance
-		transaction.FinalBalance = account.Balance.Sub(amount)
-		transaction.Currency = account.Currency
+		if err := account.ValidateAmount(amount, params.Currency); err != nil {
+			return err
+		}
+
+		if err := account.Currency.ValidateWithdrawalAmount(amount); err != nil {
+			return err
+		}
+
+		if err := w.withdrawalLimiter.Check(ctx, account, amount, time.Now()); err != nil {
+			return err
+		}
+
+		fee, err := w.feeSchedule.Fee(ctx, account, entity.FeeOperationWithdraw, params.Channel, amount)
+		if err != nil {
+			return err
+		}
+
+		if account.AvailableBalance().LessThan(amount.Add(fee)) {
+			return entity.ErrInsufficientBalance
+		}
 
-		transaction, err = w.transactionRepository.CreateTransaction(ctx, transaction)
+		debit, err := w.transactionRepository.CreateTransaction(ctx, &entity.Transaction{
+			AccountID:      account.ID,
+			Type:           entity.TransactionTypeDebit,
+			Amount:         amount,
+			InitialBalance: account.Balance,
+			FinalBalance:   account.Balance.Sub(amount),
+			Currency:       account.Currency,
+			Channel:        params.Channel,
+			Reference:      params.Reference,
+			Description:    params.Description,
+			Counterparty:   params.Counterparty,
+		})
 		if err != nil {
 			return err
 		}
 
 		account.Balance = account.Balance.Sub(amount)
+
+		// Cash paid out by the bank settles what is owed to the customer
+		cashOut, err := w.ledger.SystemAccount(ctx, entity.SystemAccountCashOut)
+		if err != nil {
+			return err
+		}
+
+		journal := entity.NewJournal("Penarikan tunai").
+			Debit(account.ID, debit.ID, amount, account.Currency).
+			Credit(cashOut.ID, 0, amount, account.Currency)
+
+		feeTransaction, err := w.feeSchedule.Charge(ctx, account, fee, debit, journal)
+		if err != nil {
+			return err
+		}
+
 		if _, err := w.accountRepository.UpdateAccount(ctx, account); err != nil {
 			return err
 		}
 
+		if err := w.ledger.Post(ctx, journal); err != nil {
+			return err
+		}
+
+		if err := w.outbox.RecordBalanceChanged(ctx, account, debit); err != nil {
+			return err
+		}
+
+		if feeTransaction != nil {
+			if err := w.outbox.RecordBalanceChanged(ctx, account, feeTransaction); err != nil {
+				return err
+			}
+		}
+
+		withdrawal.Debit = debit
+		withdrawal.Fee = feeTransaction
 		return nil
 	})
 
@@ -83,5 +173,5 @@ func (w withdrawUsecase) Withdraw(ctx context.Context, accountNumber string, amo
 		return nil, err
 	}
 
-	return transaction, nil
+	return withdrawal, nil
 }
@@ -0,0 +1,497 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+	repositorymock "imansohibul.my.id/account-domain-service/internal/usecase/mock"
+	"imansohibul.my.id/account-domain-service/util"
+)
+
+func TestWithdrawWithFee(t *testing.T) {
+	var (
+		ctrl                  = gomock.NewController(t)
+		accountRepository     = repositorymock.NewMockAccountRepository(ctrl)
+		transactionRepository = repositorymock.NewMockTransactionRepository(ctrl)
+		transactionManager    = repositorymock.NewMockTransactionManager(ctrl)
+		journalRepository     = repositorymock.NewMockJournalRepository(ctrl)
+		outboxRepository      = repositorymock.NewMockOutboxRepository(ctrl)
+		feeRuleRepository     = repositorymock.NewMockFeeRuleRepository(ctrl)
+		limitRepository       = repositorymock.NewMockWithdrawalLimitRepository(ctrl)
+
+		account   = &entity.Account{ID: 1, AccountNumber: "1111111111", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, Currency: entity.CurrencyIDR, Balance: decimal.NewFromInt(1000000)}
+		cashOut   = &entity.Account{ID: 2, AccountNumber: entity.SystemAccountCashOut}
+		feeIncome = &entity.Account{ID: 7, AccountNumber: entity.SystemAccountFeeIncome}
+		rules     = []*entity.FeeRule{
+			{MinAmount: decimal.Zero, FlatFee: decimal.NewFromInt(2500)},
+			{MinAmount: decimal.NewFromInt(500000), FlatFee: decimal.NewFromInt(2500), Percentage: decimal.RequireFromString("0.1"), MaxFee: decimal.NewFromInt(5000)},
+		}
+		journal *entity.Journal
+	)
+
+	transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction)
+	accountRepository.EXPECT().FindByAccountNumber(gomock.Any(), account.AccountNumber, true).Return(account, nil)
+	limitRepository.EXPECT().FindAccountWithdrawalLimit(gomock.Any(), account.ID).Return(&entity.WithdrawalLimit{AccountID: account.ID}, nil)
+	transactionRepository.EXPECT().FindWithdrawalUsage(gomock.Any(), account.ID, gomock.Any(), gomock.Any()).Return(&entity.WithdrawalUsage{}, nil)
+	feeRuleRepository.EXPECT().FindFeeRules(gomock.Any(), entity.AccountTypeSaving, entity.FeeOperationWithdraw, entity.ChannelATM, entity.CurrencyIDR).Return(rules, nil)
+	accountRepository.EXPECT().FindSystemAccount(gomock.Any(), entity.SystemAccountCashOut).Return(cashOut, nil)
+	accountRepository.EXPECT().FindSystemAccount(gomock.Any(), entity.SystemAccountFeeIncome).Return(feeIncome, nil)
+
+	var transactionID uint
+	transactionRepository.EXPECT().CreateTransaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, transaction *entity.Transaction) (*entity.Transaction, error) {
+			transactionID++
+			transaction.ID = transactionID
+			return transaction, nil
+		}).Times(2)
+	accountRepository.EXPECT().UpdateAccount(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, account *entity.Account) (*entity.Account, error) {
+			return account, nil
+		})
+	journalRepository.EXPECT().CreateJournal(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, j *entity.Journal) (*entity.Journal, error) {
+			journal = j
+			return j, nil
+		})
+	outboxRepository.EXPECT().CreateOutboxEvent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, event *entity.OutboxEvent) (*entity.OutboxEvent, error) {
+			return event, nil
+		}).Times(2)
+
+	withdrawUsecase := NewWithdrawUsecase(
+		accountRepository,
+		transactionRepository,
+		transactionManager,
+		repositorymock.NewMockIdempotencyKeyRepository(ctrl),
+		journalRepository,
+		outboxRepository,
+		feeRuleRepository,
+		limitRepository,
+		repositorymock.NewMockCustomerRepository(ctrl),
+		time.UTC,
+		util.GetZapLogger(),
+	)
+
+	withdrawal, err := withdrawUsecase.Withdraw(context.Background(), &entity.WithdrawParams{
+		AccountNumber: account.AccountNumber,
+		Amount:        decimal.NewFromInt(600000),
+		Currency:      entity.CurrencyIDR,
+		Channel:       entity.ChannelATM,
+		Reference:     "ATM-0042-000123",
+		Description:   "Tarik tunai ATM Sudirman",
+	})
+
+	assert.NoError(t, err)
+
+	// The metadata is kept on the debit, not on the fee
+	assert.Equal(t, entity.ChannelATM, withdrawal.Debit.Channel)
+	assert.Equal(t, "ATM-0042-000123", withdrawal.Debit.Reference)
+	assert.Equal(t, "Tarik tunai ATM Sudirman", withdrawal.Debit.Description)
+	assert.Empty(t, withdrawal.Fee.Reference)
+
+	// 600.000 falls into the second tier: 2.500 + 0,1% = 3.100
+	assert.True(t, decimal.NewFromInt(600000).Equal(withdrawal.Debit.Amount))
+	assert.Equal(t, entity.TransactionTypeFee, withdrawal.Fee.Type)
+	assert.True(t, decimal.NewFromInt(3100).Equal(withdrawal.FeeAmount()))
+	assert.Equal(t, withdrawal.Debit.ID, withdrawal.Fee.LinkedTransactionID)
+	assert.True(t, decimal.NewFromInt(396900).Equal(withdrawal.Balance()))
+	assert.True(t, decimal.NewFromInt(396900).Equal(account.Balance))
+
+	// The fee is credited to the fee income account in the journal of the withdrawal
+	assert.Len(t, journal.Entries, 4)
+	assert.Equal(t, feeIncome.ID, journal.Entries[3].AccountID)
+	assert.NoError(t, journal.Validate())
+}
+
+func TestWithdrawFeeInsufficientBalance(t *testing.T) {
+	var (
+		ctrl                  = gomock.NewController(t)
+		accountRepository     = repositorymock.NewMockAccountRepository(ctrl)
+		transactionRepository = repositorymock.NewMockTransactionRepository(ctrl)
+		transactionManager    = repositorymock.NewMockTransactionManager(ctrl)
+		feeRuleRepository     = repositorymock.NewMockFeeRuleRepository(ctrl)
+		limitRepository       = repositorymock.NewMockWithdrawalLimitRepository(ctrl)
+
+		account = &entity.Account{ID: 1, AccountNumber: "1111111111", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, Currency: entity.CurrencyIDR, Balance: decimal.NewFromInt(100000)}
+		rules   = []*entity.FeeRule{{MinAmount: decimal.Zero, FlatFee: decimal.NewFromInt(6500)}}
+	)
+
+	transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction)
+	accountRepository.EXPECT().FindByAccountNumber(gomock.Any(), account.AccountNumber, true).Return(account, nil)
+	limitRepository.EXPECT().FindAccountWithdrawalLimit(gomock.Any(), account.ID).Return(&entity.WithdrawalLimit{AccountID: account.ID}, nil)
+	transactionRepository.EXPECT().FindWithdrawalUsage(gomock.Any(), account.ID, gomock.Any(), gomock.Any()).Return(&entity.WithdrawalUsage{}, nil)
+	feeRuleRepository.EXPECT().FindFeeRules(gomock.Any(), entity.AccountTypeSaving, entity.FeeOperationWithdraw, entity.ChannelTeller, entity.CurrencyIDR).Return(rules, nil)
+
+	withdrawUsecase := NewWithdrawUsecase(
+		accountRepository,
+		transactionRepository,
+		transactionManager,
+		repositorymock.NewMockIdempotencyKeyRepository(ctrl),
+		repositorymock.NewMockJournalRepository(ctrl),
+		repositorymock.NewMockOutboxRepository(ctrl),
+		feeRuleRepository,
+		limitRepository,
+		repositorymock.NewMockCustomerRepository(ctrl),
+		time.UTC,
+		util.GetZapLogger(),
+	)
+
+	// The balance covers the amount but not the amount and the fee
+	_, err := withdrawUsecase.Withdraw(context.Background(), &entity.WithdrawParams{
+		AccountNumber: account.AccountNumber,
+		Amount:        decimal.NewFromInt(100000),
+		Currency:      entity.CurrencyIDR,
+	})
+
+	assert.ErrorIs(t, err, entity.ErrInsufficientBalance)
+	assert.True(t, decimal.NewFromInt(100000).Equal(account.Balance))
+}
+
+func TestWithdrawHeldBalance(t *testing.T) {
+	var (
+		ctrl                  = gomock.NewController(t)
+		accountRepository     = repositorymock.NewMockAccountRepository(ctrl)
+		transactionRepository = repositorymock.NewMockTransactionRepository(ctrl)
+		transactionManager    = repositorymock.NewMockTransactionManager(ctrl)
+		feeRuleRepository     = repositorymock.NewMockFeeRuleRepository(ctrl)
+		limitRepository       = repositorymock.NewMockWithdrawalLimitRepository(ctrl)
+
+		account = &entity.Account{ID: 1, AccountNumber: "1111111111", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, Currency: entity.CurrencyIDR, Balance: decimal.NewFromInt(1000000), HeldAmount: decimal.NewFromInt(950000)}
+	)
+
+	transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction)
+	accountRepository.EXPECT().FindByAccountNumber(gomock.Any(), account.AccountNumber, true).Return(account, nil)
+	limitRepository.EXPECT().FindAccountWithdrawalLimit(gomock.Any(), account.ID).Return(&entity.WithdrawalLimit{AccountID: account.ID}, nil)
+	transactionRepository.EXPECT().FindWithdrawalUsage(gomock.Any(), account.ID, gomock.Any(), gomock.Any()).Return(&entity.WithdrawalUsage{}, nil)
+	feeRuleRepository.EXPECT().FindFeeRules(gomock.Any(), entity.AccountTypeSaving, entity.FeeOperationWithdraw, entity.ChannelTeller, entity.CurrencyIDR).Return(nil, nil)
+
+	withdrawUsecase := NewWithdrawUsecase(
+		accountRepository,
+		transactionRepository,
+		transactionManager,
+		repositorymock.NewMockIdempotencyKeyRepository(ctrl),
+		repositorymock.NewMockJournalRepository(ctrl),
+		repositorymock.NewMockOutboxRepository(ctrl),
+		feeRuleRepository,
+		limitRepository,
+		repositorymock.NewMockCustomerRepository(ctrl),
+		time.UTC,
+		util.GetZapLogger(),
+	)
+
+	// The ledger balance covers the amount but the active holds leave only 50.000 available
+	_, err := withdrawUsecase.Withdraw(context.Background(), &entity.WithdrawParams{
+		AccountNumber: account.AccountNumber,
+		Amount:        decimal.NewFromInt(100000),
+		Currency:      entity.CurrencyIDR,
+	})
+
+	assert.ErrorIs(t, err, entity.ErrInsufficientBalance)
+	assert.True(t, decimal.NewFromInt(1000000).Equal(account.Balance))
+}
+
+func TestWithdrawLimitExceeded(t *testing.T) {
+	tests := []struct {
+		name              string
+		amount            decimal.Decimal
+		usage             *entity.WithdrawalUsage
+		expectedLimit     entity.LimitType
+		expectedRemaining string
+	}{
+		{
+			name:              "Per Transaction",
+			amount:            decimal.NewFromInt(30000000),
+			usage:             &entity.WithdrawalUsage{},
+			expectedLimit:     entity.LimitPerTransaction,
+			expectedRemaining: "25000000",
+		},
+		{
+			name:              "Daily",
+			amount:            decimal.NewFromInt(20000000),
+			usage:             &entity.WithdrawalUsage{DailyAmount: decimal.NewFromInt(35000000), DailyCount: 2, MonthlyAmount: decimal.NewFromInt(35000000)},
+			expectedLimit:     entity.LimitDaily,
+			expectedRemaining: "15000000",
+		},
+		{
+			name:              "Monthly",
+			amount:            decimal.NewFromInt(20000000),
+			usage:             &entity.WithdrawalUsage{MonthlyAmount: decimal.NewFromInt(190000000)},
+			expectedLimit:     entity.LimitMonthly,
+			expectedRemaining: "10000000",
+		},
+		{
+			name:              "Daily Count",
+			amount:            decimal.NewFromInt(100000),
+			usage:             &entity.WithdrawalUsage{DailyAmount: decimal.NewFromInt(500000), DailyCount: 5, MonthlyAmount: decimal.NewFromInt(500000)},
+			expectedLimit:     entity.LimitDailyCount,
+			expectedRemaining: "0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctrl                  = gomock.NewController(t)
+				accountRepository     = repositorymock.NewMockAccountRepository(ctrl)
+				transactionRepository = repositorymock.NewMockTransactionRepository(ctrl)
+				transactionManager    = repositorymock.NewMockTransactionManager(ctrl)
+				limitRepository       = repositorymock.NewMockWithdrawalLimitRepository(ctrl)
+				customerRepository    = repositorymock.NewMockCustomerRepository(ctrl)
+
+				account = &entity.Account{ID: 1, CustomerID: 3, AccountNumber: "1111111111", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, Currency: entity.CurrencyIDR, Balance: decimal.NewFromInt(500000000)}
+				limit   = &entity.WithdrawalLimit{
+					MaxPerTransaction: decimal.NewFromInt(25000000),
+					MaxDaily:          decimal.NewFromInt(50000000),
+					MaxMonthly:        decimal.NewFromInt(200000000),
+					MaxDailyCount:     5,
+				}
+			)
+
+			// The account isn't overridden, so the limits of its product for the tier of its customer apply
+			transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction)
+			accountRepository.EXPECT().FindByAccountNumber(gomock.Any(), account.AccountNumber, true).Return(account, nil)
+			limitRepository.EXPECT().FindAccountWithdrawalLimit(gomock.Any(), account.ID).Return(nil, entity.ErrWithdrawalLimitNotFound)
+			customerRepository.EXPECT().FindByID(gomock.Any(), account.CustomerID, false).Return(&entity.Customer{ID: 3, Tier: entity.CustomerTierPriority}, nil)
+			limitRepository.EXPECT().FindWithdrawalLimit(gomock.Any(), entity.AccountTypeSaving, entity.CustomerTierPriority, entity.CurrencyIDR).Return(limit, nil)
+			transactionRepository.EXPECT().FindWithdrawalUsage(gomock.Any(), account.ID, gomock.Any(), gomock.Any()).Return(tt.usage, nil)
+
+			withdrawUsecase := NewWithdrawUsecase(
+				accountRepository,
+				transactionRepository,
+				transactionManager,
+				repositorymock.NewMockIdempotencyKeyRepository(ctrl),
+				repositorymock.NewMockJournalRepository(ctrl),
+				repositorymock.NewMockOutboxRepository(ctrl),
+				repositorymock.NewMockFeeRuleRepository(ctrl),
+				limitRepository,
+				customerRepository,
+				time.UTC,
+				util.GetZapLogger(),
+			)
+
+			_, err := withdrawUsecase.Withdraw(context.Background(), &entity.WithdrawParams{
+				AccountNumber: account.AccountNumber,
+				Amount:        tt.amount,
+				Currency:      entity.CurrencyIDR,
+			})
+
+			var domainError *entity.DomainError
+			assert.ErrorIs(t, err, entity.ErrLimitExceeded)
+			assert.ErrorAs(t, err, &domainError)
+			assert.Equal(t, string(tt.expectedLimit), domainError.Details["limit"])
+			assert.Equal(t, tt.expectedRemaining, domainError.Details["remaining"])
+		})
+	}
+}
+
+func TestWithdrawIdempotencyKeyReusedWithDifferentMetadata(t *testing.T) {
+	params := entity.WithdrawParams{
+		AccountNumber:  "1111111111",
+		Amount:         decimal.NewFromInt(100000),
+		Currency:       entity.CurrencyIDR,
+		Channel:        entity.ChannelTeller,
+		Reference:      "TLR-0001",
+		Description:    "Tarik tunai",
+		Counterparty:   &entity.Counterparty{Name: "Budi"},
+		IdempotencyKey: "key-1",
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(*entity.WithdrawParams)
+		expectedErr error
+	}{
+		{
+			name:   "Same Request - Returns Stored Response",
+			modify: func(params *entity.WithdrawParams) {},
+		},
+		{
+			name:        "Different Description",
+			modify:      func(params *entity.WithdrawParams) { params.Description = "Tarik tunai cabang" },
+			expectedErr: entity.ErrIdempotencyKeyReused,
+		},
+		{
+			name:        "Different Counterparty Name",
+			modify:      func(params *entity.WithdrawParams) { params.Counterparty = &entity.Counterparty{Name: "Ani"} },
+			expectedErr: entity.ErrIdempotencyKeyReused,
+		},
+		{
+			name: "Different Counterparty Account Number",
+			modify: func(params *entity.WithdrawParams) {
+				params.Counterparty = &entity.Counterparty{Name: "Budi", AccountNumber: "9999999999"}
+			},
+			expectedErr: entity.ErrIdempotencyKeyReused,
+		},
+		{
+			name:        "Without Counterparty",
+			modify:      func(params *entity.WithdrawParams) { params.Counterparty = nil },
+			expectedErr: entity.ErrIdempotencyKeyReused,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctrl                  = gomock.NewController(t)
+				idempotencyRepository = repositorymock.NewMockIdempotencyKeyRepository(ctrl)
+				requestHash           = hashRequest(params.AccountNumber, params.Amount.String(), string(params.Currency), strconv.Itoa(int(params.Channel)), params.Reference, params.Description, hashCounterparty(params.Counterparty))
+				retried               = params
+			)
+
+			// The key was first used for the original request, the retry changes only its metadata
+			tt.modify(&retried)
+			idempotencyRepository.EXPECT().FindIdempotencyKey(gomock.Any(), entity.IdempotencyScopeWithdraw, "key-1").
+				Return(&entity.IdempotencyKey{RequestHash: requestHash, Response: `{"Debit":{"ID":1}}`}, nil)
+
+			withdrawUsecase := NewWithdrawUsecase(
+				repositorymock.NewMockAccountRepository(ctrl),
+				repositorymock.NewMockTransactionRepository(ctrl),
+				repositorymock.NewMockTransactionManager(ctrl),
+				idempotencyRepository,
+				repositorymock.NewMockJournalRepository(ctrl),
+				repositorymock.NewMockOutboxRepository(ctrl),
+				repositorymock.NewMockFeeRuleRepository(ctrl),
+				repositorymock.NewMockWithdrawalLimitRepository(ctrl),
+				repositorymock.NewMockCustomerRepository(ctrl),
+				time.UTC,
+				util.GetZapLogger(),
+			)
+
+			_, err := withdrawUsecase.Withdraw(context.Background(), &retried)
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
+
+func TestWithdrawLimitNotConfigured(t *testing.T) {
+	var (
+		ctrl               = gomock.NewController(t)
+		accountRepository  = repositorymock.NewMockAccountRepository(ctrl)
+		transactionManager = repositorymock.NewMockTransactionManager(ctrl)
+		limitRepository    = repositorymock.NewMockWithdrawalLimitRepository(ctrl)
+		customerRepository = repositorymock.NewMockCustomerRepository(ctrl)
+
+		account = &entity.Account{ID: 1, CustomerID: 3, AccountNumber: "1111111111", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, Currency: entity.CurrencySGD, Balance: decimal.NewFromInt(50000)}
+	)
+
+	// Neither the account nor its product has limits, the withdrawal isn't unlimited
+	transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction)
+	accountRepository.EXPECT().FindByAccountNumber(gomock.Any(), account.AccountNumber, true).Return(account, nil)
+	limitRepository.EXPECT().FindAccountWithdrawalLimit(gomock.Any(), account.ID).Return(nil, entity.ErrWithdrawalLimitNotFound)
+	customerRepository.EXPECT().FindByID(gomock.Any(), account.CustomerID, false).Return(&entity.Customer{ID: 3, Tier: entity.CustomerTierRegular}, nil)
+	limitRepository.EXPECT().FindWithdrawalLimit(gomock.Any(), entity.AccountTypeSaving, entity.CustomerTierRegular, entity.CurrencySGD).Return(nil, entity.ErrWithdrawalLimitNotFound)
+
+	withdrawUsecase := NewWithdrawUsecase(
+		accountRepository,
+		repositorymock.NewMockTransactionRepository(ctrl),
+		transactionManager,
+		repositorymock.NewMockIdempotencyKeyRepository(ctrl),
+		repositorymock.NewMockJournalRepository(ctrl),
+		repositorymock.NewMockOutboxRepository(ctrl),
+		repositorymock.NewMockFeeRuleRepository(ctrl),
+		limitRepository,
+		customerRepository,
+		time.UTC,
+		util.GetZapLogger(),
+	)
+
+	_, err := withdrawUsecase.Withdraw(context.Background(), &entity.WithdrawParams{
+		AccountNumber: account.AccountNumber,
+		Amount:        decimal.NewFromInt(100),
+		Currency:      entity.CurrencySGD,
+	})
+
+	assert.ErrorIs(t, err, entity.ErrWithdrawalLimitNotConfigured)
+	assert.True(t, decimal.NewFromInt(50000).Equal(account.Balance))
+}
+
+func TestWithdrawAmountExceedsMaximum(t *testing.T) {
+	var (
+		ctrl               = gomock.NewController(t)
+		accountRepository  = repositorymock.NewMockAccountRepository(ctrl)
+		transactionManager = repositorymock.NewMockTransactionManager(ctrl)
+
+		account = &entity.Account{ID: 1, CustomerID: 3, AccountNumber: "1111111111", AccountType: entity.AccountTypeSaving, Status: entity.AccountStatusActive, Currency: entity.CurrencyUSD, Balance: decimal.NewFromInt(100000)}
+	)
+
+	// The maximum of the currency applies before the limits of the account, even an unlimited override
+	transactionManager.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(withTransaction)
+	accountRepository.EXPECT().FindByAccountNumber(gomock.Any(), account.AccountNumber, true).Return(account, nil)
+
+	withdrawUsecase := NewWithdrawUsecase(
+		accountRepository,
+		repositorymock.NewMockTransactionRepository(ctrl),
+		transactionManager,
+		repositorymock.NewMockIdempotencyKeyRepository(ctrl),
+		repositorymock.NewMockJournalRepository(ctrl),
+		repositorymock.NewMockOutboxRepository(ctrl),
+		repositorymock.NewMockFeeRuleRepository(ctrl),
+		repositorymock.NewMockWithdrawalLimitRepository(ctrl),
+		repositorymock.NewMockCustomerRepository(ctrl),
+		time.UTC,
+		util.GetZapLogger(),
+	)
+
+	_, err := withdrawUsecase.Withdraw(context.Background(), &entity.WithdrawParams{
+		AccountNumber: account.AccountNumber,
+		Amount:        decimal.NewFromInt(65000),
+		Currency:      entity.CurrencyUSD,
+	})
+
+	assert.ErrorIs(t, err, entity.ErrAmountExceedsMaximum)
+	assert.True(t, decimal.NewFromInt(100000).Equal(account.Balance))
+}
+
+func TestWithdrawalLimiterPeriodsInTimezoneOfBank(t *testing.T) {
+	wib := time.FixedZone("WIB", 7*60*60)
+
+	tests := []struct {
+		name               string
+		now                time.Time
+		expectedDayStart   time.Time
+		expectedMonthStart time.Time
+	}{
+		{
+			name:               "Before Midnight WIB",
+			now:                time.Date(2025, time.June, 10, 16, 59, 59, 0, time.UTC),
+			expectedDayStart:   time.Date(2025, time.June, 9, 17, 0, 0, 0, time.UTC),
+			expectedMonthStart: time.Date(2025, time.May, 31, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name:               "Midnight WIB - Next Day Before Midnight UTC",
+			now:                time.Date(2025, time.June, 10, 17, 0, 0, 0, time.UTC),
+			expectedDayStart:   time.Date(2025, time.June, 10, 17, 0, 0, 0, time.UTC),
+			expectedMonthStart: time.Date(2025, time.May, 31, 17, 0, 0, 0, time.UTC),
+		},
+		{
+			name:               "First Day Of The Month WIB - Last Day UTC",
+			now:                time.Date(2025, time.June, 30, 18, 30, 0, 0, time.UTC),
+			expectedDayStart:   time.Date(2025, time.June, 30, 17, 0, 0, 0, time.UTC),
+			expectedMonthStart: time.Date(2025, time.June, 30, 17, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				ctrl                  = gomock.NewController(t)
+				transactionRepository = repositorymock.NewMockTransactionRepository(ctrl)
+				limitRepository       = repositorymock.NewMockWithdrawalLimitRepository(ctrl)
+				account               = &entity.Account{ID: 1, AccountType: entity.AccountTypeSaving, Currency: entity.CurrencyIDR}
+			)
+
+			limitRepository.EXPECT().FindAccountWithdrawalLimit(gomock.Any(), account.ID).Return(&entity.WithdrawalLimit{AccountID: account.ID, MaxDaily: decimal.NewFromInt(1000000)}, nil)
+			transactionRepository.EXPECT().FindWithdrawalUsage(gomock.Any(), account.ID, tt.expectedDayStart, tt.expectedMonthStart).Return(&entity.WithdrawalUsage{}, nil)
+
+			limiter := newWithdrawalLimiter(limitRepository, repositorymock.NewMockCustomerRepository(ctrl), transactionRepository, wib)
+
+			assert.NoError(t, limiter.Check(context.Background(), account, decimal.NewFromInt(100000), tt.now))
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// withdrawalLimiter enforces the withdrawal limits of the accounts
+type withdrawalLimiter struct {
+	withdrawalLimitRepository WithdrawalLimitRepository
+	customerRepository        CustomerRepository
+	transactionRepository     TransactionRepository
+	location                  *time.Location // timezone of the bank, the days and months of the limits start at its midnight
+}
+
+func newWithdrawalLimiter(
+	withdrawalLimitRepository WithdrawalLimitRepository,
+	customerRepository CustomerRepository,
+	transactionRepository TransactionRepository,
+	location *time.Location,
+) withdrawalLimiter {
+	return withdrawalLimiter{
+		withdrawalLimitRepository: withdrawalLimitRepository,
+		customerRepository:        customerRepository,
+		transactionRepository:     transactionRepository,
+		location:                  location,
+	}
+}
+
+// Check checks whether withdrawing the amount from the account stays within its limits.
+// The account must be locked by the caller, so concurrent withdrawals can't both pass on the same usage.
+// returns ErrLimitExceeded with the remaining allowance when a limit is exceeded,
+// and ErrWithdrawalLimitNotConfigured when neither the account nor its product has limits
+func (w withdrawalLimiter) Check(ctx context.Context, account *entity.Account, amount decimal.Decimal, now time.Time) error {
+	limit, err := w.limitOf(ctx, account)
+	if errors.Is(err, entity.ErrWithdrawalLimitNotFound) {
+		// A product without limits isn't unlimited, its limits are missing
+		return entity.ErrWithdrawalLimitNotConfigured
+	} else if err != nil {
+		return err
+	}
+
+	dayStart, monthStart := w.periodsOf(now)
+	usage, err := w.transactionRepository.FindWithdrawalUsage(ctx, account.ID, dayStart, monthStart)
+	if err != nil {
+		return err
+	}
+
+	return limit.Check(amount, *usage)
+}
+
+// limitOf finds the limits of the account: its override, otherwise the limits of its product for the tier of its customer
+func (w withdrawalLimiter) limitOf(ctx context.Context, account *entity.Account) (*entity.WithdrawalLimit, error) {
+	limit, err := w.withdrawalLimitRepository.FindAccountWithdrawalLimit(ctx, account.ID)
+	if !errors.Is(err, entity.ErrWithdrawalLimitNotFound) {
+		return limit, err
+	}
+
+	customer, err := w.customerRepository.FindByID(ctx, account.CustomerID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.withdrawalLimitRepository.FindWithdrawalLimit(ctx, account.AccountType, customer.Tier, account.Currency)
+}
+
+// periodsOf returns the start of the day and of the month of now in the timezone of the bank, in UTC as the transactions
+func (w withdrawalLimiter) periodsOf(now time.Time) (dayStart, monthStart time.Time) {
+	year, month, day := now.In(w.location).Date()
+	dayStart = time.Date(year, month, day, 0, 0, 0, 0, w.location).UTC()
+	monthStart = time.Date(year, month, 1, 0, 0, 0, 0, w.location).UTC()
+	return dayStart, monthStart
+}
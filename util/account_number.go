@@ -0,0 +1,200 @@
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Check digit algorithms of the account numbers
+const (
+	CheckDigitNone  = "none"
+	CheckDigitLuhn  = "luhn"  // 1 digit, detects every single mistyped digit and most swapped adjacent digits
+	CheckDigitMod97 = "mod97" // 2 digits (ISO 7064 MOD 97-10, as IBAN), detects every single mistyped digit and swapped digits
+)
+
+// Sources of the body of the account numbers
+const (
+	AccountNumberBodyRandom   = "random"   // cryptographically secure random digits, retried when the number is taken
+	AccountNumberBodySequence = "sequence" // the next value of the account number sequence of the database
+)
+
+// MinAccountNumberBodyLength is the minimum number of digits between the prefix and the check digits
+const MinAccountNumberBodyLength = 6
+
+// MaxAccountNumberLength is the size of the account_number column
+const MaxAccountNumberLength = 16
+
+// digitsPattern matches a non-empty string of digits
+var digitsPattern = regexp.MustCompile(`^\d+$`)
+
+// AccountNumberScheme describes the format of the account numbers:
+// the prefix (e.g. the branch and product code), the body and the check digits
+type AccountNumberScheme struct {
+	Prefix       string // digits, empty when the account numbers have no prefix
+	Length       int    // total length, including the prefix and the check digits
+	Body         string // AccountNumberBodyRandom or AccountNumberBodySequence
+	CheckDigit   string // CheckDigitLuhn, CheckDigitMod97 or CheckDigitNone
+	LegacyLength int    // length of the numbers issued before the scheme, accepted without check digit, zero when there are none
+	LegacyPrefix string // digits starting the numbers issued before the scheme, tells them apart when they have the length
+}
+
+// DefaultAccountNumberScheme is the scheme of the account numbers when none is configured
+var DefaultAccountNumberScheme = AccountNumberScheme{
+	Length:     10,
+	Body:       AccountNumberBodyRandom,
+	CheckDigit: CheckDigitLuhn,
+}
+
+// accountNumberScheme is the scheme validated by the account_number validation rule
+var accountNumberScheme = DefaultAccountNumberScheme
+
+// SetAccountNumberScheme sets the scheme validated by the account_number validation rule
+func SetAccountNumberScheme(scheme AccountNumberScheme) {
+	accountNumberScheme = scheme
+}
+
+// GetAccountNumberScheme returns the scheme validated by the account_number validation rule
+func GetAccountNumberScheme() AccountNumberScheme {
+	return accountNumberScheme
+}
+
+// Validate checks that the scheme can generate account numbers
+func (s AccountNumberScheme) Validate() error {
+	if s.Prefix != "" && !digitsPattern.MatchString(s.Prefix) {
+		return fmt.Errorf("account number prefix %q must be digits", s.Prefix)
+	}
+
+	if s.Body != AccountNumberBodyRandom && s.Body != AccountNumberBodySequence {
+		return fmt.Errorf("unsupported account number body %q", s.Body)
+	}
+
+	if s.CheckDigit != CheckDigitNone && s.CheckDigit != CheckDigitLuhn && s.CheckDigit != CheckDigitMod97 {
+		return fmt.Errorf("unsupported account number check digit %q", s.CheckDigit)
+	}
+
+	if s.Length > MaxAccountNumberLength {
+		return fmt.Errorf("account number length %d exceeds %d digits", s.Length, MaxAccountNumberLength)
+	}
+
+	if s.BodyLength() < MinAccountNumberBodyLength {
+		return fmt.Errorf("account number length %d leaves less than %d digits after the prefix and the check digits", s.Length, MinAccountNumberBodyLength)
+	}
+
+	if s.LegacyPrefix != "" && !digitsPattern.MatchString(s.LegacyPrefix) {
+		return fmt.Errorf("account number legacy prefix %q must be digits", s.LegacyPrefix)
+	}
+
+	// A mistyped new number would otherwise be accepted as a legacy number without check digit
+	if s.LegacyLength == s.Length && !s.hasDistinctLegacyPrefix() {
+		return fmt.Errorf("account number legacy length must differ from the length %d unless the legacy prefix differs from the prefix", s.Length)
+	}
+
+	return nil
+}
+
+// BodyLength returns the number of digits between the prefix and the check digits
+func (s AccountNumberScheme) BodyLength() int {
+	return s.Length - len(s.Prefix) - s.checkDigitLength()
+}
+
+// Random builds an account number with a random body
+func (s AccountNumberScheme) Random() (string, error) {
+	body, err := GenerateSecureNumber(s.BodyLength())
+	if err != nil {
+		return "", err
+	}
+
+	return s.build(body), nil
+}
+
+// FromSequence builds an account number with the value of a sequence as the body, padded with zeros
+func (s AccountNumberScheme) FromSequence(sequence int64) (string, error) {
+	body := strconv.FormatInt(sequence, 10)
+	if sequence < 0 || len(body) > s.BodyLength() {
+		return "", fmt.Errorf("account number sequence %d doesn't fit in %d digits", sequence, s.BodyLength())
+	}
+
+	return s.build(strings.Repeat("0", s.BodyLength()-len(body)) + body), nil
+}
+
+// IsValid checks the length, the prefix and the check digits of the account number
+// Numbers of the legacy length and prefix are only checked to be digits, they have no check digit
+func (s AccountNumberScheme) IsValid(accountNumber string) bool {
+	if !digitsPattern.MatchString(accountNumber) {
+		return false
+	}
+
+	if s.LegacyLength > 0 && len(accountNumber) == s.LegacyLength && strings.HasPrefix(accountNumber, s.LegacyPrefix) {
+		return true
+	}
+
+	if len(accountNumber) != s.Length || !strings.HasPrefix(accountNumber, s.Prefix) {
+		return false
+	}
+
+	payload := accountNumber[:len(accountNumber)-s.checkDigitLength()]
+	return s.build(payload[len(s.Prefix):]) == accountNumber
+}
+
+// build appends the check digits to the prefix and the body
+func (s AccountNumberScheme) build(body string) string {
+	payload := s.Prefix + body
+
+	switch s.CheckDigit {
+	case CheckDigitLuhn:
+		return payload + strconv.Itoa(luhnCheckDigit(payload))
+	case CheckDigitMod97:
+		return payload + fmt.Sprintf("%02d", mod97CheckDigits(payload))
+	default:
+		return payload
+	}
+}
+
+// hasDistinctLegacyPrefix checks that no number can start with both the prefix and the legacy prefix
+func (s AccountNumberScheme) hasDistinctLegacyPrefix() bool {
+	return s.Prefix != "" && s.LegacyPrefix != "" &&
+		!strings.HasPrefix(s.Prefix, s.LegacyPrefix) && !strings.HasPrefix(s.LegacyPrefix, s.Prefix)
+}
+
+func (s AccountNumberScheme) checkDigitLength() int {
+	switch s.CheckDigit {
+	case CheckDigitLuhn:
+		return 1
+	case CheckDigitMod97:
+		return 2
+	default:
+		return 0
+	}
+}
+
+// luhnCheckDigit computes the Luhn check digit of the digits:
+// every second digit from the right is doubled, the check digit completes the sum to a multiple of 10
+func luhnCheckDigit(digits string) int {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := int(digits[i] - '0')
+		if (len(digits)-1-i)%2 == 0 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+
+		sum += digit
+	}
+
+	return (10 - sum%10) % 10
+}
+
+// mod97CheckDigits computes the ISO 7064 MOD 97-10 check digits of the digits:
+// the digits followed by the check digits are 1 modulo 97
+func mod97CheckDigits(digits string) int {
+	remainder := 0
+	for i := 0; i < len(digits); i++ {
+		remainder = (remainder*10 + int(digits[i]-'0')) % 97
+	}
+
+	return 98 - (remainder*100)%97
+}
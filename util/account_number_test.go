@@ -0,0 +1,105 @@
+package util
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountNumberScheme(t *testing.T) {
+	schemes := []AccountNumberScheme{
+		DefaultAccountNumberScheme,
+		{Prefix: "001", Length: 12, Body: AccountNumberBodyRandom, CheckDigit: CheckDigitMod97},
+		{Prefix: "7", Length: 10, Body: AccountNumberBodySequence, CheckDigit: CheckDigitNone},
+	}
+
+	for _, scheme := range schemes {
+		t.Run(scheme.CheckDigit, func(t *testing.T) {
+			assert.NoError(t, scheme.Validate())
+
+			accountNumber, err := scheme.Random()
+			assert.NoError(t, err)
+			assert.Len(t, accountNumber, scheme.Length)
+			assert.True(t, strings.HasPrefix(accountNumber, scheme.Prefix))
+			assert.True(t, scheme.IsValid(accountNumber))
+
+			if scheme.CheckDigit == CheckDigitNone {
+				return
+			}
+
+			// Every single mistyped digit is detected
+			for i := len(scheme.Prefix); i < len(accountNumber); i++ {
+				for digit := '0'; digit <= '9'; digit++ {
+					if rune(accountNumber[i]) == digit {
+						continue
+					}
+
+					mistyped := accountNumber[:i] + string(digit) + accountNumber[i+1:]
+					assert.False(t, scheme.IsValid(mistyped), mistyped)
+				}
+			}
+		})
+	}
+}
+
+func TestAccountNumberCheckDigits(t *testing.T) {
+	luhn := AccountNumberScheme{Length: 10, CheckDigit: CheckDigitLuhn}
+	assert.True(t, luhn.IsValid("1234567897"))
+	assert.False(t, luhn.IsValid("1234567890"))
+	assert.False(t, luhn.IsValid("2134567897"))
+
+	// The check digits of the IBAN GB82 WEST 1234 5698 7654 32, its letters converted to digits and its country code moved to the end
+	assert.Equal(t, 82, mod97CheckDigits("32142829123456987654321611"))
+
+	mod97 := AccountNumberScheme{Prefix: "001", Length: 12, CheckDigit: CheckDigitMod97}
+	accountNumber, err := mod97.FromSequence(42)
+	assert.NoError(t, err)
+	assert.Equal(t, "0010000042", accountNumber[:10])
+	assert.True(t, mod97.IsValid(accountNumber))
+}
+
+func TestAccountNumberSchemeFromSequence(t *testing.T) {
+	scheme := AccountNumberScheme{Prefix: "01", Length: 9, Body: AccountNumberBodySequence, CheckDigit: CheckDigitLuhn}
+
+	accountNumber, err := scheme.FromSequence(42)
+	assert.NoError(t, err)
+	assert.Equal(t, "010000420", accountNumber)
+	assert.True(t, scheme.IsValid(accountNumber))
+
+	_, err = scheme.FromSequence(1000000)
+	assert.Error(t, err)
+}
+
+func TestAccountNumberSchemeLegacyLength(t *testing.T) {
+	scheme := AccountNumberScheme{Prefix: "01", Length: 12, Body: AccountNumberBodyRandom, CheckDigit: CheckDigitLuhn, LegacyLength: 10}
+
+	assert.True(t, scheme.IsValid("1234567890"))
+	assert.False(t, scheme.IsValid("123456789"))
+	assert.False(t, scheme.IsValid("12345678AB"))
+}
+
+func TestAccountNumberSchemeValidate(t *testing.T) {
+	assert.Error(t, AccountNumberScheme{Prefix: "A1", Length: 10, Body: AccountNumberBodyRandom, CheckDigit: CheckDigitLuhn}.Validate())
+	assert.Error(t, AccountNumberScheme{Length: 10, Body: "uuid", CheckDigit: CheckDigitLuhn}.Validate())
+	assert.Error(t, AccountNumberScheme{Length: 10, Body: AccountNumberBodyRandom, CheckDigit: "crc"}.Validate())
+	assert.Error(t, AccountNumberScheme{Prefix: "0011", Length: 10, Body: AccountNumberBodyRandom, CheckDigit: CheckDigitMod97}.Validate())
+	assert.Error(t, AccountNumberScheme{Length: 10, Body: AccountNumberBodyRandom, CheckDigit: CheckDigitLuhn, LegacyLength: 10}.Validate())
+	assert.Error(t, AccountNumberScheme{Length: 17, Body: AccountNumberBodyRandom, CheckDigit: CheckDigitLuhn}.Validate())
+}
+
+func TestAccountNumberSchemeLegacyPrefix(t *testing.T) {
+	scheme := AccountNumberScheme{Prefix: "9", Length: 10, Body: AccountNumberBodyRandom, CheckDigit: CheckDigitLuhn, LegacyLength: 10, LegacyPrefix: "1"}
+
+	assert.NoError(t, scheme.Validate())
+	assert.True(t, scheme.IsValid("1234567890"))
+	assert.True(t, scheme.IsValid("9234567890"))
+
+	// A new number with a mistyped check digit isn't taken for a legacy number
+	assert.False(t, scheme.IsValid("9234567897"))
+	assert.False(t, scheme.IsValid("2234567890"))
+
+	assert.Error(t, AccountNumberScheme{Prefix: "9", Length: 10, Body: AccountNumberBodyRandom, CheckDigit: CheckDigitLuhn, LegacyLength: 10}.Validate())
+	assert.Error(t, AccountNumberScheme{Prefix: "9", Length: 10, Body: AccountNumberBodyRandom, CheckDigit: CheckDigitLuhn, LegacyLength: 10, LegacyPrefix: "92"}.Validate())
+	assert.Error(t, AccountNumberScheme{Length: 10, Body: AccountNumberBodyRandom, CheckDigit: CheckDigitLuhn, LegacyLength: 10, LegacyPrefix: "1"}.Validate())
+}
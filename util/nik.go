@@ -0,0 +1,137 @@
+package util
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// nikRegionsCSV is the table of the region codes of the NIK (Kemendagri codes) with their name
+// Codes are 2 digits for provinces, 4 for regencies and 6 for districts. Provinces and regencies are all listed,
+// including the codes of the regencies before their province was split. The districts of a regency are
+// checked when the table lists them
+//
+//go:embed nik_regions.csv
+var nikRegionsCSV string
+
+// femaleBirthDayOffset is added to the birth day of women in the NIK
+const femaleBirthDayOffset = 40
+
+var (
+	// nikRegions maps the region codes to their name
+	nikRegions map[string]string
+	// nikDistrictsListed tells whether the districts of a regency are listed in the table
+	nikDistrictsListed map[string]bool
+)
+
+func init() {
+	records, err := csv.NewReader(strings.NewReader(nikRegionsCSV)).ReadAll()
+	if err != nil {
+		panic(fmt.Sprintf("invalid NIK region table: %v", err))
+	}
+
+	nikRegions = make(map[string]string, len(records))
+	nikDistrictsListed = make(map[string]bool)
+	for _, record := range records[1:] {
+		code, name := record[0], record[1]
+		nikRegions[code] = name
+		if len(code) == 6 {
+			nikDistrictsListed[code[:4]] = true
+		}
+	}
+}
+
+// NIK is the data encoded in a NIK (Nomor Induk Kependudukan):
+// PPRRDD-DDMMYY-SSSS, the province, regency and district where it was issued,
+// the birth date (the day plus 40 for women) and the serial number
+type NIK struct {
+	Number       string
+	ProvinceCode string
+	RegencyCode  string
+	DistrictCode string
+	Province     string
+	Regency      string
+	District     string // empty when the districts of the regency aren't listed in the region table
+	BirthDate    time.Time
+	Gender       entity.Gender
+	SerialNumber string
+}
+
+// Age returns the age of the holder at the given time
+func (n NIK) Age(now time.Time) int {
+	age := now.Year() - n.BirthDate.Year()
+	if now.Month() < n.BirthDate.Month() || (now.Month() == n.BirthDate.Month() && now.Day() < n.BirthDate.Day()) {
+		age--
+	}
+
+	return age
+}
+
+// ParseNIK decodes the NIK, returns entity.ErrInvalidNIK wrapped with the reason
+// when the number isn't 16 digits, the region is unknown or the birth date is impossible
+func ParseNIK(number string) (*NIK, error) {
+	return parseNIK(number, time.Now())
+}
+
+func parseNIK(number string, now time.Time) (*NIK, error) {
+	if !nikPattern.MatchString(number) {
+		return nil, fmt.Errorf("%w: must be 16 digits", entity.ErrInvalidNIK)
+	}
+
+	nik := &NIK{
+		Number:       number,
+		ProvinceCode: number[0:2],
+		RegencyCode:  number[0:4],
+		DistrictCode: number[0:6],
+		SerialNumber: number[12:16],
+	}
+
+	var ok bool
+	if nik.Province, ok = nikRegions[nik.ProvinceCode]; !ok {
+		return nil, fmt.Errorf("%w: unknown province code %s", entity.ErrInvalidNIK, nik.ProvinceCode)
+	}
+
+	if nik.Regency, ok = nikRegions[nik.RegencyCode]; !ok {
+		return nil, fmt.Errorf("%w: unknown regency code %s", entity.ErrInvalidNIK, nik.RegencyCode)
+	}
+
+	if nik.District, ok = nikRegions[nik.DistrictCode]; !ok && (nikDistrictsListed[nik.RegencyCode] || number[4:6] == "00") {
+		return nil, fmt.Errorf("%w: unknown district code %s", entity.ErrInvalidNIK, nik.DistrictCode)
+	}
+
+	// The digits are already validated by the pattern
+	day, _ := strconv.Atoi(number[6:8])
+	month, _ := strconv.Atoi(number[8:10])
+	year, _ := strconv.Atoi(number[10:12])
+
+	nik.Gender = entity.GenderMale
+	if day > femaleBirthDayOffset {
+		nik.Gender = entity.GenderFemale
+		day -= femaleBirthDayOffset
+	}
+
+	// The year has 2 digits, a birth date after today is of the previous century
+	year += 2000
+	if time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC).After(now) {
+		year -= 100
+	}
+
+	// time.Date normalizes the out of range values e.g. 31 February is 3 March
+	birthDate := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if birthDate.Day() != day || int(birthDate.Month()) != month {
+		return nil, fmt.Errorf("%w: impossible birth date %s", entity.ErrInvalidNIK, number[6:12])
+	}
+
+	nik.BirthDate = birthDate
+
+	if nik.SerialNumber == "0000" {
+		return nil, fmt.Errorf("%w: serial number must not be 0000", entity.ErrInvalidNIK)
+	}
+
+	return nik, nil
+}
@@ -0,0 +1,102 @@
+package util
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+func TestParseNIK(t *testing.T) {
+	now := time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		number      string
+		expectedNIK *NIK
+		expectedErr bool
+	}{
+		{
+			name:   "Male",
+			number: "3204081901970002",
+			expectedNIK: &NIK{
+				Number:       "3204081901970002",
+				ProvinceCode: "32",
+				RegencyCode:  "3204",
+				DistrictCode: "320408",
+				Province:     "JAWA BARAT",
+				Regency:      "KABUPATEN BANDUNG",
+				BirthDate:    time.Date(1997, time.January, 19, 0, 0, 0, 0, time.UTC),
+				Gender:       entity.GenderMale,
+				SerialNumber: "0002",
+			},
+		},
+		{
+			name:   "Female - Day Plus 40",
+			number: "3578014512050001",
+			expectedNIK: &NIK{
+				Number:       "3578014512050001",
+				ProvinceCode: "35",
+				RegencyCode:  "3578",
+				DistrictCode: "357801",
+				Province:     "JAWA TIMUR",
+				Regency:      "KOTA SURABAYA",
+				BirthDate:    time.Date(2005, time.December, 5, 0, 0, 0, 0, time.UTC),
+				Gender:       entity.GenderFemale,
+				SerialNumber: "0001",
+			},
+		},
+		{
+			name:   "Birth Year After Today - Previous Century",
+			number: "3173010107300001",
+			expectedNIK: &NIK{
+				Number:       "3173010107300001",
+				ProvinceCode: "31",
+				RegencyCode:  "3173",
+				DistrictCode: "317301",
+				Province:     "DKI JAKARTA",
+				Regency:      "KOTA ADMINISTRASI JAKARTA PUSAT",
+				District:     "TANAH ABANG",
+				BirthDate:    time.Date(1930, time.July, 1, 0, 0, 0, 0, time.UTC),
+				Gender:       entity.GenderMale,
+				SerialNumber: "0001",
+			},
+		},
+		{name: "Sixteen Zeros", number: "0000000000000000", expectedErr: true},
+		{name: "Not 16 Digits", number: "320408190197000", expectedErr: true},
+		{name: "Unknown Province", number: "9904081901970002", expectedErr: true},
+		{name: "Zero Regency", number: "3200081901970002", expectedErr: true},
+		{name: "Unknown Regency", number: "3299081901970002", expectedErr: true},
+		{name: "Regency Of Another Province", number: "3229081901970002", expectedErr: true},
+		{name: "Unknown District", number: "3173990107300001", expectedErr: true},
+		{name: "Zero District", number: "3578001901970002", expectedErr: true},
+		{name: "31 February", number: "3204083102970002", expectedErr: true},
+		{name: "Female 29 February Of Non-Leap Year", number: "3204086902970002", expectedErr: true},
+		{name: "Day 35", number: "3204083501970002", expectedErr: true},
+		{name: "Month 13", number: "3204081913970002", expectedErr: true},
+		{name: "Zero Serial Number", number: "3204081901970000", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nik, err := parseNIK(tt.number, now)
+			if tt.expectedErr {
+				assert.True(t, errors.Is(err, entity.ErrInvalidNIK))
+				assert.Nil(t, nik)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedNIK, nik)
+		})
+	}
+}
+
+func TestNIKAge(t *testing.T) {
+	nik := NIK{BirthDate: time.Date(2008, time.June, 2, 0, 0, 0, 0, time.UTC)}
+
+	assert.Equal(t, 16, nik.Age(time.Date(2025, time.June, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 17, nik.Age(time.Date(2025, time.June, 2, 0, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,73 @@
+package util
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+// indonesiaCallingCode is the country calling code of Indonesia
+const indonesiaCallingCode = "62"
+
+var (
+	// phoneNumberSeparators are removed from the phone numbers typed by the customers
+	phoneNumberSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+	// indonesianMobilePattern is the national number of an Indonesian mobile phone, 9-12 digits starting with 8
+	indonesianMobilePattern = regexp.MustCompile(`^8\d{8,11}$`)
+	// e164Pattern is a phone number in E.164 format
+	e164Pattern = regexp.MustCompile(`^\+[1-9]\d{7,14}$`)
+)
+
+// indonesianMobilePrefixes are the prefixes of the national numbers assigned to the mobile operators
+var indonesianMobilePrefixes = map[string]bool{
+	// Telkomsel
+	"811": true, "812": true, "813": true, "821": true, "822": true, "823": true, "851": true, "852": true, "853": true,
+	// Indosat
+	"814": true, "815": true, "816": true, "855": true, "856": true, "857": true, "858": true,
+	// XL
+	"817": true, "818": true, "819": true, "859": true, "877": true, "878": true,
+	// Axis
+	"831": true, "832": true, "833": true, "838": true,
+	// Tri
+	"895": true, "896": true, "897": true, "898": true, "899": true,
+	// Smartfren
+	"881": true, "882": true, "883": true, "884": true, "885": true, "886": true, "887": true, "888": true, "889": true,
+}
+
+// NormalizePhoneNumber converts a phone number to E.164, e.g. 0812-3456-7890, 62 812 3456 7890
+// and +6281234567890 are all +6281234567890. Indonesian numbers must be mobile numbers of a known operator,
+// numbers of other countries must already have their + and country calling code.
+// Returns entity.ErrInvalidPhoneNumber wrapped with the reason when the number can't be normalized
+func NormalizePhoneNumber(phoneNumber string) (string, error) {
+	number := phoneNumberSeparators.Replace(strings.TrimSpace(phoneNumber))
+
+	var nationalNumber string
+	switch {
+	case strings.HasPrefix(number, "+"+indonesiaCallingCode):
+		nationalNumber = strings.TrimPrefix(number, "+"+indonesiaCallingCode)
+	case strings.HasPrefix(number, "+"):
+		if !e164Pattern.MatchString(number) {
+			return "", fmt.Errorf("%w: %q is not E.164", entity.ErrInvalidPhoneNumber, phoneNumber)
+		}
+
+		return number, nil
+	case strings.HasPrefix(number, indonesiaCallingCode):
+		nationalNumber = strings.TrimPrefix(number, indonesiaCallingCode)
+	case strings.HasPrefix(number, "0"):
+		nationalNumber = strings.TrimPrefix(number, "0")
+	default:
+		return "", fmt.Errorf("%w: %q has no country calling code or leading 0", entity.ErrInvalidPhoneNumber, phoneNumber)
+	}
+
+	if !indonesianMobilePattern.MatchString(nationalNumber) {
+		return "", fmt.Errorf("%w: %q is not an Indonesian mobile number", entity.ErrInvalidPhoneNumber, phoneNumber)
+	}
+
+	if !indonesianMobilePrefixes[nationalNumber[:3]] {
+		return "", fmt.Errorf("%w: unknown mobile prefix 0%s", entity.ErrInvalidPhoneNumber, nationalNumber[:3])
+	}
+
+	return "+" + indonesiaCallingCode + nationalNumber, nil
+}
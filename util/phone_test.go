@@ -0,0 +1,46 @@
+package util
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"imansohibul.my.id/account-domain-service/entity"
+)
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	tests := []struct {
+		name                string
+		phoneNumber         string
+		expectedPhoneNumber string
+		expectedErr         bool
+	}{
+		{name: "E.164", phoneNumber: "+6281234567890", expectedPhoneNumber: "+6281234567890"},
+		{name: "Local", phoneNumber: "081234567890", expectedPhoneNumber: "+6281234567890"},
+		{name: "Country Code Without Plus", phoneNumber: "6281234567890", expectedPhoneNumber: "+6281234567890"},
+		{name: "Spaces And Dashes", phoneNumber: " 0812-3456 7890 ", expectedPhoneNumber: "+6281234567890"},
+		{name: "Parentheses And Dots", phoneNumber: "+62 (812) 3456.7890", expectedPhoneNumber: "+6281234567890"},
+		{name: "Shortest Number", phoneNumber: "0895123456", expectedPhoneNumber: "+62895123456"},
+		{name: "Foreign E.164", phoneNumber: "+65 9123 4567", expectedPhoneNumber: "+6591234567"},
+		{name: "Landline", phoneNumber: "0215551234", expectedErr: true},
+		{name: "Unknown Mobile Prefix", phoneNumber: "081034567890", expectedErr: true},
+		{name: "Too Short", phoneNumber: "08123456", expectedErr: true},
+		{name: "Too Long", phoneNumber: "08123456789012", expectedErr: true},
+		{name: "No Country Code", phoneNumber: "81234567890", expectedErr: true},
+		{name: "Letters", phoneNumber: "0812345678ab", expectedErr: true},
+		{name: "Foreign Not E.164", phoneNumber: "+0123", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			phoneNumber, err := NormalizePhoneNumber(tt.phoneNumber)
+			if tt.expectedErr {
+				assert.True(t, errors.Is(err, entity.ErrInvalidPhoneNumber))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedPhoneNumber, phoneNumber)
+		})
+	}
+}
@@ -1,9 +1,12 @@
 package util
 
 import (
+	"reflect"
 	"regexp"
+	"strings"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/shopspring/decimal"
 )
 
 var validate *validator.Validate
@@ -11,19 +14,111 @@ var validate *validator.Validate
 func init() {
 	validate = validator.New()
 	validate.RegisterValidation("nik", validateNIK)
+	validate.RegisterValidation("passport", validatePassport)
+	validate.RegisterValidation("kitas", validateKITAS)
+	validate.RegisterValidation("npwp", validateNPWP)
+	validate.RegisterValidation("identity_number", validateIdentityNumber)
 	validate.RegisterValidation("fullname", validateFullname)
+	validate.RegisterValidation("phone_number", validatePhoneNumber)
+	validate.RegisterValidation("account_number", validateAccountNumber)
+	validate.RegisterTagNameFunc(fieldName)
+
+	// Validate decimal amounts with the numeric rules e.g. gt=0
+	validate.RegisterCustomTypeFunc(decimalValue, decimal.Decimal{})
 }
 
 func GetValidator() *validator.Validate {
 	return validate
 }
 
+// fieldName reports validation errors with the name the client sent the field with
+// e.g. "no_rekening" instead of "AccountNumber"
+func fieldName(field reflect.StructField) string {
+	for _, tag := range []string{"json", "query", "param", "header"} {
+		name := strings.SplitN(field.Tag.Get(tag), ",", 2)[0]
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+
+	return field.Name
+}
+
+// decimalValue converts a decimal.Decimal to float64 for validation
+func decimalValue(field reflect.Value) interface{} {
+	if amount, ok := field.Interface().(decimal.Decimal); ok {
+		value, _ := amount.Float64()
+		return value
+	}
+
+	return nil
+}
+
+// Formats of the identity documents
+var (
+	nikPattern      = regexp.MustCompile(`^\d{16}$`)          // NIK should be exactly 16 digits
+	passportPattern = regexp.MustCompile(`^[A-Z0-9]{6,9}$`)   // ICAO passport number, e.g. Indonesian passports are 1-2 letters and 6-7 digits
+	kitasPattern    = regexp.MustCompile(`^[A-Z0-9]{11,16}$`) // KITAS card number
+	npwpPattern     = regexp.MustCompile(`^(\d{15}|\d{16})$`) // NPWP is 15 digits, or the 16 digit NIK since 2024, without separators
+)
+
+// identityNumberValidators maps the identity type names of the API to the validation of their number
+var identityNumberValidators = map[string]func(string) bool{
+	"NIK":    isValidNIK,
+	"PASPOR": passportPattern.MatchString,
+	"KITAS":  kitasPattern.MatchString,
+	"NPWP":   npwpPattern.MatchString,
+}
+
+// isValidNIK checks the format, the region and the birth date of the NIK
+func isValidNIK(number string) bool {
+	_, err := ParseNIK(number)
+	return err == nil
+}
+
 func validateNIK(fl validator.FieldLevel) bool {
-	nik := fl.Field().String()
+	return isValidNIK(fl.Field().String())
+}
 
-	// NIK should be exactly 16 digits
-	match, _ := regexp.MatchString(`^\d{16}$`, nik)
-	return match
+func validatePassport(fl validator.FieldLevel) bool {
+	return passportPattern.MatchString(fl.Field().String())
+}
+
+func validateKITAS(fl validator.FieldLevel) bool {
+	return kitasPattern.MatchString(fl.Field().String())
+}
+
+func validateNPWP(fl validator.FieldLevel) bool {
+	return npwpPattern.MatchString(fl.Field().String())
+}
+
+// validateIdentityNumber validates the number with the format of the identity type in the field of the param
+// e.g. identity_number=IdentityType, an empty identity type is a NIK
+func validateIdentityNumber(fl validator.FieldLevel) bool {
+	identityType := "NIK"
+	if field, _, _, ok := fl.GetStructFieldOKAdvanced2(fl.Parent(), fl.Param()); ok && field.String() != "" {
+		identityType = field.String()
+	}
+
+	isValid, ok := identityNumberValidators[identityType]
+	if !ok {
+		return false
+	}
+
+	return isValid(fl.Field().String())
+}
+
+// validatePhoneNumber accepts the phone numbers that can be normalized to E.164
+// e.g. 0812-3456-7890 or +6281234567890
+func validatePhoneNumber(fl validator.FieldLevel) bool {
+	_, err := NormalizePhoneNumber(fl.Field().String())
+	return err == nil
+}
+
+// validateAccountNumber checks the length, the prefix and the check digits of the account number
+// with the configured scheme, so a mistyped account number is rejected before it's looked up
+func validateAccountNumber(fl validator.FieldLevel) bool {
+	return accountNumberScheme.IsValid(fl.Field().String())
 }
 
 func validateFullname(fl validator.FieldLevel) bool {